@@ -0,0 +1,199 @@
+// Package activitypub makes the public posts of the users followable
+// from the fediverse. Every user is a Person actor with an outbox of
+// the public posts, followers and an inbox that accepts follows and
+// replies. Create, Delete and Like activities are delivered to the
+// followers with signed requests through a retrying queue.
+//
+// Users have no unique names, the handle of a user is id<user_id> like
+// in the mentions of the posts.
+package activitypub
+
+import (
+	"context"
+	"crypto/rsa"
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/NexusIT-Dev/nexusmicro_publications/pb"
+	"github.com/go-kit/log"
+	"github.com/go-kit/log/level"
+)
+
+const (
+	// Public is the collection addressing an object to everyone.
+	Public = "https://www.w3.org/ns/activitystreams#Public"
+
+	// ContentType is the media type of the ActivityPub documents.
+	ContentType = "application/activity+json"
+
+	activityStreams = "https://www.w3.org/ns/activitystreams"
+	securityContext = "https://w3id.org/security/v1"
+)
+
+// Posts reads the posts and the users as a guest, so only public posts
+// are returned.
+type Posts interface {
+	UserPosts(ctx context.Context, user_id int64, last_id uint64, limit int64) ([]*pb.Post, error)
+	Post(ctx context.Context, post_id uint64) (*pb.Post, error)
+	User(ctx context.Context, user_id int64) (*pb.User, error)
+}
+
+// Replies stores a reply to a public post as its comment. Saving a
+// reply with the same object id again must be a no-op.
+type Replies interface {
+	Add(ctx context.Context, post_id uint64, object_id string, actor string, message string) error
+}
+
+type Config struct {
+	// BaseURL is the public address of the http server, the ids of the
+	// actors and the objects start with it.
+	BaseURL string
+	// WebURL is the address of the web app the profiles and the posts
+	// link to.
+	WebURL string
+	Queue  QueueConfig
+	// Timeout is the timeout of the requests to the remote servers.
+	Timeout time.Duration
+	// AllowPrivate allows the remote actors on http and internal
+	// addresses, it is for tests only.
+	AllowPrivate bool
+}
+
+// defaultTimeout is the timeout of the requests to the remote servers
+// if Config.Timeout is not set.
+const defaultTimeout = time.Second * 30
+
+// Federation serves the actors and delivers the activities of the
+// users. It implements service.Federation.
+type Federation struct {
+	cfg     Config
+	signer  *Signer
+	store   Store
+	posts   Posts
+	replies Replies
+	keys    *KeyFetcher
+	queue   *Queue
+	logger  log.Logger
+}
+
+// New returns the federation of the users with the key all of them sign
+// with.
+func New(cfg Config, key *rsa.PrivateKey, store Store, posts Posts, replies Replies, logger log.Logger) *Federation {
+
+	cfg.BaseURL = strings.TrimSuffix(cfg.BaseURL, "/")
+	cfg.WebURL = strings.TrimSuffix(cfg.WebURL, "/")
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = defaultTimeout
+	}
+
+	signer := NewSigner(key)
+	client := NewClient(cfg.Timeout, cfg.AllowPrivate)
+
+	return &Federation{
+		cfg:     cfg,
+		signer:  signer,
+		store:   store,
+		posts:   posts,
+		replies: replies,
+		keys:    NewKeyFetcher(client, cfg.AllowPrivate),
+		queue:   NewQueue(client, signer, store, cfg.Queue, logger),
+		logger:  logger,
+	}
+}
+
+// Run delivers the queued activities until the context is done.
+func (f *Federation) Run(ctx context.Context) {
+	f.queue.Run(ctx)
+}
+
+func (f *Federation) actorURL(user_id int64) string {
+	return f.cfg.BaseURL + "/users/" + strconv.FormatInt(user_id, 10)
+}
+
+func (f *Federation) keyId(user_id int64) string {
+	return f.actorURL(user_id) + "#main-key"
+}
+
+func (f *Federation) noteURL(owner_id int64, post_id uint64) string {
+	return f.actorURL(owner_id) + "/posts/" + strconv.FormatUint(post_id, 10)
+}
+
+// parseNoteURL returns the owner and the id of the post of a note of
+// this server.
+func (f *Federation) parseNoteURL(url string) (int64, uint64, bool) {
+
+	rest, ok := strings.CutPrefix(url, f.cfg.BaseURL+"/users/")
+	if !ok {
+		return 0, 0, false
+	}
+
+	owner, post, ok := strings.Cut(rest, "/posts/")
+	if !ok {
+		return 0, 0, false
+	}
+
+	owner_id, err := strconv.ParseInt(owner, 10, 64)
+	if err != nil {
+		return 0, 0, false
+	}
+	post_id, err := strconv.ParseUint(post, 10, 64)
+	if err != nil {
+		return 0, 0, false
+	}
+
+	return owner_id, post_id, true
+}
+
+func (f *Federation) PostCreated(post *pb.Post) {
+	if post.Visibility != pb.Visibility_public {
+		return
+	}
+	go f.deliverToFollowers(post.OwnerId, f.create(post))
+}
+
+func (f *Federation) PostDeleted(post *pb.Post) {
+	if post.Visibility != pb.Visibility_public {
+		return
+	}
+	go f.deliverToFollowers(post.OwnerId, f.delete(post))
+}
+
+func (f *Federation) PostLiked(user_id int64, post *pb.Post) {
+	if post.Visibility != pb.Visibility_public {
+		return
+	}
+	go f.deliverToFollowers(user_id, f.like(user_id, post))
+}
+
+// deliverToFollowers queues the activity of the user for the inboxes of
+// its followers.
+func (f *Federation) deliverToFollowers(user_id int64, activity any) {
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	body, err := json.Marshal(activity)
+	if err != nil {
+		level.Error(f.logger).Log("msg", "failed to encode activity", "err", err)
+		return
+	}
+
+	followers, err := f.store.Followers(ctx, user_id)
+	if err != nil {
+		level.Error(f.logger).Log("msg", "failed to load followers", "user_id", user_id, "err", err)
+		return
+	}
+
+	// followers on the same server share an inbox.
+	inboxes := make(map[string]bool)
+	for _, follower := range followers {
+		if inboxes[follower.Inbox] {
+			continue
+		}
+		inboxes[follower.Inbox] = true
+
+		f.queue.Enqueue(Delivery{Inbox: follower.Inbox, KeyId: f.keyId(user_id), Body: body})
+	}
+}
@@ -0,0 +1,362 @@
+package activitypub_test
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/NexusIT-Dev/nexusmicro_publications/activitypub"
+	"github.com/NexusIT-Dev/nexusmicro_publications/activitypub/aptest"
+	"github.com/NexusIT-Dev/nexusmicro_publications/pb"
+	"github.com/go-kit/log"
+	"github.com/gocql/gocql"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	testUserId = 7
+	testPostId = 42
+)
+
+type fakePosts struct {
+	posts []*pb.Post
+}
+
+func (p *fakePosts) UserPosts(ctx context.Context, user_id int64, last_id uint64, limit int64) ([]*pb.Post, error) {
+	return p.posts, nil
+}
+
+func (p *fakePosts) Post(ctx context.Context, post_id uint64) (*pb.Post, error) {
+	for _, post := range p.posts {
+		if post.Id == post_id {
+			return post, nil
+		}
+	}
+	return nil, nil
+}
+
+func (p *fakePosts) User(ctx context.Context, user_id int64) (*pb.User, error) {
+	if user_id != testUserId {
+		return nil, nil
+	}
+	return &pb.User{Id: user_id, Name: "Test", Lastname: "User"}, nil
+}
+
+type comment struct {
+	postId  uint64
+	actor   string
+	message string
+}
+
+// fakeReplies keeps the comments by the object ids of the replies, like
+// service.Replies does with ap_replies.
+type fakeReplies struct {
+	mu       sync.Mutex
+	comments map[string]comment
+	adds     int
+}
+
+func (r *fakeReplies) Add(ctx context.Context, post_id uint64, object_id string, actor string, message string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.adds++
+	if _, ok := r.comments[object_id]; !ok {
+		r.comments[object_id] = comment{postId: post_id, actor: actor, message: message}
+	}
+	return nil
+}
+
+type testFederation struct {
+	fed     *activitypub.Federation
+	server  *httptest.Server
+	store   *activitypub.MemoryStore
+	replies *fakeReplies
+	post    *pb.Post
+}
+
+func (tf *testFederation) actor() string {
+	return tf.server.URL + "/users/7"
+}
+
+func newTestFederation(t *testing.T, allowPrivate bool) *testFederation {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tf := &testFederation{
+		store:   activitypub.NewMemoryStore(),
+		replies: &fakeReplies{comments: make(map[string]comment)},
+		post: &pb.Post{
+			Id:         testPostId,
+			OwnerId:    testUserId,
+			Message:    "hello",
+			Visibility: pb.Visibility_public,
+			Time:       timestamppb.Now(),
+		},
+	}
+
+	mux := http.NewServeMux()
+	tf.server = httptest.NewServer(mux)
+	t.Cleanup(tf.server.Close)
+
+	cfg := activitypub.Config{
+		BaseURL: tf.server.URL,
+		WebURL:  "https://example.com",
+		Queue: activitypub.QueueConfig{
+			Backoff:     time.Millisecond * 20,
+			MaxAttempts: 4,
+		},
+		AllowPrivate: allowPrivate,
+	}
+	posts := &fakePosts{posts: []*pb.Post{tf.post}}
+	tf.fed = activitypub.New(cfg, key, tf.store, posts, tf.replies, log.NewNopLogger())
+	mux.Handle("/", tf.fed)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	go tf.fed.Run(ctx)
+
+	return tf
+}
+
+func newRemote(t *testing.T) *aptest.Remote {
+	t.Helper()
+
+	remote, err := aptest.NewRemote()
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(remote.Close)
+
+	return remote
+}
+
+func waitContext(t *testing.T) context.Context {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	t.Cleanup(cancel)
+	return ctx
+}
+
+func send(t *testing.T, remote *aptest.Remote, inbox string, activity any) int {
+	t.Helper()
+
+	res, err := remote.Send(context.Background(), inbox, activity)
+	if err != nil {
+		t.Fatal(err)
+	}
+	io.Copy(io.Discard, res.Body)
+	res.Body.Close()
+
+	return res.StatusCode
+}
+
+func followers(t *testing.T, tf *testFederation) []activitypub.Follower {
+	t.Helper()
+
+	followers, err := tf.store.Followers(context.Background(), testUserId)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return followers
+}
+
+func TestInboxFollow(t *testing.T) {
+
+	tf := newTestFederation(t, true)
+	remote := newRemote(t)
+
+	code := send(t, remote, tf.actor()+"/inbox", remote.Follow(tf.actor()))
+	if code != http.StatusAccepted {
+		t.Fatalf("follow: got status %d, want %d", code, http.StatusAccepted)
+	}
+
+	got := followers(t, tf)
+	if len(got) != 1 || got[0].Actor != remote.ActorId() || got[0].Inbox != remote.Inbox() {
+		t.Fatalf("followers: got %+v", got)
+	}
+
+	received, err := remote.Wait(waitContext(t), 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	accept, err := received[0].Activity()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if accept["type"] != "Accept" || accept["actor"] != tf.actor() {
+		t.Errorf("accept: got %v", accept)
+	}
+	if received[0].Header.Get("Signature") == "" {
+		t.Error("accept is not signed")
+	}
+
+	code = send(t, remote, tf.server.URL+"/inbox", remote.Undo(remote.Follow(tf.actor())))
+	if code != http.StatusAccepted {
+		t.Fatalf("undo: got status %d, want %d", code, http.StatusAccepted)
+	}
+	if got := followers(t, tf); len(got) != 0 {
+		t.Errorf("followers after undo: got %+v", got)
+	}
+}
+
+func TestInboxRejectsTamperedDigest(t *testing.T) {
+
+	tf := newTestFederation(t, true)
+	remote := newRemote(t)
+
+	req, err := remote.Request(context.Background(), tf.actor()+"/inbox", remote.Follow(tf.actor()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Digest", "SHA-256=47DEQpj8HBSa+/TImW+5JCeuQeRkm5NMpJWZG3hSuFU=")
+
+	res, err := remote.Server.Client().Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res.Body.Close()
+
+	if res.StatusCode != http.StatusUnauthorized {
+		t.Errorf("got status %d, want %d", res.StatusCode, http.StatusUnauthorized)
+	}
+	if got := followers(t, tf); len(got) != 0 {
+		t.Errorf("followers: got %+v", got)
+	}
+}
+
+func TestInboxRejectsPrivateActor(t *testing.T) {
+
+	tf := newTestFederation(t, false)
+	remote := newRemote(t)
+
+	code := send(t, remote, tf.actor()+"/inbox", remote.Follow(tf.actor()))
+	if code != http.StatusUnauthorized {
+		t.Errorf("got status %d, want %d", code, http.StatusUnauthorized)
+	}
+}
+
+func TestInboxReply(t *testing.T) {
+
+	tf := newTestFederation(t, true)
+	remote := newRemote(t)
+
+	note := tf.actor() + "/posts/42"
+	reply := remote.Reply("1", note, "<p>first line<br>second &amp; last</p>")
+
+	// remote servers retry, the same reply is saved once.
+	for i := 0; i < 2; i++ {
+		code := send(t, remote, tf.server.URL+"/inbox", reply)
+		if code != http.StatusAccepted {
+			t.Fatalf("reply: got status %d, want %d", code, http.StatusAccepted)
+		}
+	}
+
+	// not a reply to a post of this server.
+	code := send(t, remote, tf.server.URL+"/inbox", remote.Reply("2", remote.Server.URL+"/notes/9", "elsewhere"))
+	if code != http.StatusAccepted {
+		t.Fatalf("other reply: got status %d, want %d", code, http.StatusAccepted)
+	}
+
+	if tf.replies.adds != 2 {
+		t.Errorf("got %d replies, want 2", tf.replies.adds)
+	}
+	if len(tf.replies.comments) != 1 {
+		t.Fatalf("got %d comments, want 1", len(tf.replies.comments))
+	}
+	want := comment{postId: testPostId, actor: remote.ActorId(), message: "first line\nsecond & last"}
+	if got := tf.replies.comments[remote.Server.URL+"/notes/1"]; got != want {
+		t.Errorf("comment: got %+v, want %+v", got, want)
+	}
+}
+
+func TestDeliveryRetry(t *testing.T) {
+
+	tf := newTestFederation(t, true)
+	remote := newRemote(t)
+
+	err := tf.store.AddFollower(context.Background(), testUserId, activitypub.Follower{Actor: remote.ActorId(), Inbox: remote.Inbox()})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the retries wait 20ms and 40ms.
+	remote.Fail(2, http.StatusServiceUnavailable)
+	start := time.Now()
+	tf.fed.PostCreated(tf.post)
+
+	received, err := remote.Wait(waitContext(t), 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if elapsed := time.Since(start); elapsed < time.Millisecond*60 {
+		t.Errorf("delivered after %s, want the backoff of at least 60ms", elapsed)
+	}
+	create, err := received[0].Activity()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if create["type"] != "Create" {
+		t.Errorf("got %v, want a Create", create["type"])
+	}
+
+	// client errors are not retried.
+	remote.Fail(1, http.StatusBadRequest)
+	tf.fed.PostDeleted(tf.post)
+	tf.fed.PostLiked(testUserId, tf.post)
+
+	_, err = remote.Wait(waitContext(t), 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(time.Millisecond * 100)
+	if got := len(remote.Received()); got != 2 {
+		t.Errorf("got %d deliveries, want 2", got)
+	}
+}
+
+func TestDeliveryFromStore(t *testing.T) {
+
+	tf := newTestFederation(t, true)
+	remote := newRemote(t)
+
+	// a delivery left in the store by a stopped instance is sent by the
+	// next poll.
+	err := tf.store.SaveDelivery(context.Background(), activitypub.Delivery{
+		Inbox:        remote.Inbox(),
+		KeyId:        tf.actor() + "#main-key",
+		Body:         []byte(`{"type":"Delete"}`),
+		Id:           gocql.TimeUUID(),
+		Due:          time.Now(),
+		ClaimedUntil: time.Unix(0, 0),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	received, err := remote.Wait(waitContext(t), 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(received[0].Body) != `{"type":"Delete"}` {
+		t.Errorf("got %s", received[0].Body)
+	}
+
+	time.Sleep(time.Millisecond * 100)
+	pending, err := tf.store.PendingDeliveries(context.Background(), time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pending) != 0 || len(remote.Received()) != 1 {
+		t.Errorf("got %d pending and %d received deliveries, want 0 and 1", len(pending), len(remote.Received()))
+	}
+}
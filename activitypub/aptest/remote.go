@@ -0,0 +1,241 @@
+// Package aptest provides a fake remote ActivityPub server to test the
+// federation against in process.
+package aptest
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+
+	"github.com/NexusIT-Dev/nexusmicro_publications/activitypub"
+)
+
+// Received is a request delivered to the inbox of the remote actor.
+type Received struct {
+	Header http.Header
+	Body   []byte
+}
+
+// Activity decodes the delivered activity.
+func (r Received) Activity() (map[string]any, error) {
+	var activity map[string]any
+	err := json.Unmarshal(r.Body, &activity)
+	return activity, err
+}
+
+// Remote is a remote server with one actor. It serves the actor with its
+// key and records the deliveries to its inbox.
+type Remote struct {
+	Server *httptest.Server
+	Key    *rsa.PrivateKey
+
+	signer *activitypub.Signer
+
+	// failures is the number of the next deliveries answered with
+	// failStatus.
+	failures   atomic.Int64
+	failStatus atomic.Int64
+
+	mu       sync.Mutex
+	received []Received
+	notify   chan struct{}
+}
+
+// NewRemote starts the remote server, it must be closed with Close.
+func NewRemote() (*Remote, error) {
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return nil, err
+	}
+
+	r := &Remote{
+		Key:    key,
+		signer: activitypub.NewSigner(key),
+		notify: make(chan struct{}, 1),
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/actor", r.serveActor)
+	mux.HandleFunc("/inbox", r.serveInbox)
+	r.Server = httptest.NewServer(mux)
+
+	return r, nil
+}
+
+func (r *Remote) Close() {
+	r.Server.Close()
+}
+
+// ActorId is the id of the remote actor.
+func (r *Remote) ActorId() string {
+	return r.Server.URL + "/actor"
+}
+
+// Inbox is the inbox of the remote actor.
+func (r *Remote) Inbox() string {
+	return r.Server.URL + "/inbox"
+}
+
+// KeyId is the id of the key the remote actor signs with.
+func (r *Remote) KeyId() string {
+	return r.ActorId() + "#main-key"
+}
+
+func (r *Remote) serveActor(w http.ResponseWriter, req *http.Request) {
+	w.Header().Set("Content-Type", activitypub.ContentType)
+	json.NewEncoder(w).Encode(&activitypub.Actor{
+		Context:           "https://www.w3.org/ns/activitystreams",
+		Id:                r.ActorId(),
+		Type:              "Person",
+		PreferredUsername: "remote",
+		Inbox:             r.Inbox(),
+		PublicKey: &activitypub.PublicKey{
+			Id:           r.KeyId(),
+			Owner:        r.ActorId(),
+			PublicKeyPem: r.signer.PublicKeyPem(),
+		},
+	})
+}
+
+// Fail makes the next n deliveries fail with the status.
+func (r *Remote) Fail(n int, status int) {
+	r.failStatus.Store(int64(status))
+	r.failures.Store(int64(n))
+}
+
+func (r *Remote) serveInbox(w http.ResponseWriter, req *http.Request) {
+
+	if req.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	if r.failures.Add(-1) >= 0 {
+		w.WriteHeader(int(r.failStatus.Load()))
+		return
+	}
+
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	r.mu.Lock()
+	r.received = append(r.received, Received{Header: req.Header.Clone(), Body: body})
+	r.mu.Unlock()
+
+	select {
+	case r.notify <- struct{}{}:
+	default:
+	}
+
+	w.WriteHeader(http.StatusAccepted)
+}
+
+// Received returns the deliveries to the inbox.
+func (r *Remote) Received() []Received {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]Received(nil), r.received...)
+}
+
+// Wait waits until the inbox has received n deliveries.
+func (r *Remote) Wait(ctx context.Context, n int) ([]Received, error) {
+	for {
+		received := r.Received()
+		if len(received) >= n {
+			return received, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return received, fmt.Errorf("aptest: %d of %d deliveries received: %w", len(received), n, ctx.Err())
+		case <-r.notify:
+		}
+	}
+}
+
+// Request returns a request posting the activity to the inbox signed by
+// the remote actor. Tests may tamper with it before sending it with the
+// client of the server.
+func (r *Remote) Request(ctx context.Context, inbox string, activity any) (*http.Request, error) {
+
+	body, err := json.Marshal(activity)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, inbox, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", activitypub.ContentType)
+
+	err = r.signer.Sign(req, r.KeyId(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// Send posts the activity to the inbox signed by the remote actor.
+func (r *Remote) Send(ctx context.Context, inbox string, activity any) (*http.Response, error) {
+
+	req, err := r.Request(ctx, inbox, activity)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.Server.Client().Do(req)
+}
+
+// Follow returns a follow of the actor by the remote actor.
+func (r *Remote) Follow(actor string) map[string]any {
+	return map[string]any{
+		"@context": "https://www.w3.org/ns/activitystreams",
+		"id":       r.Server.URL + "/follows/1",
+		"type":     "Follow",
+		"actor":    r.ActorId(),
+		"object":   actor,
+	}
+}
+
+// Undo returns an undo of the activity by the remote actor.
+func (r *Remote) Undo(activity map[string]any) map[string]any {
+	return map[string]any{
+		"@context": "https://www.w3.org/ns/activitystreams",
+		"id":       fmt.Sprint(activity["id"], "/undo"),
+		"type":     "Undo",
+		"actor":    r.ActorId(),
+		"object":   activity,
+	}
+}
+
+// Reply returns a Create of a note with the id replying to the note.
+func (r *Remote) Reply(id string, note string, content string) map[string]any {
+	return map[string]any{
+		"@context": "https://www.w3.org/ns/activitystreams",
+		"id":       r.Server.URL + "/notes/" + id + "/activity",
+		"type":     "Create",
+		"actor":    r.ActorId(),
+		"object": map[string]any{
+			"id":           r.Server.URL + "/notes/" + id,
+			"type":         "Note",
+			"attributedTo": r.ActorId(),
+			"inReplyTo":    note,
+			"content":      content,
+			"to":           []string{activitypub.Public},
+		},
+	}
+}
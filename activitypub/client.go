@@ -0,0 +1,33 @@
+package activitypub
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/NexusIT-Dev/nexusmicro_publications/netguard"
+)
+
+// maxRedirects limits the redirects of the requests to remote servers.
+const maxRedirects = 3
+
+// NewClient returns the client for the requests to the remote servers.
+// The urls of the remote actors and inboxes are given by anyone who can
+// post to the inbox, so unless allowPrivate is set the client refuses to
+// connect to internal addresses.
+func NewClient(timeout time.Duration, allowPrivate bool) *http.Client {
+	return &http.Client{
+		Timeout: timeout,
+		Transport: &http.Transport{
+			DialContext:         netguard.Dialer(timeout, allowPrivate).DialContext,
+			TLSHandshakeTimeout: timeout,
+			MaxIdleConnsPerHost: 4,
+		},
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if len(via) >= maxRedirects {
+				return fmt.Errorf("activitypub: stopped after %d redirects", maxRedirects)
+			}
+			return nil
+		},
+	}
+}
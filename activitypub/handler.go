@@ -0,0 +1,444 @@
+package activitypub
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/go-kit/log/level"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// outboxPageSize is the number of the posts in a page of an outbox.
+const outboxPageSize = 20
+
+// maxReplyLength limits the text of the replies saved as comments.
+const maxReplyLength = 5000
+
+// ServeHTTP serves the webfinger, the actors with their outboxes,
+// followers and inboxes, the notes of the public posts and the shared
+// inbox:
+//
+//	/.well-known/webfinger?resource=acct:id{id}@{host}
+//	/users/{id}
+//	/users/{id}/outbox
+//	/users/{id}/followers
+//	/users/{id}/inbox
+//	/users/{id}/posts/{post_id}
+//	/inbox
+func (f *Federation) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+
+	if r.URL.Path == "/.well-known/webfinger" {
+		f.webfinger(w, r)
+		return
+	}
+
+	if r.URL.Path == "/inbox" {
+		f.inbox(w, r)
+		return
+	}
+
+	parts := strings.Split(strings.TrimPrefix(r.URL.Path, "/users/"), "/")
+	if !strings.HasPrefix(r.URL.Path, "/users/") || len(parts) > 3 {
+		http.NotFound(w, r)
+		return
+	}
+
+	user_id, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil || user_id <= 0 {
+		http.NotFound(w, r)
+		return
+	}
+
+	if len(parts) == 2 && parts[1] == "inbox" {
+		f.inbox(w, r)
+		return
+	}
+
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	switch {
+	case len(parts) == 1:
+		f.actor(w, r, user_id)
+	case len(parts) == 2 && parts[1] == "outbox":
+		f.outbox(w, r, user_id)
+	case len(parts) == 2 && parts[1] == "followers":
+		f.followers(w, r, user_id)
+	case len(parts) == 3 && parts[1] == "posts":
+		post_id, err := strconv.ParseUint(parts[2], 10, 64)
+		if err != nil {
+			http.NotFound(w, r)
+			return
+		}
+		f.noteHandler(w, r, user_id, post_id)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func (f *Federation) webfinger(w http.ResponseWriter, r *http.Request) {
+
+	resource := strings.TrimPrefix(r.URL.Query().Get("resource"), "acct:")
+	name, host, _ := strings.Cut(resource, "@")
+
+	base, err := url.Parse(f.cfg.BaseURL)
+	if err != nil || host != base.Host || !strings.HasPrefix(name, "id") {
+		http.NotFound(w, r)
+		return
+	}
+
+	user_id, err := strconv.ParseInt(strings.TrimPrefix(name, "id"), 10, 64)
+	if err != nil || user_id <= 0 {
+		http.NotFound(w, r)
+		return
+	}
+
+	user, err := f.posts.User(r.Context(), user_id)
+	if err != nil {
+		f.writeError(w, err)
+		return
+	}
+	if user == nil {
+		http.NotFound(w, r)
+		return
+	}
+
+	writeJSON(w, "application/jrd+json", map[string]any{
+		"subject": "acct:" + resource,
+		"aliases": []string{f.actorURL(user_id)},
+		"links": []map[string]string{
+			{"rel": "self", "type": ContentType, "href": f.actorURL(user_id)},
+			{"rel": "http://webfinger.net/rel/profile-page", "type": "text/html", "href": f.cfg.WebURL + "/users/" + strconv.FormatInt(user_id, 10)},
+		},
+	})
+}
+
+func (f *Federation) actor(w http.ResponseWriter, r *http.Request, user_id int64) {
+
+	// browsers are sent to the profile in the web app.
+	if !acceptsActivity(r) && f.cfg.WebURL != "" {
+		http.Redirect(w, r, f.cfg.WebURL+"/users/"+strconv.FormatInt(user_id, 10), http.StatusFound)
+		return
+	}
+
+	user, err := f.posts.User(r.Context(), user_id)
+	if err != nil {
+		f.writeError(w, err)
+		return
+	}
+	if user == nil {
+		http.NotFound(w, r)
+		return
+	}
+
+	id := f.actorURL(user_id)
+
+	writeJSON(w, ContentType, &Actor{
+		Context:           []string{activityStreams, securityContext},
+		Id:                id,
+		Type:              "Person",
+		PreferredUsername: "id" + strconv.FormatInt(user_id, 10),
+		Name:              strings.TrimSpace(user.Name + " " + user.Lastname),
+		Summary:           noteContent(user.GetDescription()),
+		Url:               f.cfg.WebURL + "/users/" + strconv.FormatInt(user_id, 10),
+		Inbox:             id + "/inbox",
+		Outbox:            id + "/outbox",
+		Followers:         id + "/followers",
+		Endpoints:         &Endpoints{SharedInbox: f.cfg.BaseURL + "/inbox"},
+		PublicKey: &PublicKey{
+			Id:           f.keyId(user_id),
+			Owner:        id,
+			PublicKeyPem: f.signer.PublicKeyPem(),
+		},
+	})
+}
+
+// outbox serves the Create activities of the public posts of the user,
+// the pages go from the latest posts by max_id.
+func (f *Federation) outbox(w http.ResponseWriter, r *http.Request, user_id int64) {
+
+	id := f.actorURL(user_id) + "/outbox"
+
+	query := r.URL.Query()
+	if query.Get("page") != "true" {
+		writeJSON(w, ContentType, &OrderedCollection{
+			Context: activityStreams,
+			Id:      id,
+			Type:    "OrderedCollection",
+			First:   id + "?page=true",
+		})
+		return
+	}
+
+	var max_id uint64
+	if v := query.Get("max_id"); v != "" {
+		var err error
+		max_id, err = strconv.ParseUint(v, 10, 64)
+		if err != nil {
+			http.Error(w, "invalid max_id", http.StatusBadRequest)
+			return
+		}
+	}
+
+	posts, err := f.posts.UserPosts(r.Context(), user_id, max_id, outboxPageSize)
+	if err != nil {
+		f.writeError(w, err)
+		return
+	}
+
+	page := &OrderedCollection{
+		Context:      activityStreams,
+		Id:           id + "?" + r.URL.RawQuery,
+		Type:         "OrderedCollectionPage",
+		PartOf:       id,
+		OrderedItems: []any{},
+	}
+	for _, post := range posts {
+		activity := f.create(post)
+		activity.Context = nil
+		page.OrderedItems = append(page.OrderedItems, activity)
+	}
+	if len(posts) == outboxPageSize {
+		page.Next = id + "?page=true&max_id=" + strconv.FormatUint(posts[len(posts)-1].Id, 10)
+	}
+
+	writeJSON(w, ContentType, page)
+}
+
+// followers serves the number of the followers, the followers
+// themselves are not listed.
+func (f *Federation) followers(w http.ResponseWriter, r *http.Request, user_id int64) {
+
+	followers, err := f.store.Followers(r.Context(), user_id)
+	if err != nil {
+		f.writeError(w, err)
+		return
+	}
+
+	total := int64(len(followers))
+
+	writeJSON(w, ContentType, &OrderedCollection{
+		Context:    activityStreams,
+		Id:         f.actorURL(user_id) + "/followers",
+		Type:       "OrderedCollection",
+		TotalItems: &total,
+	})
+}
+
+func (f *Federation) noteHandler(w http.ResponseWriter, r *http.Request, user_id int64, post_id uint64) {
+
+	post, err := f.posts.Post(r.Context(), post_id)
+	if err != nil {
+		f.writeError(w, err)
+		return
+	}
+	if post == nil || post.OwnerId != user_id {
+		http.NotFound(w, r)
+		return
+	}
+
+	if !acceptsActivity(r) && f.cfg.WebURL != "" {
+		http.Redirect(w, r, f.cfg.WebURL+"/posts/"+strconv.FormatUint(post_id, 10), http.StatusFound)
+		return
+	}
+
+	note := f.note(post)
+	note.Context = activityStreams
+
+	writeJSON(w, ContentType, note)
+}
+
+// inbox accepts the signed activities of the remote actors: follows and
+// their undos, and replies to the public posts. The other activities
+// are accepted and ignored. The inboxes of the users and the shared
+// inbox are the same, the followed user is the object of the follow.
+func (f *Federation) inbox(w http.ResponseWriter, r *http.Request) {
+
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", "POST")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxDocumentSize+1))
+	if err != nil {
+		http.Error(w, "failed to read body", http.StatusBadRequest)
+		return
+	}
+	if len(body) > maxDocumentSize {
+		http.Error(w, "activity is too large", http.StatusRequestEntityTooLarge)
+		return
+	}
+
+	actor, err := f.keys.Verify(r.Context(), r, body)
+	if err != nil {
+		level.Debug(f.logger).Log("msg", "inbox signature rejected", "err", err)
+		http.Error(w, "invalid signature", http.StatusUnauthorized)
+		return
+	}
+
+	var activity inboundActivity
+	err = json.Unmarshal(body, &activity)
+	if err != nil {
+		http.Error(w, "invalid activity", http.StatusBadRequest)
+		return
+	}
+
+	// the activity must be of the actor who signed it.
+	if activity.Actor != actor.Id {
+		http.Error(w, "actor does not match the signature", http.StatusForbidden)
+		return
+	}
+
+	switch activity.Type {
+	case "Follow":
+		err = f.follow(r.Context(), actor, activity, body)
+	case "Undo":
+		err = f.undo(r.Context(), actor, activity)
+	case "Create":
+		err = f.reply(r.Context(), actor, activity)
+	}
+	if err != nil {
+		f.writeError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusAccepted)
+}
+
+// errNotFound is returned for activities with objects that are not on
+// this server.
+var errNotFound = errors.New("activitypub: object not found")
+
+// followedUser returns the user of an actor id of this server.
+func (f *Federation) followedUser(id string) (int64, bool) {
+
+	rest, ok := strings.CutPrefix(id, f.cfg.BaseURL+"/users/")
+	if !ok {
+		return 0, false
+	}
+
+	user_id, err := strconv.ParseInt(rest, 10, 64)
+	if err != nil || user_id <= 0 {
+		return 0, false
+	}
+
+	return user_id, true
+}
+
+func (f *Federation) follow(ctx context.Context, actor *RemoteActor, activity inboundActivity, body []byte) error {
+
+	user_id, ok := f.followedUser(objectId(activity.Object))
+	if !ok {
+		return errNotFound
+	}
+
+	user, err := f.posts.User(ctx, user_id)
+	if err != nil {
+		return err
+	}
+	if user == nil {
+		return errNotFound
+	}
+
+	inbox := actor.SharedInbox
+	if inbox == "" {
+		inbox = actor.Inbox
+	}
+
+	err = f.store.AddFollower(ctx, user_id, Follower{Actor: actor.Id, Inbox: inbox})
+	if err != nil {
+		return err
+	}
+
+	accept, err := json.Marshal(f.accept(user_id, body))
+	if err != nil {
+		return err
+	}
+	f.queue.Enqueue(Delivery{Inbox: actor.Inbox, KeyId: f.keyId(user_id), Body: accept})
+
+	return nil
+}
+
+func (f *Federation) undo(ctx context.Context, actor *RemoteActor, activity inboundActivity) error {
+
+	var follow inboundActivity
+	if json.Unmarshal(activity.Object, &follow) != nil || follow.Type != "Follow" {
+		return nil
+	}
+
+	user_id, ok := f.followedUser(objectId(follow.Object))
+	if !ok {
+		return nil
+	}
+
+	return f.store.RemoveFollower(ctx, user_id, actor.Id)
+}
+
+// reply saves a note replying to a post of this server as a comment.
+func (f *Federation) reply(ctx context.Context, actor *RemoteActor, activity inboundActivity) error {
+
+	var note Note
+	if json.Unmarshal(activity.Object, &note) != nil || note.Type != "Note" || note.Id == "" {
+		return nil
+	}
+
+	_, post_id, ok := f.parseNoteURL(note.InReplyTo)
+	if !ok {
+		return nil
+	}
+
+	// the note must be of the actor who created it.
+	if note.AttributedTo != "" && note.AttributedTo != actor.Id {
+		return nil
+	}
+
+	message := []rune(plainText(note.Content))
+	if len(message) > maxReplyLength {
+		message = message[:maxReplyLength]
+	}
+
+	return f.replies.Add(ctx, post_id, note.Id, actor.Id, string(message))
+}
+
+func (f *Federation) writeError(w http.ResponseWriter, err error) {
+
+	if err == errNotFound {
+		http.Error(w, "not found", http.StatusNotFound)
+		return
+	}
+
+	switch status.Code(err) {
+	case codes.NotFound, codes.PermissionDenied:
+		http.Error(w, "not found", http.StatusNotFound)
+	case codes.InvalidArgument:
+		http.Error(w, status.Convert(err).Message(), http.StatusBadRequest)
+	case codes.Unavailable:
+		http.Error(w, "service unavailable", http.StatusServiceUnavailable)
+	default:
+		level.Error(f.logger).Log("msg", "activitypub request failed", "err", err)
+		http.Error(w, "internal error", http.StatusInternalServerError)
+	}
+}
+
+// acceptsActivity tells if the request wants an ActivityPub document
+// rather than a page.
+func acceptsActivity(r *http.Request) bool {
+	accept := r.Header.Get("Accept")
+	return strings.Contains(accept, "activity+json") || strings.Contains(accept, "ld+json")
+}
+
+func writeJSON(w http.ResponseWriter, contentType string, v any) {
+	w.Header().Set("Content-Type", contentType)
+	json.NewEncoder(w).Encode(v)
+}
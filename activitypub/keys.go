@@ -0,0 +1,208 @@
+package activitypub
+
+import (
+	"context"
+	"crypto/rsa"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/NexusIT-Dev/nexusmicro_publications/netguard"
+)
+
+// actorCacheTTL is how long the fetched remote actors are cached.
+const actorCacheTTL = time.Hour
+
+// minRefreshInterval limits how often an actor is fetched again because
+// of a signature that does not verify with the cached key.
+const minRefreshInterval = time.Minute
+
+// maxDocumentSize limits the size of the fetched and received documents.
+const maxDocumentSize = 1 << 20
+
+// RemoteActor is a fetched remote actor with its key.
+type RemoteActor struct {
+	Id          string
+	Inbox       string
+	SharedInbox string
+	Key         *rsa.PublicKey
+}
+
+type cachedActor struct {
+	actor   *RemoteActor
+	fetched time.Time
+}
+
+// KeyFetcher fetches and caches the remote actors and their keys. The
+// actors and their inboxes must be https urls of public addresses
+// unless allowPrivate is set.
+type KeyFetcher struct {
+	client       *http.Client
+	allowPrivate bool
+
+	mu    sync.Mutex
+	cache map[string]cachedActor
+}
+
+func NewKeyFetcher(client *http.Client, allowPrivate bool) *KeyFetcher {
+	return &KeyFetcher{
+		client:       client,
+		allowPrivate: allowPrivate,
+		cache:        make(map[string]cachedActor),
+	}
+}
+
+// Actor returns the actor with the id or of the key with the id. With
+// refresh the cached actor is fetched again, like when the key was
+// rotated, but not more often than minRefreshInterval.
+func (k *KeyFetcher) Actor(ctx context.Context, id string, refresh bool) (*RemoteActor, error) {
+
+	// the keys are fragments or paths of the actors.
+	id, _, _ = strings.Cut(id, "#")
+
+	k.mu.Lock()
+	cached, ok := k.cache[id]
+	k.mu.Unlock()
+	if ok {
+		age := time.Since(cached.fetched)
+		if (!refresh && age < actorCacheTTL) || (refresh && age < minRefreshInterval) {
+			return cached.actor, nil
+		}
+	}
+
+	err := netguard.CheckURL(ctx, id, k.allowPrivate)
+	if err != nil {
+		return nil, fmt.Errorf("activitypub: actor %s: %w", id, err)
+	}
+
+	actor, err := k.fetch(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	k.mu.Lock()
+	k.cache[id] = cachedActor{actor: actor, fetched: time.Now()}
+	k.mu.Unlock()
+
+	return actor, nil
+}
+
+func (k *KeyFetcher) fetch(ctx context.Context, id string) (*RemoteActor, error) {
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, id, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", ContentType)
+
+	resp, err := k.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("activitypub: fetch %s: %s", id, resp.Status)
+	}
+
+	var doc struct {
+		Id        string     `json:"id"`
+		Inbox     string     `json:"inbox"`
+		Endpoints *Endpoints `json:"endpoints"`
+		PublicKey *PublicKey `json:"publicKey"`
+	}
+	err = json.NewDecoder(io.LimitReader(resp.Body, maxDocumentSize)).Decode(&doc)
+	if err != nil {
+		return nil, err
+	}
+
+	// some servers serve the key itself at its id.
+	if doc.PublicKey == nil {
+		return nil, errors.New("activitypub: actor has no public key")
+	}
+	if doc.Id == "" {
+		doc.Id = doc.PublicKey.Owner
+	}
+
+	key, err := ParsePublicKey(doc.PublicKey.PublicKeyPem)
+	if err != nil {
+		return nil, err
+	}
+
+	// the actor must be on the server the key was fetched from, and the
+	// activities are delivered only to public servers.
+	if !sameHost(doc.Id, id) {
+		return nil, fmt.Errorf("activitypub: actor %s is not on the host of %s", doc.Id, id)
+	}
+	err = netguard.CheckURL(ctx, doc.Inbox, k.allowPrivate)
+	if err != nil {
+		return nil, fmt.Errorf("activitypub: inbox %s: %w", doc.Inbox, err)
+	}
+
+	actor := &RemoteActor{Id: doc.Id, Inbox: doc.Inbox, Key: key}
+	if doc.Endpoints != nil && doc.Endpoints.SharedInbox != "" {
+		if netguard.CheckURL(ctx, doc.Endpoints.SharedInbox, k.allowPrivate) == nil {
+			actor.SharedInbox = doc.Endpoints.SharedInbox
+		}
+	}
+
+	return actor, nil
+}
+
+// Verify checks the signature of an inbound request and returns the
+// actor that signed it. The headers are checked before the key is
+// fetched, a failed check is retried with the refetched actor.
+func (k *KeyFetcher) Verify(ctx context.Context, req *http.Request, body []byte) (*RemoteActor, error) {
+
+	header := req.Header.Get("Signature")
+	if header == "" {
+		return nil, ErrNoSignature
+	}
+
+	sig, err := parseSignature(header)
+	if err != nil {
+		return nil, err
+	}
+
+	err = sig.check(req, body)
+	if err != nil {
+		return nil, err
+	}
+
+	actor, err := k.Actor(ctx, sig.keyId, false)
+	if err != nil {
+		return nil, err
+	}
+
+	err = sig.verify(req, actor.Key)
+	if err == ErrInvalidSignature {
+		actor, err = k.Actor(ctx, sig.keyId, true)
+		if err != nil {
+			return nil, err
+		}
+		err = sig.verify(req, actor.Key)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return actor, nil
+}
+
+func sameHost(a, b string) bool {
+	ua, err := url.Parse(a)
+	if err != nil {
+		return false
+	}
+	ub, err := url.Parse(b)
+	if err != nil {
+		return false
+	}
+	return ua.Host != "" && ua.Host == ub.Host
+}
@@ -0,0 +1,239 @@
+package activitypub
+
+import (
+	"encoding/json"
+	"html"
+	"mime"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/NexusIT-Dev/nexusmicro_publications/pb"
+	xhtml "golang.org/x/net/html"
+)
+
+type Actor struct {
+	Context           any        `json:"@context,omitempty"`
+	Id                string     `json:"id"`
+	Type              string     `json:"type"`
+	PreferredUsername string     `json:"preferredUsername,omitempty"`
+	Name              string     `json:"name,omitempty"`
+	Summary           string     `json:"summary,omitempty"`
+	Url               string     `json:"url,omitempty"`
+	Inbox             string     `json:"inbox"`
+	Outbox            string     `json:"outbox,omitempty"`
+	Followers         string     `json:"followers,omitempty"`
+	Endpoints         *Endpoints `json:"endpoints,omitempty"`
+	PublicKey         *PublicKey `json:"publicKey,omitempty"`
+}
+
+type Endpoints struct {
+	SharedInbox string `json:"sharedInbox,omitempty"`
+}
+
+type PublicKey struct {
+	Id           string `json:"id"`
+	Owner        string `json:"owner"`
+	PublicKeyPem string `json:"publicKeyPem"`
+}
+
+type Note struct {
+	Context      any          `json:"@context,omitempty"`
+	Id           string       `json:"id"`
+	Type         string       `json:"type"`
+	AttributedTo string       `json:"attributedTo,omitempty"`
+	InReplyTo    string       `json:"inReplyTo,omitempty"`
+	Content      string       `json:"content,omitempty"`
+	Published    string       `json:"published,omitempty"`
+	Url          string       `json:"url,omitempty"`
+	To           []string     `json:"to,omitempty"`
+	Cc           []string     `json:"cc,omitempty"`
+	Attachment   []Attachment `json:"attachment,omitempty"`
+}
+
+type Attachment struct {
+	Type      string `json:"type"`
+	MediaType string `json:"mediaType,omitempty"`
+	Url       string `json:"url"`
+	Name      string `json:"name,omitempty"`
+}
+
+// Activity is an activity with its object. Inbound activities keep the
+// object as is, it is either an id or an object.
+type Activity struct {
+	Context   any      `json:"@context,omitempty"`
+	Id        string   `json:"id"`
+	Type      string   `json:"type"`
+	Actor     string   `json:"actor"`
+	Published string   `json:"published,omitempty"`
+	To        []string `json:"to,omitempty"`
+	Cc        []string `json:"cc,omitempty"`
+	Object    any      `json:"object"`
+}
+
+type inboundActivity struct {
+	Id     string          `json:"id"`
+	Type   string          `json:"type"`
+	Actor  string          `json:"actor"`
+	Object json.RawMessage `json:"object"`
+}
+
+type OrderedCollection struct {
+	Context      any    `json:"@context,omitempty"`
+	Id           string `json:"id"`
+	Type         string `json:"type"`
+	TotalItems   *int64 `json:"totalItems,omitempty"`
+	First        string `json:"first,omitempty"`
+	PartOf       string `json:"partOf,omitempty"`
+	Next         string `json:"next,omitempty"`
+	OrderedItems []any  `json:"orderedItems,omitempty"`
+}
+
+// objectId returns the id of an object that is either an id or an
+// object with an id.
+func objectId(raw json.RawMessage) string {
+
+	var id string
+	if json.Unmarshal(raw, &id) == nil {
+		return id
+	}
+
+	var obj struct {
+		Id string `json:"id"`
+	}
+	json.Unmarshal(raw, &obj)
+
+	return obj.Id
+}
+
+func (f *Federation) note(post *pb.Post) *Note {
+
+	note := &Note{
+		Id:           f.noteURL(post.OwnerId, post.Id),
+		Type:         "Note",
+		AttributedTo: f.actorURL(post.OwnerId),
+		Content:      noteContent(post.Message),
+		Url:          f.cfg.WebURL + "/posts/" + strconv.FormatUint(post.Id, 10),
+		To:           []string{Public},
+		Cc:           []string{f.actorURL(post.OwnerId) + "/followers"},
+	}
+	if post.Time != nil {
+		note.Published = post.Time.AsTime().UTC().Format(time.RFC3339)
+	}
+
+	for _, a := range post.Attachments {
+		if a.Url == "" {
+			continue
+		}
+
+		name := a.GetInfo().GetFileName()
+		mediatype := mime.TypeByExtension(strings.ToLower(path.Ext(name)))
+
+		typ := "Document"
+		if a.Type == pb.AttachmentType_photo {
+			typ = "Image"
+		} else if a.Type == pb.AttachmentType_video {
+			typ = "Video"
+		}
+
+		note.Attachment = append(note.Attachment, Attachment{Type: typ, MediaType: mediatype, Url: a.Url, Name: name})
+	}
+
+	return note
+}
+
+func (f *Federation) create(post *pb.Post) *Activity {
+
+	note := f.note(post)
+
+	return &Activity{
+		Context:   activityStreams,
+		Id:        note.Id + "/activity",
+		Type:      "Create",
+		Actor:     note.AttributedTo,
+		Published: note.Published,
+		To:        note.To,
+		Cc:        note.Cc,
+		Object:    note,
+	}
+}
+
+func (f *Federation) delete(post *pb.Post) *Activity {
+
+	id := f.noteURL(post.OwnerId, post.Id)
+
+	return &Activity{
+		Context: activityStreams,
+		Id:      id + "#delete",
+		Type:    "Delete",
+		Actor:   f.actorURL(post.OwnerId),
+		To:      []string{Public},
+		Object:  map[string]string{"id": id, "type": "Tombstone"},
+	}
+}
+
+func (f *Federation) like(user_id int64, post *pb.Post) *Activity {
+	return &Activity{
+		Context: activityStreams,
+		Id:      f.actorURL(user_id) + "/likes/" + strconv.FormatUint(post.Id, 10),
+		Type:    "Like",
+		Actor:   f.actorURL(user_id),
+		Object:  f.noteURL(post.OwnerId, post.Id),
+	}
+}
+
+func (f *Federation) accept(user_id int64, follow json.RawMessage) *Activity {
+	return &Activity{
+		Context: activityStreams,
+		Id:      f.actorURL(user_id) + "#accepts/" + strconv.FormatInt(time.Now().UnixNano(), 36),
+		Type:    "Accept",
+		Actor:   f.actorURL(user_id),
+		Object:  follow,
+	}
+}
+
+// noteContent returns the message as html, the paragraphs of the
+// message are separated by empty lines.
+func noteContent(message string) string {
+
+	paragraphs := strings.Split(strings.TrimSpace(message), "\n\n")
+
+	buf := &strings.Builder{}
+	for _, p := range paragraphs {
+		if p = strings.TrimSpace(p); p == "" {
+			continue
+		}
+		buf.WriteString("<p>")
+		buf.WriteString(strings.ReplaceAll(html.EscapeString(p), "\n", "<br>"))
+		buf.WriteString("</p>")
+	}
+
+	return buf.String()
+}
+
+// plainText returns the text of the html content of a remote note.
+func plainText(content string) string {
+
+	buf := &strings.Builder{}
+
+	z := xhtml.NewTokenizer(strings.NewReader(content))
+	for {
+		switch z.Next() {
+		case xhtml.ErrorToken:
+			return strings.TrimSpace(buf.String())
+		case xhtml.TextToken:
+			buf.Write(z.Text())
+		case xhtml.StartTagToken, xhtml.SelfClosingTagToken:
+			name, _ := z.TagName()
+			if string(name) == "br" {
+				buf.WriteString("\n")
+			}
+		case xhtml.EndTagToken:
+			name, _ := z.TagName()
+			if string(name) == "p" {
+				buf.WriteString("\n\n")
+			}
+		}
+	}
+}
@@ -0,0 +1,302 @@
+package activitypub
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/go-kit/kit/metrics"
+	"github.com/go-kit/kit/metrics/discard"
+	"github.com/go-kit/log"
+	"github.com/go-kit/log/level"
+	"github.com/gocql/gocql"
+)
+
+type QueueConfig struct {
+	// Workers is the number of the concurrent deliveries.
+	Workers int
+	// MaxAttempts is how many times a delivery is tried.
+	MaxAttempts int
+	// Backoff is the delay before the first retry, it doubles with every
+	// attempt up to MaxBackoff.
+	Backoff    time.Duration
+	MaxBackoff time.Duration
+	// Timeout is the timeout of a delivery request.
+	Timeout time.Duration
+	// PollInterval is how often the store is read for the deliveries
+	// that are due, by default Backoff but at most 10 seconds.
+	PollInterval time.Duration
+	// Dropped counts the deliveries that are given up, by the reason
+	// label: store, rejected or attempts.
+	Dropped metrics.Counter
+}
+
+func (cfg QueueConfig) withDefaults() QueueConfig {
+	if cfg.Workers <= 0 {
+		cfg.Workers = 4
+	}
+	if cfg.MaxAttempts <= 0 {
+		cfg.MaxAttempts = 8
+	}
+	if cfg.Backoff <= 0 {
+		cfg.Backoff = time.Second * 30
+	}
+	if cfg.MaxBackoff <= 0 {
+		cfg.MaxBackoff = time.Hour * 2
+	}
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = time.Second * 30
+	}
+	if cfg.PollInterval <= 0 {
+		cfg.PollInterval = cfg.Backoff
+		if cfg.PollInterval > time.Second*10 {
+			cfg.PollInterval = time.Second * 10
+		}
+	}
+	if cfg.Dropped == nil {
+		cfg.Dropped = discard.NewCounter()
+	}
+	return cfg
+}
+
+// queueSize is the number of the deliveries waiting for a worker.
+const queueSize = 1024
+
+// Delivery is an activity signed with the key for the inbox.
+type Delivery struct {
+	Inbox string
+	KeyId string
+	Body  []byte
+
+	// Id, Attempt, Due and ClaimedUntil are set by the queue.
+	Id      gocql.UUID
+	Attempt int
+	Due     time.Time
+	// ClaimedUntil is the time until which a queue sends the delivery,
+	// the other queues leave it alone until then.
+	ClaimedUntil time.Time
+}
+
+// Queue delivers the activities to the remote inboxes and retries the
+// failed deliveries with an exponential backoff. The deliveries are kept
+// in the store until they are sent or given up, so they survive restarts
+// and the instances of the service share them. A delivery is claimed
+// before it is sent, so only one instance sends it.
+type Queue struct {
+	client *http.Client
+	signer *Signer
+	store  Store
+	cfg    QueueConfig
+	logger log.Logger
+
+	queue chan Delivery
+
+	// queued are the deliveries in the queue or being sent.
+	mu     sync.Mutex
+	queued map[gocql.UUID]bool
+}
+
+func NewQueue(client *http.Client, signer *Signer, store Store, cfg QueueConfig, logger log.Logger) *Queue {
+	return &Queue{
+		client: client,
+		signer: signer,
+		store:  store,
+		cfg:    cfg.withDefaults(),
+		logger: logger,
+		queue:  make(chan Delivery, queueSize),
+		queued: make(map[gocql.UUID]bool),
+	}
+}
+
+// Run delivers the queued activities and polls the store for the due
+// ones until the context is done.
+func (q *Queue) Run(ctx context.Context) {
+
+	wg := sync.WaitGroup{}
+	for i := 0; i < q.cfg.Workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case d := <-q.queue:
+					q.deliver(ctx, d)
+
+					q.mu.Lock()
+					delete(q.queued, d.Id)
+					q.mu.Unlock()
+				}
+			}
+		}()
+	}
+
+	ticker := time.NewTicker(q.cfg.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			wg.Wait()
+			return
+		case <-ticker.C:
+			q.poll(ctx)
+		}
+	}
+}
+
+// Enqueue stores the delivery and queues it. When the queue is full the
+// delivery waits in the store for the next poll.
+func (q *Queue) Enqueue(d Delivery) {
+
+	d.Id = gocql.TimeUUID()
+	d.Attempt = 0
+	d.Due = time.Now().Truncate(time.Millisecond)
+	d.ClaimedUntil = unclaimed
+
+	ctx, cancel := context.WithTimeout(context.Background(), q.cfg.Timeout)
+	defer cancel()
+
+	err := q.store.SaveDelivery(ctx, d)
+	if err != nil {
+		level.Error(q.logger).Log("msg", "failed to store delivery, activity dropped", "inbox", d.Inbox, "err", err)
+		q.cfg.Dropped.With("reason", "store").Add(1)
+		return
+	}
+
+	if !q.push(d) {
+		level.Debug(q.logger).Log("msg", "delivery queue is full, delivery is left for the next poll", "inbox", d.Inbox)
+	}
+}
+
+// push hands the delivery to the workers unless it is queued already, it
+// returns false when the queue is full.
+func (q *Queue) push(d Delivery) bool {
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.queued[d.Id] {
+		return true
+	}
+
+	select {
+	case q.queue <- d:
+		q.queued[d.Id] = true
+		return true
+	default:
+		return false
+	}
+}
+
+// poll queues the stored deliveries that are due.
+func (q *Queue) poll(ctx context.Context) {
+
+	deliveries, err := q.store.PendingDeliveries(ctx, time.Now())
+	if err != nil {
+		level.Error(q.logger).Log("msg", "failed to read pending deliveries", "err", err)
+		return
+	}
+
+	for _, d := range deliveries {
+		if !q.push(d) {
+			return
+		}
+	}
+}
+
+func (q *Queue) deliver(ctx context.Context, d Delivery) {
+
+	// the claim outlives the request, so the delivery is not sent twice
+	// unless the instance stops while sending it.
+	until := time.Now().Add(2 * q.cfg.Timeout).Truncate(time.Millisecond)
+	claimed, err := q.store.ClaimDelivery(ctx, d, until)
+	if err != nil {
+		level.Error(q.logger).Log("msg", "failed to claim delivery", "inbox", d.Inbox, "err", err)
+		return
+	}
+	if !claimed {
+		return
+	}
+	d.ClaimedUntil = until
+
+	d.Attempt++
+
+	retry, err := q.send(ctx, d)
+	if err == nil {
+		q.delete(ctx, d)
+		return
+	}
+
+	if !retry || d.Attempt >= q.cfg.MaxAttempts {
+		level.Warn(q.logger).Log("msg", "failed to deliver activity", "inbox", d.Inbox, "attempt", d.Attempt, "err", err)
+		reason := "attempts"
+		if !retry {
+			reason = "rejected"
+		}
+		q.cfg.Dropped.With("reason", reason).Add(1)
+		q.delete(ctx, d)
+		return
+	}
+
+	level.Debug(q.logger).Log("msg", "delivery will be retried", "inbox", d.Inbox, "attempt", d.Attempt, "err", err)
+
+	backoff := q.cfg.Backoff << (d.Attempt - 1)
+	if backoff > q.cfg.MaxBackoff || backoff <= 0 {
+		backoff = q.cfg.MaxBackoff
+	}
+
+	// if the delivery is not released, it is retried when the claim
+	// expires.
+	err = q.store.RetryDelivery(ctx, d, time.Now().Add(backoff).Truncate(time.Millisecond))
+	if err != nil {
+		level.Error(q.logger).Log("msg", "failed to schedule delivery retry", "inbox", d.Inbox, "err", err)
+	}
+}
+
+func (q *Queue) delete(ctx context.Context, d Delivery) {
+	err := q.store.DeleteDelivery(ctx, d)
+	if err != nil {
+		level.Error(q.logger).Log("msg", "failed to delete delivery", "inbox", d.Inbox, "err", err)
+	}
+}
+
+// send posts the delivery, the error is retried when the server may
+// accept it later.
+func (q *Queue) send(ctx context.Context, d Delivery) (bool, error) {
+
+	ctx, cancel := context.WithTimeout(ctx, q.cfg.Timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, d.Inbox, bytes.NewReader(d.Body))
+	if err != nil {
+		return false, err
+	}
+	req.Header.Set("Content-Type", ContentType)
+
+	err = q.signer.Sign(req, d.KeyId, d.Body)
+	if err != nil {
+		return false, err
+	}
+
+	resp, err := q.client.Do(req)
+	if err != nil {
+		return true, err
+	}
+	io.Copy(io.Discard, io.LimitReader(resp.Body, maxDocumentSize))
+	resp.Body.Close()
+
+	switch {
+	case resp.StatusCode < 300:
+		return false, nil
+	case resp.StatusCode >= 500, resp.StatusCode == http.StatusRequestTimeout, resp.StatusCode == http.StatusTooManyRequests:
+		return true, fmt.Errorf("activitypub: delivery: %s", resp.Status)
+	default:
+		return false, fmt.Errorf("activitypub: delivery: %s", resp.Status)
+	}
+}
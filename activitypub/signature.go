@@ -0,0 +1,227 @@
+package activitypub
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// maxClockSkew is how far the Date of a signed request may be from now.
+const maxClockSkew = time.Hour * 12
+
+var (
+	ErrNoSignature      = errors.New("activitypub: request is not signed")
+	ErrInvalidSignature = errors.New("activitypub: invalid signature")
+)
+
+// Signer signs requests with HTTP Signatures (draft-cavage-http-signatures)
+// and rsa-sha256, the scheme the fediverse uses.
+type Signer struct {
+	key    *rsa.PrivateKey
+	keyPem string
+}
+
+func NewSigner(key *rsa.PrivateKey) *Signer {
+
+	der, _ := x509.MarshalPKIXPublicKey(&key.PublicKey)
+
+	return &Signer{
+		key:    key,
+		keyPem: string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})),
+	}
+}
+
+// PublicKeyPem returns the public key in the form of publicKeyPem.
+func (s *Signer) PublicKeyPem() string {
+	return s.keyPem
+}
+
+// Sign sets the Date, Digest and Signature headers of the request with
+// the body. GET requests have no digest.
+func (s *Signer) Sign(req *http.Request, keyId string, body []byte) error {
+
+	req.Header.Set("Date", time.Now().UTC().Format(http.TimeFormat))
+	if req.Host == "" {
+		req.Host = req.URL.Host
+	}
+
+	headers := []string{"(request-target)", "host", "date"}
+	if req.Method != http.MethodGet && req.Method != http.MethodHead {
+		req.Header.Set("Digest", digest(body))
+		headers = append(headers, "digest")
+	}
+
+	hash := sha256.Sum256([]byte(signingString(req, headers)))
+	sig, err := rsa.SignPKCS1v15(nil, s.key, crypto.SHA256, hash[:])
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Signature", `keyId="`+keyId+`",algorithm="rsa-sha256",headers="`+strings.Join(headers, " ")+`",signature="`+base64.StdEncoding.EncodeToString(sig)+`"`)
+
+	return nil
+}
+
+func digest(body []byte) string {
+	sum := sha256.Sum256(body)
+	return "SHA-256=" + base64.StdEncoding.EncodeToString(sum[:])
+}
+
+func signingString(req *http.Request, headers []string) string {
+
+	lines := make([]string, 0, len(headers))
+	for _, h := range headers {
+		switch h {
+		case "(request-target)":
+			lines = append(lines, h+": "+strings.ToLower(req.Method)+" "+req.URL.RequestURI())
+		case "host":
+			lines = append(lines, h+": "+req.Host)
+		default:
+			lines = append(lines, h+": "+strings.Join(req.Header.Values(h), ", "))
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// signature is the parsed Signature header.
+type signature struct {
+	keyId     string
+	algorithm string
+	headers   []string
+	signature []byte
+}
+
+func parseSignature(header string) (*signature, error) {
+
+	sig := &signature{headers: []string{"date"}}
+
+	for _, param := range strings.Split(header, ",") {
+		key, value, ok := strings.Cut(strings.TrimSpace(param), "=")
+		if !ok {
+			return nil, ErrInvalidSignature
+		}
+		value = strings.Trim(value, `"`)
+
+		switch key {
+		case "keyId":
+			sig.keyId = value
+		case "algorithm":
+			sig.algorithm = value
+		case "headers":
+			sig.headers = strings.Fields(strings.ToLower(value))
+		case "signature":
+			b, err := base64.StdEncoding.DecodeString(value)
+			if err != nil {
+				return nil, ErrInvalidSignature
+			}
+			sig.signature = b
+		}
+	}
+
+	if sig.keyId == "" || len(sig.signature) == 0 {
+		return nil, ErrInvalidSignature
+	}
+	if sig.algorithm != "" && sig.algorithm != "rsa-sha256" && sig.algorithm != "hs2019" {
+		return nil, ErrInvalidSignature
+	}
+
+	return sig, nil
+}
+
+// check checks the signed headers of a request before the key is
+// fetched: the request target, the host and the date must be signed,
+// and the digest of the body for requests with a body.
+func (sig *signature) check(req *http.Request, body []byte) error {
+
+	signed := make(map[string]bool)
+	for _, h := range sig.headers {
+		signed[h] = true
+	}
+	if !signed["(request-target)"] || !signed["host"] || !signed["date"] {
+		return ErrInvalidSignature
+	}
+
+	date, err := http.ParseTime(req.Header.Get("Date"))
+	if err != nil || time.Since(date) > maxClockSkew || time.Until(date) > maxClockSkew {
+		return ErrInvalidSignature
+	}
+
+	if body != nil {
+		if !signed["digest"] || !bytes.Equal([]byte(req.Header.Get("Digest")), []byte(digest(body))) {
+			return ErrInvalidSignature
+		}
+	}
+
+	return nil
+}
+
+// verify checks the signature of a request made with the key.
+func (sig *signature) verify(req *http.Request, key *rsa.PublicKey) error {
+
+	hash := sha256.Sum256([]byte(signingString(req, sig.headers)))
+	err := rsa.VerifyPKCS1v15(key, crypto.SHA256, hash[:], sig.signature)
+	if err != nil {
+		return ErrInvalidSignature
+	}
+
+	return nil
+}
+
+// ParsePublicKey parses a publicKeyPem, PKIX or PKCS#1.
+func ParsePublicKey(data string) (*rsa.PublicKey, error) {
+
+	block, _ := pem.Decode([]byte(data))
+	if block == nil {
+		return nil, errors.New("activitypub: no pem block in the public key")
+	}
+
+	if key, err := x509.ParsePKCS1PublicKey(block.Bytes); err == nil {
+		return key, nil
+	}
+
+	key, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+
+	rsakey, ok := key.(*rsa.PublicKey)
+	if !ok {
+		return nil, errors.New("activitypub: public key is not rsa")
+	}
+
+	return rsakey, nil
+}
+
+// ParsePrivateKey parses a pem rsa key, PKCS#1 or PKCS#8.
+func ParsePrivateKey(data []byte) (*rsa.PrivateKey, error) {
+
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("activitypub: no pem block in the private key")
+	}
+
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+
+	rsakey, ok := key.(*rsa.PrivateKey)
+	if !ok {
+		return nil, errors.New("activitypub: private key is not rsa")
+	}
+
+	return rsakey, nil
+}
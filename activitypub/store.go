@@ -0,0 +1,231 @@
+package activitypub
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/gocql/gocql"
+)
+
+// Follower is a remote actor following a user.
+type Follower struct {
+	Actor string
+	Inbox string
+}
+
+// Store keeps the remote followers of the users and the deliveries
+// waiting to be sent.
+type Store interface {
+	AddFollower(ctx context.Context, user_id int64, follower Follower) error
+	RemoveFollower(ctx context.Context, user_id int64, actor string) error
+	Followers(ctx context.Context, user_id int64) ([]Follower, error)
+
+	// SaveDelivery stores a new delivery, it stays pending until it is
+	// deleted.
+	SaveDelivery(ctx context.Context, d Delivery) error
+	// PendingDeliveries returns the deliveries due at now that are not
+	// claimed or whose claim expired, oldest first.
+	PendingDeliveries(ctx context.Context, now time.Time) ([]Delivery, error)
+	// ClaimDelivery claims the delivery until the time. It fails if the
+	// delivery was claimed by another queue since it was read.
+	ClaimDelivery(ctx context.Context, d Delivery, until time.Time) (bool, error)
+	// RetryDelivery releases the claimed delivery to be sent again at
+	// due.
+	RetryDelivery(ctx context.Context, d Delivery, due time.Time) error
+	DeleteDelivery(ctx context.Context, d Delivery) error
+}
+
+// unclaimed is the ClaimedUntil of the deliveries nobody sends.
+var unclaimed = time.Unix(0, 0)
+
+// deliveriesBucket is the partition of ap_deliveries, the deliveries are
+// deleted once sent, so all of them fit in one.
+const deliveriesBucket = 0
+
+// CQLStore keeps the followers in the ap_followers table and the
+// deliveries in ap_deliveries.
+type CQLStore struct {
+	cses *gocql.Session
+}
+
+func NewCQLStore(cses *gocql.Session) *CQLStore {
+	return &CQLStore{cses: cses}
+}
+
+func (s *CQLStore) AddFollower(ctx context.Context, user_id int64, follower Follower) error {
+	return s.cses.Query(`INSERT INTO ap_followers (user_id, actor, inbox) VALUES (?, ?, ?)`,
+		user_id, follower.Actor, follower.Inbox).WithContext(ctx).Exec()
+}
+
+func (s *CQLStore) RemoveFollower(ctx context.Context, user_id int64, actor string) error {
+	return s.cses.Query(`DELETE FROM ap_followers WHERE user_id = ? AND actor = ?`,
+		user_id, actor).WithContext(ctx).Exec()
+}
+
+func (s *CQLStore) Followers(ctx context.Context, user_id int64) ([]Follower, error) {
+
+	iter := s.cses.Query(`SELECT actor, inbox FROM ap_followers WHERE user_id = ?`, user_id).WithContext(ctx).Iter()
+
+	var followers []Follower
+	var follower Follower
+	for iter.Scan(&follower.Actor, &follower.Inbox) {
+		followers = append(followers, follower)
+	}
+
+	return followers, iter.Close()
+}
+
+func (s *CQLStore) SaveDelivery(ctx context.Context, d Delivery) error {
+	return s.cses.Query(`INSERT INTO ap_deliveries (bucket, id, inbox, key_id, body, attempt, due, claimed_until) VALUES (?, ?, ?, ?, ?, ?, ?, ?)`,
+		deliveriesBucket, d.Id, d.Inbox, d.KeyId, d.Body, d.Attempt, d.Due, d.ClaimedUntil).WithContext(ctx).Exec()
+}
+
+func (s *CQLStore) PendingDeliveries(ctx context.Context, now time.Time) ([]Delivery, error) {
+
+	iter := s.cses.Query(`SELECT id, inbox, key_id, body, attempt, due, claimed_until FROM ap_deliveries WHERE bucket = ?`, deliveriesBucket).WithContext(ctx).Iter()
+
+	var deliveries []Delivery
+	var d Delivery
+	for iter.Scan(&d.Id, &d.Inbox, &d.KeyId, &d.Body, &d.Attempt, &d.Due, &d.ClaimedUntil) {
+		if !d.Due.After(now) && !d.ClaimedUntil.After(now) {
+			deliveries = append(deliveries, d)
+		}
+		d = Delivery{}
+	}
+
+	return deliveries, iter.Close()
+}
+
+func (s *CQLStore) ClaimDelivery(ctx context.Context, d Delivery, until time.Time) (bool, error) {
+	return s.cses.Query(`UPDATE ap_deliveries SET claimed_until = ? WHERE bucket = ? AND id = ? IF claimed_until = ?`,
+		until, deliveriesBucket, d.Id, d.ClaimedUntil).WithContext(ctx).MapScanCAS(make(map[string]interface{}))
+}
+
+func (s *CQLStore) RetryDelivery(ctx context.Context, d Delivery, due time.Time) error {
+	_, err := s.cses.Query(`UPDATE ap_deliveries SET attempt = ?, due = ?, claimed_until = ? WHERE bucket = ? AND id = ? IF claimed_until = ?`,
+		d.Attempt, due, unclaimed, deliveriesBucket, d.Id, d.ClaimedUntil).WithContext(ctx).MapScanCAS(make(map[string]interface{}))
+	return err
+}
+
+func (s *CQLStore) DeleteDelivery(ctx context.Context, d Delivery) error {
+	return s.cses.Query(`DELETE FROM ap_deliveries WHERE bucket = ? AND id = ?`,
+		deliveriesBucket, d.Id).WithContext(ctx).Exec()
+}
+
+// MemoryStore keeps the followers and the deliveries in memory, it is
+// for development and tests only.
+type MemoryStore struct {
+	mu         sync.Mutex
+	followers  map[int64]map[string]string
+	deliveries map[gocql.UUID]Delivery
+}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{followers: make(map[int64]map[string]string), deliveries: make(map[gocql.UUID]Delivery)}
+}
+func (s *MemoryStore) AddFollower(ctx context.Context, user_id int64, follower Follower) error {
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.followers[user_id] == nil {
+		s.followers[user_id] = make(map[string]string)
+	}
+	s.followers[user_id][follower.Actor] = follower.Inbox
+
+	return nil
+}
+
+func (s *MemoryStore) RemoveFollower(ctx context.Context, user_id int64, actor string) error {
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.followers[user_id], actor)
+
+	return nil
+}
+
+func (s *MemoryStore) Followers(ctx context.Context, user_id int64) ([]Follower, error) {
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	followers := make([]Follower, 0, len(s.followers[user_id]))
+	for actor, inbox := range s.followers[user_id] {
+		followers = append(followers, Follower{Actor: actor, Inbox: inbox})
+	}
+	sort.Slice(followers, func(i, j int) bool { return followers[i].Actor < followers[j].Actor })
+
+	return followers, nil
+}
+
+func (s *MemoryStore) SaveDelivery(ctx context.Context, d Delivery) error {
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.deliveries[d.Id] = d
+
+	return nil
+}
+
+func (s *MemoryStore) PendingDeliveries(ctx context.Context, now time.Time) ([]Delivery, error) {
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	deliveries := make([]Delivery, 0)
+	for _, d := range s.deliveries {
+		if !d.Due.After(now) && !d.ClaimedUntil.After(now) {
+			deliveries = append(deliveries, d)
+		}
+	}
+	sort.Slice(deliveries, func(i, j int) bool { return deliveries[i].Id.Time().Before(deliveries[j].Id.Time()) })
+
+	return deliveries, nil
+}
+
+func (s *MemoryStore) ClaimDelivery(ctx context.Context, d Delivery, until time.Time) (bool, error) {
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stored, ok := s.deliveries[d.Id]
+	if !ok || !stored.ClaimedUntil.Equal(d.ClaimedUntil) {
+		return false, nil
+	}
+	stored.ClaimedUntil = until
+	s.deliveries[d.Id] = stored
+
+	return true, nil
+}
+
+func (s *MemoryStore) RetryDelivery(ctx context.Context, d Delivery, due time.Time) error {
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stored, ok := s.deliveries[d.Id]
+	if !ok || !stored.ClaimedUntil.Equal(d.ClaimedUntil) {
+		return nil
+	}
+	stored.Attempt = d.Attempt
+	stored.Due = due
+	stored.ClaimedUntil = unclaimed
+	s.deliveries[d.Id] = stored
+
+	return nil
+}
+
+func (s *MemoryStore) DeleteDelivery(ctx context.Context, d Delivery) error {
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.deliveries, d.Id)
+
+	return nil
+}
@@ -0,0 +1,57 @@
+package main
+
+import (
+	"context"
+
+	"github.com/NexusIT-Dev/nexusmicro_publications/pb"
+	"github.com/NexusIT-Dev/nexusmicro_publications/service"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// apPosts reads the posts and the users for the federation. Posts are
+// read as a guest, so only public posts are federated.
+type apPosts struct {
+	srv      pb.PostsServer
+	userscli pb.UsersClient
+}
+
+func guestContext(ctx context.Context) context.Context {
+	return service.WithPrincipal(ctx, &service.Principal{Kind: service.PrincipalGuest})
+}
+
+func (p *apPosts) UserPosts(ctx context.Context, user_id int64, last_id uint64, limit int64) ([]*pb.Post, error) {
+
+	res, err := p.srv.GetPostsUser(guestContext(ctx), &pb.GetPostsUserRequest{UserId: user_id, LastId: last_id, Limit: limit})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Posts, nil
+}
+
+func (p *apPosts) Post(ctx context.Context, post_id uint64) (*pb.Post, error) {
+
+	res, err := p.srv.GetPostById(guestContext(ctx), &pb.GetPostByIdRequest{Id: post_id})
+	if status.Code(err) == codes.NotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Post, nil
+}
+
+func (p *apPosts) User(ctx context.Context, user_id int64) (*pb.User, error) {
+
+	res, err := p.userscli.GetUsersByIds(ctx, &pb.GetUsersByIdsRequest{Ids: []int64{user_id}, Fields: []pb.UserFields{pb.UserFields_description}})
+	if err != nil {
+		return nil, err
+	}
+	if len(res.Users) == 0 {
+		return nil, nil
+	}
+
+	return res.Users[0], nil
+}
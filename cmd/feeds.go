@@ -0,0 +1,97 @@
+package main
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/NexusIT-Dev/nexusmicro_publications/pb"
+	"github.com/NexusIT-Dev/nexusmicro_publications/syndication"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// feedSize is the number of the latest posts in a feed.
+const feedSize = 50
+
+// feedHandler serves /users/{id}/feed.rss and /users/{id}/feed.atom.
+// Posts are loaded by GetPostsUser on behalf of the caller, so readers
+// without a token get the public posts only.
+type feedHandler struct {
+	gw *gateway
+	// webURL is the address of the web app the links point to, the
+	// address of the request if empty.
+	webURL string
+}
+
+func (h *feedHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	parts := strings.Split(strings.TrimPrefix(r.URL.Path, "/users/"), "/")
+	if len(parts) != 2 || (parts[1] != "feed.rss" && parts[1] != "feed.atom") {
+		http.NotFound(w, r)
+		return
+	}
+
+	user_id, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil || user_id <= 0 {
+		http.NotFound(w, r)
+		return
+	}
+
+	res, err := h.gw.invoke(r, "GetPostsUser", &pb.GetPostsUserRequest{UserId: user_id, Limit: feedSize, Extended: true})
+	if err != nil {
+		h.gw.writeError(w, err)
+		return
+	}
+
+	weburl := h.webURL
+	if weburl == "" {
+		weburl = requestOrigin(r)
+	}
+
+	feed := syndication.PostsFeed(user_id, res.(*pb.GetPostsUserResponse).Posts, weburl, requestOrigin(r)+r.URL.Path)
+
+	var data []byte
+	if parts[1] == "feed.rss" {
+		data, err = syndication.RSS(feed)
+		w.Header().Set("Content-Type", "application/rss+xml; charset=utf-8")
+	} else {
+		data, err = syndication.Atom(feed)
+		w.Header().Set("Content-Type", "application/atom+xml; charset=utf-8")
+	}
+	if err != nil {
+		h.gw.writeError(w, status.Error(codes.Internal, err.Error()))
+		return
+	}
+
+	// the feed of a user with a token depends on the user.
+	if r.Header.Get("Authorization") != "" {
+		w.Header().Set("Cache-Control", "private, no-cache")
+		w.Header().Set("Vary", "Authorization")
+	} else {
+		w.Header().Set("Cache-Control", "public, no-cache")
+	}
+
+	sum := sha256.Sum256(data)
+	w.Header().Set("ETag", `"`+hex.EncodeToString(sum[:16])+`"`)
+
+	// ServeContent answers conditional requests by the ETag and by the
+	// time of the latest post.
+	http.ServeContent(w, r, "", feed.Updated, bytes.NewReader(data))
+}
+
+func requestOrigin(r *http.Request) string {
+	if r.TLS != nil {
+		return "https://" + r.Host
+	}
+	return "http://" + r.Host
+}
@@ -0,0 +1,475 @@
+package main
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/NexusIT-Dev/nexusmicro_publications/pb"
+	"github.com/go-kit/log"
+	"github.com/go-kit/log/level"
+	"google.golang.org/genproto/googleapis/api/annotations"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// maxGatewayBody is the limit of a request body, the default limit of a
+// grpc message.
+const maxGatewayBody = 4 << 20
+
+var (
+	gatewayMarshal   = protojson.MarshalOptions{EmitUnpopulated: true}
+	gatewayUnmarshal = protojson.UnmarshalOptions{DiscardUnknown: true}
+)
+
+// gatewayRoute is an RPC bound to an HTTP route by google.api.http.
+type gatewayRoute struct {
+	fullMethod string
+	input      protoreflect.MessageDescriptor
+	// body is the request field set from the body, "*" for the whole
+	// request and "" if the request has no body.
+	body   string
+	unary  *grpc.MethodDesc
+	stream *grpc.StreamDesc
+}
+
+// gateway serves the google.api.http routes of posts.proto with JSON
+// transcoding. Requests are handled in process by the same interceptors
+// as the grpc server, the Authorization header is passed as metadata.
+// Query parameters set the request fields by name, nested fields are
+// separated by dots. Server streams are sent as newline delimited JSON
+// objects with a result or an error.
+type gateway struct {
+	srv    pb.PostsServer
+	unary  []grpc.UnaryServerInterceptor
+	stream []grpc.StreamServerInterceptor
+	logger log.Logger
+	routes map[string]*gatewayRoute
+}
+
+func newGateway(srv pb.PostsServer, unary []grpc.UnaryServerInterceptor, stream []grpc.StreamServerInterceptor, logger log.Logger) (*gateway, error) {
+
+	g := &gateway{
+		srv:    srv,
+		unary:  unary,
+		stream: stream,
+		logger: logger,
+		routes: make(map[string]*gatewayRoute),
+	}
+
+	desc := pb.File_posts_proto.Services().ByName(protoreflect.Name(pb.Posts_ServiceDesc.ServiceName))
+
+	for i := 0; i < desc.Methods().Len(); i++ {
+		m := desc.Methods().Get(i)
+
+		rule, ok := proto.GetExtension(m.Options(), annotations.E_Http).(*annotations.HttpRule)
+		if !ok || rule == nil {
+			continue
+		}
+
+		var method, path string
+		switch p := rule.Pattern.(type) {
+		case *annotations.HttpRule_Get:
+			method, path = http.MethodGet, p.Get
+		case *annotations.HttpRule_Post:
+			method, path = http.MethodPost, p.Post
+		case *annotations.HttpRule_Put:
+			method, path = http.MethodPut, p.Put
+		case *annotations.HttpRule_Delete:
+			method, path = http.MethodDelete, p.Delete
+		case *annotations.HttpRule_Patch:
+			method, path = http.MethodPatch, p.Patch
+		default:
+			return nil, fmt.Errorf("gateway: unsupported http rule of %s", m.Name())
+		}
+		if strings.Contains(path, "{") {
+			return nil, fmt.Errorf("gateway: path variables are not supported, %s", path)
+		}
+		if rule.Body != "" && rule.Body != "*" && m.Input().Fields().ByName(protoreflect.Name(rule.Body)) == nil {
+			return nil, fmt.Errorf("gateway: unknown body field %s of %s", rule.Body, m.Name())
+		}
+
+		route := &gatewayRoute{
+			fullMethod: "/" + pb.Posts_ServiceDesc.ServiceName + "/" + string(m.Name()),
+			input:      m.Input(),
+			body:       rule.Body,
+		}
+
+		for j := range pb.Posts_ServiceDesc.Methods {
+			if pb.Posts_ServiceDesc.Methods[j].MethodName == string(m.Name()) {
+				route.unary = &pb.Posts_ServiceDesc.Methods[j]
+			}
+		}
+		for j := range pb.Posts_ServiceDesc.Streams {
+			if pb.Posts_ServiceDesc.Streams[j].StreamName == string(m.Name()) {
+				route.stream = &pb.Posts_ServiceDesc.Streams[j]
+			}
+		}
+		if route.unary == nil && (route.stream == nil || !route.stream.ServerStreams || route.stream.ClientStreams) {
+			return nil, fmt.Errorf("gateway: unsupported method %s", m.Name())
+		}
+
+		g.routes[method+" "+path] = route
+	}
+
+	return g, nil
+}
+
+func (g *gateway) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+
+	route, ok := g.routes[r.Method+" "+r.URL.Path]
+	if !ok {
+		g.writeError(w, status.Error(codes.NotFound, "unknown route "+r.Method+" "+r.URL.Path))
+		return
+	}
+
+	ctx := incomingContext(r)
+
+	decode := func(v interface{}) error {
+		err := decodeRequest(r, route.body, v.(proto.Message))
+		if err != nil {
+			return status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil
+	}
+
+	if route.stream != nil {
+		g.serveStream(ctx, w, route, decode)
+		return
+	}
+
+	res, err := route.unary.Handler(g.srv, ctx, decode, g.chainUnary())
+	if err != nil {
+		g.writeError(w, err)
+		return
+	}
+
+	data, err := gatewayMarshal.Marshal(res.(proto.Message))
+	if err != nil {
+		g.writeError(w, status.Error(codes.Internal, err.Error()))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(data)
+}
+
+// invoke calls the unary RPC with the request on behalf of the caller
+// of the http request.
+func (g *gateway) invoke(r *http.Request, method string, req proto.Message) (proto.Message, error) {
+
+	for i := range pb.Posts_ServiceDesc.Methods {
+		desc := &pb.Posts_ServiceDesc.Methods[i]
+		if desc.MethodName != method {
+			continue
+		}
+
+		decode := func(v interface{}) error {
+			proto.Merge(v.(proto.Message), req)
+			return nil
+		}
+
+		res, err := desc.Handler(g.srv, incomingContext(r), decode, g.chainUnary())
+		if err != nil {
+			return nil, err
+		}
+
+		return res.(proto.Message), nil
+	}
+
+	return nil, status.Error(codes.Unimplemented, "unknown method "+method)
+}
+
+// incomingContext returns the context of the request with the
+// Authorization header as grpc metadata.
+func incomingContext(r *http.Request) context.Context {
+
+	md := metadata.MD{}
+	if h := r.Header.Get("Authorization"); h != "" {
+		md.Set("authorization", h)
+	}
+
+	return metadata.NewIncomingContext(r.Context(), md)
+}
+
+func (g *gateway) serveStream(ctx context.Context, w http.ResponseWriter, route *gatewayRoute, decode func(interface{}) error) {
+
+	ss := &gatewayStream{ctx: ctx, w: w, decode: decode}
+	info := &grpc.StreamServerInfo{FullMethod: route.fullMethod, IsServerStream: true}
+
+	err := g.chainStream()(g.srv, ss, info, route.stream.Handler)
+	if err == nil {
+		return
+	}
+	if !ss.started {
+		g.writeError(w, err)
+		return
+	}
+
+	// the status is already sent, the error ends the stream.
+	data, _ := gatewayMarshal.Marshal(status.Convert(err).Proto())
+	fmt.Fprintf(w, "{\"error\":%s}\n", data)
+}
+
+// chainUnary returns the unary interceptors as one, the first one is
+// the outermost like in grpc.ChainUnaryInterceptor.
+func (g *gateway) chainUnary() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		for i := len(g.unary) - 1; i >= 0; i-- {
+			interceptor, next := g.unary[i], handler
+			handler = func(ctx context.Context, req interface{}) (interface{}, error) {
+				return interceptor(ctx, req, info, next)
+			}
+		}
+		return handler(ctx, req)
+	}
+}
+
+// chainStream is chainUnary for the stream interceptors.
+func (g *gateway) chainStream() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		for i := len(g.stream) - 1; i >= 0; i-- {
+			interceptor, next := g.stream[i], handler
+			handler = func(srv interface{}, ss grpc.ServerStream) error {
+				return interceptor(srv, ss, info, next)
+			}
+		}
+		return handler(srv, ss)
+	}
+}
+
+func (g *gateway) writeError(w http.ResponseWriter, err error) {
+
+	st := status.Convert(err)
+	if httpStatus(st.Code()) == http.StatusInternalServerError {
+		level.Error(g.logger).Log("msg", "gateway request failed", "err", err)
+	}
+
+	data, err := gatewayMarshal.Marshal(st.Proto())
+	if err != nil {
+		http.Error(w, st.Message(), httpStatus(st.Code()))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(httpStatus(st.Code()))
+	w.Write(data)
+}
+
+// httpStatus maps the grpc status codes of service/errors.go to http.
+func httpStatus(code codes.Code) int {
+	switch code {
+	case codes.OK:
+		return http.StatusOK
+	case codes.Canceled:
+		return 499
+	case codes.InvalidArgument, codes.FailedPrecondition, codes.OutOfRange:
+		return http.StatusBadRequest
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.AlreadyExists, codes.Aborted:
+		return http.StatusConflict
+	case codes.ResourceExhausted:
+		return http.StatusTooManyRequests
+	case codes.Unimplemented:
+		return http.StatusNotImplemented
+	case codes.Unavailable:
+		return http.StatusServiceUnavailable
+	case codes.DeadlineExceeded:
+		return http.StatusGatewayTimeout
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// decodeRequest sets the request from the query parameters and the body.
+func decodeRequest(r *http.Request, body string, req proto.Message) error {
+
+	err := setQuery(req.ProtoReflect(), r.URL.Query())
+	if err != nil {
+		return err
+	}
+
+	if body == "" {
+		return nil
+	}
+
+	data, err := io.ReadAll(http.MaxBytesReader(nil, r.Body, maxGatewayBody))
+	if err != nil {
+		return err
+	}
+	if len(data) == 0 {
+		return nil
+	}
+
+	if body == "*" {
+		return gatewayUnmarshal.Unmarshal(data, req)
+	}
+
+	m := req.ProtoReflect()
+	fd := m.Descriptor().Fields().ByName(protoreflect.Name(body))
+	if fd.Kind() != protoreflect.MessageKind || fd.IsList() || fd.IsMap() {
+		return fmt.Errorf("body field %s must be a message", body)
+	}
+
+	return gatewayUnmarshal.Unmarshal(data, m.Mutable(fd).Message().Interface())
+}
+
+func setQuery(m protoreflect.Message, values url.Values) error {
+
+	for key, vals := range values {
+		if len(vals) == 0 {
+			continue
+		}
+
+		msg := m
+		parts := strings.Split(key, ".")
+		for _, part := range parts[:len(parts)-1] {
+			fd := findField(msg.Descriptor(), part)
+			if fd == nil || fd.Kind() != protoreflect.MessageKind || fd.IsList() || fd.IsMap() {
+				return fmt.Errorf("unknown parameter %s", key)
+			}
+			msg = msg.Mutable(fd).Message()
+		}
+
+		fd := findField(msg.Descriptor(), parts[len(parts)-1])
+		if fd == nil || fd.IsMap() {
+			return fmt.Errorf("unknown parameter %s", key)
+		}
+
+		if fd.IsList() {
+			list := msg.Mutable(fd).List()
+			for _, s := range vals {
+				v, err := parseValue(fd, s, list.NewElement)
+				if err != nil {
+					return fmt.Errorf("invalid parameter %s: %w", key, err)
+				}
+				list.Append(v)
+			}
+			continue
+		}
+
+		v, err := parseValue(fd, vals[len(vals)-1], func() protoreflect.Value { return msg.NewField(fd) })
+		if err != nil {
+			return fmt.Errorf("invalid parameter %s: %w", key, err)
+		}
+		msg.Set(fd, v)
+	}
+
+	return nil
+}
+
+// findField finds a field by its proto or json name.
+func findField(md protoreflect.MessageDescriptor, name string) protoreflect.FieldDescriptor {
+	if fd := md.Fields().ByName(protoreflect.Name(name)); fd != nil {
+		return fd
+	}
+	return md.Fields().ByJSONName(name)
+}
+
+// parseValue parses a query parameter, messages like Timestamp are
+// parsed from their json string form.
+func parseValue(fd protoreflect.FieldDescriptor, s string, newValue func() protoreflect.Value) (protoreflect.Value, error) {
+
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		v, err := strconv.ParseBool(s)
+		return protoreflect.ValueOfBool(v), err
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		v, err := strconv.ParseInt(s, 10, 32)
+		return protoreflect.ValueOfInt32(int32(v)), err
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		v, err := strconv.ParseInt(s, 10, 64)
+		return protoreflect.ValueOfInt64(v), err
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		v, err := strconv.ParseUint(s, 10, 32)
+		return protoreflect.ValueOfUint32(uint32(v)), err
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		v, err := strconv.ParseUint(s, 10, 64)
+		return protoreflect.ValueOfUint64(v), err
+	case protoreflect.FloatKind:
+		v, err := strconv.ParseFloat(s, 32)
+		return protoreflect.ValueOfFloat32(float32(v)), err
+	case protoreflect.DoubleKind:
+		v, err := strconv.ParseFloat(s, 64)
+		return protoreflect.ValueOfFloat64(v), err
+	case protoreflect.StringKind:
+		return protoreflect.ValueOfString(s), nil
+	case protoreflect.BytesKind:
+		v, err := base64.StdEncoding.DecodeString(s)
+		return protoreflect.ValueOfBytes(v), err
+	case protoreflect.EnumKind:
+		if ev := fd.Enum().Values().ByName(protoreflect.Name(s)); ev != nil {
+			return protoreflect.ValueOfEnum(ev.Number()), nil
+		}
+		v, err := strconv.ParseInt(s, 10, 32)
+		return protoreflect.ValueOfEnum(protoreflect.EnumNumber(v)), err
+	case protoreflect.MessageKind:
+		v := newValue()
+		data, _ := json.Marshal(s)
+		err := gatewayUnmarshal.Unmarshal(data, v.Message().Interface())
+		return v, err
+	default:
+		return protoreflect.Value{}, fmt.Errorf("unsupported type %s", fd.Kind())
+	}
+}
+
+// gatewayStream is the grpc.ServerStream of a server streaming RPC
+// called through the gateway.
+type gatewayStream struct {
+	ctx     context.Context
+	w       http.ResponseWriter
+	decode  func(interface{}) error
+	started bool
+}
+
+func (s *gatewayStream) SetHeader(metadata.MD) error  { return nil }
+func (s *gatewayStream) SendHeader(metadata.MD) error { return nil }
+func (s *gatewayStream) SetTrailer(metadata.MD)       {}
+
+func (s *gatewayStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *gatewayStream) RecvMsg(m interface{}) error {
+	return s.decode(m)
+}
+
+func (s *gatewayStream) SendMsg(m interface{}) error {
+
+	data, err := gatewayMarshal.Marshal(m.(proto.Message))
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	if !s.started {
+		s.w.Header().Set("Content-Type", "application/x-ndjson")
+		s.started = true
+	}
+
+	_, err = fmt.Fprintf(s.w, "{\"result\":%s}\n", data)
+	if err != nil {
+		return err
+	}
+
+	if f, ok := s.w.(http.Flusher); ok {
+		f.Flush()
+	}
+
+	return nil
+}
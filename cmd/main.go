@@ -1,17 +1,31 @@
 package main
 
 import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/tls"
 	"fmt"
 	"net"
 	"net/http"
 	"os"
 	"os/signal"
+	"strconv"
+	"strings"
 	"syscall"
 	"time"
 
+	"github.com/NexusIT-Dev/nexusmicro_publications/activitypub"
+	"github.com/NexusIT-Dev/nexusmicro_publications/creds"
+	"github.com/NexusIT-Dev/nexusmicro_publications/jwks"
 	"github.com/NexusIT-Dev/nexusmicro_publications/middleware"
+	"github.com/NexusIT-Dev/nexusmicro_publications/openapi"
 	"github.com/NexusIT-Dev/nexusmicro_publications/pb"
+	"github.com/NexusIT-Dev/nexusmicro_publications/pubsub"
+	"github.com/NexusIT-Dev/nexusmicro_publications/ranking"
+	"github.com/NexusIT-Dev/nexusmicro_publications/search"
 	"github.com/NexusIT-Dev/nexusmicro_publications/service"
+	"github.com/NexusIT-Dev/nexusmicro_publications/unfurl"
 	kitprometheus "github.com/go-kit/kit/metrics/prometheus"
 	"github.com/go-kit/log"
 	"github.com/go-kit/log/level"
@@ -21,11 +35,32 @@ import (
 	stdprometheus "github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
 )
 
 const (
 	bucketDuration = time.Hour * 3
+
+	unfurlTimeout  = time.Second * 10
+	unfurlCacheTTL = time.Hour * 24
+
+	trendingSize = 500
+
+	// a few snapshots a day, so every day has one
+	followerSnapshotInterval = time.Hour * 6
+
+	jwksRefreshInterval = time.Minute * 5
+
+	defaultAnonymousRPCs = "GetPostById,GetPostsUser,GetCommentsList"
+
+	certReloadInterval = time.Minute
+
+	// events buffered for a subscriber of live updates before it is dropped.
+	subscriberBuffer = 256
+
+	apKeyBits       = 2048
+	apClientTimeout = time.Second * 30
 )
 
 var (
@@ -79,8 +114,48 @@ func main() {
 	}
 	defer cses.Close()
 
+	// tls, the certificate serves the grpc server and is the client
+	// certificate for the downstream services
+	var tlsstore *creds.Store
+	if os.Getenv("TLS_CERT_FILE") != "" || os.Getenv("TLS_CA_FILE") != "" {
+		tlsstore, err = creds.NewStore(creds.Files{
+			Cert: os.Getenv("TLS_CERT_FILE"),
+			Key:  os.Getenv("TLS_KEY_FILE"),
+			CA:   os.Getenv("TLS_CA_FILE"),
+		})
+		if err != nil {
+			level.Error(logger).Log("err", err)
+			return
+		}
+		go tlsstore.Run(context.Background(), certReloadInterval, logger)
+	}
+
+	// mTLS, without a CA client certificates would be verified against
+	// the system roots and any public certificate accepted
+	clientauth := os.Getenv("TLS_CLIENT_AUTH") == "require"
+	if clientauth && (os.Getenv("TLS_CERT_FILE") == "" || os.Getenv("TLS_CA_FILE") == "") {
+		level.Error(logger).Log("err", "TLS_CLIENT_AUTH=require requires TLS_CERT_FILE and TLS_CA_FILE")
+		return
+	}
+
+	downstreamtls := os.Getenv("DOWNSTREAM_TLS") == "true"
+	dialopts := []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
+	if downstreamtls {
+		if tlsstore == nil {
+			level.Error(logger).Log("err", "DOWNSTREAM_TLS requires TLS_CA_FILE")
+			return
+		}
+		dialopts = []grpc.DialOption{grpc.WithTransportCredentials(credentials.NewTLS(tlsstore.ClientConfig()))}
+	}
+
+	// service account token, sent to the downstream services when there
+	// is no user token to forward
+	if os.Getenv("SERVICE_TOKEN_FILE") != "" {
+		dialopts = append(dialopts, grpc.WithPerRPCCredentials(creds.NewServiceToken(os.Getenv("SERVICE_TOKEN_FILE"), downstreamtls)))
+	}
+
 	// storage service
-	conn, err := grpc.Dial(os.Getenv("STORAGE_URL"), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	conn, err := grpc.Dial(os.Getenv("STORAGE_URL"), dialopts...)
 	if err != nil {
 		level.Error(logger).Log("err", err)
 		return
@@ -88,7 +163,7 @@ func main() {
 	defer conn.Close()
 	storagecli := pb.NewStorageClient(conn)
 
-	conn1, err := grpc.Dial(os.Getenv("USERS_URL"), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	conn1, err := grpc.Dial(os.Getenv("USERS_URL"), dialopts...)
 	if err != nil {
 		level.Error(logger).Log("err", err)
 		return
@@ -96,7 +171,7 @@ func main() {
 	defer conn1.Close()
 	userscli := pb.NewUsersClient(conn1)
 
-	conn2, err := grpc.Dial(os.Getenv("LINKEDACC_URL"), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	conn2, err := grpc.Dial(os.Getenv("LINKEDACC_URL"), dialopts...)
 	if err != nil {
 		level.Error(logger).Log("err", err)
 		return
@@ -104,14 +179,204 @@ func main() {
 	defer conn2.Close()
 	linkedacccli := pb.NewLinkedaccClient(conn2)
 
+	// link previews
+	unfurler := unfurl.New(unfurl.NewHTTPFetcher(unfurlTimeout, false), unfurl.OpenGraphParser{}, unfurl.NewCQLCache(cses, unfurlCacheTTL))
+
+	// search index, the in-memory one is for development only
+	var index search.Index
+	switch os.Getenv("SEARCH_INDEX") {
+	case "memory":
+		index = search.NewMemoryIndex()
+	case "", "cql":
+		index = search.NewCQLIndex(cses)
+	default:
+		level.Error(logger).Log("err", "unknown SEARCH_INDEX "+os.Getenv("SEARCH_INDEX"))
+		return
+	}
+
+	// trending posts
+	trendingcfg := service.TrendingConfig{Size: trendingSize}
+	for _, v := range []struct {
+		env string
+		dst *time.Duration
+		def time.Duration
+	}{
+		{"TRENDING_WINDOW", &trendingcfg.Window, time.Hour * 48},
+		{"TRENDING_INTERVAL", &trendingcfg.Interval, time.Minute * 10},
+		{"TRENDING_HALF_LIFE", &trendingcfg.HalfLife, time.Hour * 12},
+	} {
+		*v.dst = v.def
+		if os.Getenv(v.env) != "" {
+			*v.dst, err = time.ParseDuration(os.Getenv(v.env))
+			if err != nil || *v.dst <= 0 {
+				level.Error(logger).Log("err", "invalid "+v.env)
+				return
+			}
+		}
+	}
+	for _, v := range []struct {
+		env string
+		dst *float64
+		def float64
+	}{
+		{"TRENDING_LIKE_WEIGHT", &trendingcfg.LikeWeight, 1},
+		{"TRENDING_COMMENT_WEIGHT", &trendingcfg.CommentWeight, 2},
+	} {
+		*v.dst = v.def
+		if os.Getenv(v.env) != "" {
+			*v.dst, err = strconv.ParseFloat(os.Getenv(v.env), 64)
+			if err != nil || *v.dst < 0 {
+				level.Error(logger).Log("err", "invalid "+v.env)
+				return
+			}
+		}
+	}
+	// likes and comments made before the post_stats counters
+	go service.NewStatsBackfill(cses, logger).Run(context.Background())
+
+	go service.NewTrending(cses, bucketDuration, trendingcfg, logger).Run(context.Background())
+
+	// follower snapshots of the authors statistics
+	go service.NewFollowerSnapshots(cses, userscli, followerSnapshotInterval, logger).Run(context.Background())
+
+	// ranked feed
+	rankingcfg := ranking.Config{}
+	for _, v := range []struct {
+		env string
+		dst *time.Duration
+		def time.Duration
+	}{
+		{"RANKING_RECENCY_HALF_LIFE", &rankingcfg.RecencyHalfLife, time.Hour * 12},
+	} {
+		*v.dst = v.def
+		if os.Getenv(v.env) != "" {
+			*v.dst, err = time.ParseDuration(os.Getenv(v.env))
+			if err != nil || *v.dst <= 0 {
+				level.Error(logger).Log("err", "invalid "+v.env)
+				return
+			}
+		}
+	}
+	for _, v := range []struct {
+		env string
+		dst *float64
+		def float64
+	}{
+		{"RANKING_RECENCY_WEIGHT", &rankingcfg.RecencyWeight, 1},
+		{"RANKING_LIKE_WEIGHT", &rankingcfg.LikeWeight, 1},
+		{"RANKING_COMMENT_WEIGHT", &rankingcfg.CommentWeight, 2},
+		{"RANKING_ENGAGEMENT_WEIGHT", &rankingcfg.EngagementWeight, 0.2},
+		{"RANKING_AUTHOR_WEIGHT", &rankingcfg.AuthorWeight, 1},
+		{"RANKING_TAG_WEIGHT", &rankingcfg.TagWeight, 0.5},
+	} {
+		*v.dst = v.def
+		if os.Getenv(v.env) != "" {
+			*v.dst, err = strconv.ParseFloat(os.Getenv(v.env), 64)
+			if err != nil || *v.dst < 0 {
+				level.Error(logger).Log("err", "invalid "+v.env)
+				return
+			}
+		}
+	}
+	ranker := ranking.NewRanker(ranking.SystemClock, rankingcfg.Scorers()...)
+
+	// live updates
+	broker := pubsub.NewMemoryBroker(subscriberBuffer)
+
+	// ActivityPub, the public posts are federated if AP_BASE_URL is set
+	var federation service.Federation
+	var apfederation *activitypub.Federation
+	apposts := &apPosts{userscli: userscli}
+	if os.Getenv("AP_BASE_URL") != "" {
+		var apkey *rsa.PrivateKey
+		if os.Getenv("AP_KEY_FILE") != "" {
+			data, err := os.ReadFile(os.Getenv("AP_KEY_FILE"))
+			if err != nil {
+				level.Error(logger).Log("err", err)
+				return
+			}
+			apkey, err = activitypub.ParsePrivateKey(data)
+			if err != nil {
+				level.Error(logger).Log("err", err)
+				return
+			}
+		} else {
+			level.Warn(logger).Log("msg", "AP_KEY_FILE is not set, remote servers will not verify activities after restart")
+			apkey, err = rsa.GenerateKey(rand.Reader, apKeyBits)
+			if err != nil {
+				level.Error(logger).Log("err", err)
+				return
+			}
+		}
+
+		apcfg := activitypub.Config{BaseURL: os.Getenv("AP_BASE_URL"), WebURL: os.Getenv("WEB_URL"), Timeout: apClientTimeout}
+		apcfg.Queue.Dropped = kitprometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: "nexusmicro",
+			Subsystem: "posts",
+			Name:      "activitypub_dropped_deliveries",
+			Help:      "Number of ActivityPub deliveries given up.",
+		}, []string{"reason"})
+		apreplies := service.NewReplies(cses, bucketDuration, index, broker, logger)
+		apfederation = activitypub.New(apcfg, apkey, activitypub.NewCQLStore(cses), apposts, apreplies, logger)
+		federation = apfederation
+		go apfederation.Run(context.Background())
+	}
+
 	//add service
-	addservice := service.NewService(cses, []byte(os.Getenv("SIGNONG_KEY")), bucketDuration, storagecli, userscli, linkedacccli)
+	addservice := service.NewService(cses, []byte(os.Getenv("SIGNONG_KEY")), bucketDuration, storagecli, userscli, linkedacccli, unfurler, index, ranker, broker, federation, logger)
 	addmiddleware := middleware.LoggingMiddleware(logger, requestCount, requestLatency)(addservice)
+	apposts.srv = addmiddleware
+
+	// access tokens, asymmetric keys from JWKS_URL replace SIGNONG_KEY if set
+	authcfg := service.AuthConfig{
+		SigningKey: []byte(os.Getenv("SIGNONG_KEY")),
+		Issuer:     os.Getenv("JWT_ISSUER"),
+		Audience:   os.Getenv("JWT_AUDIENCE"),
+		Anonymous:  make(map[string]bool),
+	}
+	anonymous := defaultAnonymousRPCs
+	if v, ok := os.LookupEnv("ANONYMOUS_RPCS"); ok {
+		anonymous = v
+	}
+	for _, m := range strings.Split(anonymous, ",") {
+		if m = strings.TrimSpace(m); m != "" {
+			authcfg.Anonymous[m] = true
+		}
+	}
+	// guests have no token to forward, the storage and users services
+	// authorize their calls by the service account token, without it
+	// guests are disabled
+	if len(authcfg.Anonymous) != 0 {
+		tokenfile := os.Getenv("SERVICE_TOKEN_FILE")
+		if _, err := os.Stat(tokenfile); tokenfile == "" || err != nil {
+			level.Warn(logger).Log("msg", "guests are disabled, ANONYMOUS_RPCS requires a readable SERVICE_TOKEN_FILE", "file", tokenfile)
+			authcfg.Anonymous = make(map[string]bool)
+		}
+	}
+	if os.Getenv("JWKS_URL") != "" {
+		authcfg.Keys, err = jwks.New(context.Background(), os.Getenv("JWKS_URL"), logger)
+		if err != nil {
+			level.Error(logger).Log("err", err)
+			return
+		}
+		go authcfg.Keys.Run(context.Background(), jwksRefreshInterval)
+	}
+
+	// per RPC scopes and roles, denials are only logged unless enforced
+	var policyDryRun bool
+	switch os.Getenv("AUTH_POLICY") {
+	case "enforce":
+	case "", "dry-run":
+		policyDryRun = true
+	default:
+		level.Error(logger).Log("err", "unknown AUTH_POLICY "+os.Getenv("AUTH_POLICY"))
+		return
+	}
 
 	// grpc server
 	errs := make(chan error)
 	go func() {
-		c := make(chan os.Signal)
+		c := make(chan os.Signal, 1)
 		signal.Notify(c, syscall.SIGINT, syscall.SIGTERM, syscall.SIGALRM)
 		errs <- fmt.Errorf("%s", <-c)
 	}()
@@ -126,9 +391,28 @@ func main() {
 		os.Exit(1)
 	}
 
+	serveropts := make([]grpc.ServerOption, 0)
+	if os.Getenv("TLS_CERT_FILE") != "" {
+		serveropts = append(serveropts, grpc.Creds(credentials.NewTLS(tlsstore.ServerConfig(clientauth))))
+	}
+
+	unaryinterceptors := []grpc.UnaryServerInterceptor{
+		service.GetUnaryInterceptor(authcfg, logger),
+		service.GetPolicyInterceptor(service.DefaultPolicy, policyDryRun, logger),
+	}
+	// unary RPCs are logged by the middleware, streams by the interceptor
+	streaminterceptors := []grpc.StreamServerInterceptor{
+		service.GetStreamInterceptor(authcfg, logger),
+		service.GetPolicyStreamInterceptor(service.DefaultPolicy, policyDryRun, logger),
+		middleware.StreamLoggingInterceptor(logger, requestCount, requestLatency),
+	}
+
 	go func() {
 		baseServer := grpc.NewServer(
-			grpc.UnaryInterceptor(service.GetUnaryInterceptor([]byte(os.Getenv("SIGNONG_KEY")), logger)),
+			append(serveropts,
+				grpc.ChainUnaryInterceptor(unaryinterceptors...),
+				grpc.ChainStreamInterceptor(streaminterceptors...),
+			)...,
 		)
 
 		pb.RegisterPostsServer(baseServer, addmiddleware)
@@ -136,8 +420,91 @@ func main() {
 		baseServer.Serve(grpcListener)
 	}()
 
+	// rest gateway
+	gw, err := newGateway(addmiddleware, unaryinterceptors, streaminterceptors, logger)
+	if err != nil {
+		level.Error(logger).Log("err", err)
+		return
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/Posts/", gw)
+	mux.Handle(openapi.DocumentPath, openapi.Handler())
+	mux.Handle(openapi.UIPath, openapi.UIHandler(false))
+	feeds := &feedHandler{gw: gw, webURL: os.Getenv("WEB_URL")}
+	mux.HandleFunc("/users/", func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/feed.rss"), strings.HasSuffix(r.URL.Path, "/feed.atom"):
+			feeds.ServeHTTP(w, r)
+		case apfederation != nil:
+			apfederation.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+	if apfederation != nil {
+		mux.Handle("/.well-known/webfinger", apfederation)
+		mux.Handle("/inbox", apfederation)
+	}
+
+	httpport := os.Getenv("HTTP_PORT")
+	if httpport == "" {
+		httpport = "80"
+	}
+
+	// the gateway calls the service in process, so it requires client
+	// certificates like the grpc server. The public routes, the feeds of
+	// the guests and ActivityPub, are called by feed readers and remote
+	// servers without certificates, with them the gateway is a separate
+	// trust boundary where only the bearer tokens authenticate callers.
+	// HTTP_CLIENT_AUTH=none|require overrides the default.
+	publicroutes := apfederation != nil || authcfg.Anonymous["GetPostsUser"]
+	httpclientauth := clientauth && !publicroutes
+	switch os.Getenv("HTTP_CLIENT_AUTH") {
+	case "":
+		if clientauth && publicroutes {
+			level.Info(logger).Log("msg", "HTTP gateway does not require client certificates, it serves the public feeds and ActivityPub routes")
+		}
+	case "none":
+		httpclientauth = false
+	case "require":
+		if !clientauth {
+			level.Error(logger).Log("err", "HTTP_CLIENT_AUTH=require requires TLS_CLIENT_AUTH=require")
+			return
+		}
+		if publicroutes {
+			level.Error(logger).Log("err", "HTTP_CLIENT_AUTH=require makes the feeds and ActivityPub unreachable, remove GetPostsUser from ANONYMOUS_RPCS and unset AP_BASE_URL")
+			return
+		}
+		httpclientauth = true
+	default:
+		level.Error(logger).Log("err", "unknown HTTP_CLIENT_AUTH "+os.Getenv("HTTP_CLIENT_AUTH"))
+		return
+	}
+
+	go func() {
+		httpListener, err := net.Listen("tcp", ":"+httpport)
+		if err != nil {
+			level.Error(logger).Log("during", "Listen", "err", err)
+			return
+		}
+		if os.Getenv("TLS_CERT_FILE") != "" {
+			httpListener = tls.NewListener(httpListener, tlsstore.ServerConfig(httpclientauth))
+		}
+		level.Info(logger).Log("msg", "HTTP gateway started", "port", httpport)
+		err = http.Serve(httpListener, mux)
+		if err != nil {
+			level.Error(logger).Log("err", err)
+			return
+		}
+	}()
+
 	// metrics http server
 	http.Handle("/metrics", promhttp.Handler())
+	// the api contract is also published next to the metrics, there is no
+	// gateway on this port, so its Swagger UI sends no requests
+	http.Handle(openapi.DocumentPath, openapi.Handler())
+	http.Handle(openapi.UIPath, openapi.UIHandler(true))
 
 	httpmetricsport := os.Getenv("METRICS_PORT")
 	if httpmetricsport == "" {
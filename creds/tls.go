@@ -0,0 +1,215 @@
+// Package creds provides the transport and per-RPC credentials of the
+// gRPC server and its clients.
+package creds
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/go-kit/log"
+	"github.com/go-kit/log/level"
+)
+
+// Files are the PEM files of a TLS identity. CA is the bundle that
+// verifies the peers, if empty the system roots are used.
+type Files struct {
+	Cert string
+	Key  string
+	CA   string
+}
+
+// Store holds a certificate and a CA pool loaded from files and reloads
+// them when the files change, so certificates can be rotated without a
+// restart. Connections made after a reload use the new files.
+type Store struct {
+	files Files
+
+	mu      sync.RWMutex
+	cert    *tls.Certificate
+	pool    *x509.CertPool
+	modTime time.Time
+}
+
+func NewStore(files Files) (*Store, error) {
+
+	s := &Store{files: files}
+
+	err := s.load()
+	if err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// Run checks the files every interval until the context is done.
+func (s *Store) Run(ctx context.Context, interval time.Duration, logger log.Logger) {
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if !s.changed() {
+				continue
+			}
+			err := s.load()
+			if err != nil {
+				level.Error(logger).Log("msg", "failed to reload certificates", "err", err)
+				continue
+			}
+			level.Info(logger).Log("msg", "certificates reloaded")
+		}
+	}
+}
+
+// latestModTime returns the latest modification time of the files.
+func (s *Store) latestModTime() (time.Time, error) {
+
+	var res time.Time
+	for _, f := range []string{s.files.Cert, s.files.Key, s.files.CA} {
+		if f == "" {
+			continue
+		}
+		fi, err := os.Stat(f)
+		if err != nil {
+			return time.Time{}, err
+		}
+		if fi.ModTime().After(res) {
+			res = fi.ModTime()
+		}
+	}
+
+	return res, nil
+}
+
+func (s *Store) changed() bool {
+
+	mod, err := s.latestModTime()
+	if err != nil {
+		return false
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return mod.After(s.modTime)
+}
+
+func (s *Store) load() error {
+
+	mod, err := s.latestModTime()
+	if err != nil {
+		return err
+	}
+
+	var cert *tls.Certificate
+	if s.files.Cert != "" || s.files.Key != "" {
+		c, err := tls.LoadX509KeyPair(s.files.Cert, s.files.Key)
+		if err != nil {
+			return err
+		}
+		cert = &c
+	}
+
+	var pool *x509.CertPool
+	if s.files.CA != "" {
+		data, err := os.ReadFile(s.files.CA)
+		if err != nil {
+			return err
+		}
+		pool = x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(data) {
+			return errors.New("creds: no certificates in " + s.files.CA)
+		}
+	}
+
+	s.mu.Lock()
+	s.cert = cert
+	s.pool = pool
+	s.modTime = mod
+	s.mu.Unlock()
+
+	return nil
+}
+
+func (s *Store) current() (*tls.Certificate, *x509.CertPool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.cert, s.pool
+}
+
+// ServerConfig returns the TLS config of the server. If requireClientCert
+// is set, clients must present a certificate signed by the CA (mTLS),
+// handshakes fail while the store has no CA.
+func (s *Store) ServerConfig(requireClientCert bool) *tls.Config {
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			cert, pool := s.current()
+			if cert == nil {
+				return nil, errors.New("creds: no server certificate")
+			}
+
+			cfg := &tls.Config{
+				MinVersion:   tls.VersionTLS12,
+				Certificates: []tls.Certificate{*cert},
+			}
+			if requireClientCert {
+				// a nil pool would mean the system roots.
+				if pool == nil {
+					return nil, errors.New("creds: client certificates required without a CA")
+				}
+				cfg.ClientAuth = tls.RequireAndVerifyClientCert
+				cfg.ClientCAs = pool
+			}
+			return cfg, nil
+		},
+	}
+}
+
+// ClientConfig returns the TLS config of a client. The certificate, if
+// any, is presented to servers that ask for one. The server certificate
+// is verified by hand against the current CA pool, because RootCAs can
+// not be swapped in a config that is already in use.
+func (s *Store) ClientConfig() *tls.Config {
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		GetClientCertificate: func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			cert, _ := s.current()
+			if cert == nil {
+				return &tls.Certificate{}, nil
+			}
+			return cert, nil
+		},
+		// verification is done in VerifyConnection.
+		InsecureSkipVerify: true,
+		VerifyConnection: func(cs tls.ConnectionState) error {
+			_, pool := s.current()
+
+			if len(cs.PeerCertificates) == 0 {
+				return errors.New("creds: no server certificate")
+			}
+
+			intermediates := x509.NewCertPool()
+			for _, c := range cs.PeerCertificates[1:] {
+				intermediates.AddCert(c)
+			}
+
+			_, err := cs.PeerCertificates[0].Verify(x509.VerifyOptions{
+				DNSName:       cs.ServerName,
+				Roots:         pool,
+				Intermediates: intermediates,
+			})
+			return err
+		},
+	}
+}
@@ -0,0 +1,71 @@
+package creds
+
+import (
+	"context"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/metadata"
+)
+
+// tokenCacheDuration is how long the token file is cached.
+const tokenCacheDuration = time.Minute
+
+// ServiceToken is the per-RPC credentials of the service account. The
+// token is read from a file, so it can be rotated without a restart.
+//
+// Calls made on behalf of a user forward the user token in the outgoing
+// metadata, the service token is only sent when there is none, e.g. for
+// guests.
+type ServiceToken struct {
+	path       string
+	requireTLS bool
+
+	mu      sync.Mutex
+	token   string
+	expires time.Time
+}
+
+func NewServiceToken(path string, requireTLS bool) *ServiceToken {
+	return &ServiceToken{path: path, requireTLS: requireTLS}
+}
+
+func (t *ServiceToken) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+
+	if md, ok := metadata.FromOutgoingContext(ctx); ok && len(md.Get("authorization")) != 0 {
+		return nil, nil
+	}
+
+	token, err := t.get()
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]string{"authorization": "Bearer " + token}, nil
+}
+
+func (t *ServiceToken) RequireTransportSecurity() bool {
+	return t.requireTLS
+}
+
+func (t *ServiceToken) get() (string, error) {
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if time.Now().Before(t.expires) {
+		return t.token, nil
+	}
+
+	data, err := os.ReadFile(t.path)
+	if err != nil {
+		return "", err
+	}
+
+	t.token = strings.TrimSpace(string(data))
+	t.expires = time.Now().Add(tokenCacheDuration)
+
+	return t.token, nil
+}
@@ -0,0 +1,74 @@
+// Package hll implements the HyperLogLog cardinality estimate.
+//
+// The sketch is kept as separate registers, so it can be stored as rows
+// that are only ever inserted: the value of a register is the maximum
+// rank inserted for it.
+package hll
+
+import (
+	"math"
+	"math/bits"
+)
+
+const (
+	// Precision is the number of hash bits that select the register,
+	// the standard error is 1.04/sqrt(Registers), about 3%.
+	Precision = 10
+	Registers = 1 << Precision
+)
+
+// Hash mixes the value with the splitmix64 finalizer, so sequential ids
+// spread evenly over the registers.
+func Hash(v uint64) uint64 {
+	v += 0x9e3779b97f4a7c15
+	v = (v ^ (v >> 30)) * 0xbf58476d1ce4e5b9
+	v = (v ^ (v >> 27)) * 0x94d049bb133111eb
+	return v ^ (v >> 31)
+}
+
+// Register returns the register of the hash and its rank, the position
+// of the leftmost 1 bit in the remaining bits.
+func Register(h uint64) (int, uint8) {
+	index := int(h >> (64 - Precision))
+	rank := uint8(bits.LeadingZeros64(h<<Precision|1<<(Precision-1))) + 1
+	return index, rank
+}
+
+// Sketch is the registers of a HyperLogLog.
+type Sketch [Registers]uint8
+
+// Add sets the register to rank if rank is greater.
+func (s *Sketch) Add(index int, rank uint8) {
+	if rank > s[index] {
+		s[index] = rank
+	}
+}
+
+// Insert adds the value to the sketch.
+func (s *Sketch) Insert(v uint64) {
+	s.Add(Register(Hash(v)))
+}
+
+// Estimate returns the approximate number of distinct values.
+func (s *Sketch) Estimate() uint64 {
+
+	m := float64(Registers)
+
+	sum := 0.0
+	zeros := 0
+	for _, r := range s {
+		sum += math.Ldexp(1, -int(r))
+		if r == 0 {
+			zeros++
+		}
+	}
+
+	estimate := 0.7213 / (1 + 1.079/m) * m * m / sum
+
+	// linear counting is more precise for small cardinalities.
+	if estimate <= 2.5*m && zeros != 0 {
+		estimate = m * math.Log(m/float64(zeros))
+	}
+
+	return uint64(estimate + 0.5)
+}
@@ -0,0 +1,314 @@
+// Package jwks loads the public keys that verify access tokens from a
+// JSON Web Key Set and keeps them up to date.
+package jwks
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-kit/log"
+	"github.com/go-kit/log/level"
+)
+
+const (
+	fetchTimeout = time.Second * 10
+	maxSetSize   = 1 << 20
+	// minRefreshInterval limits the refreshes caused by unknown key ids.
+	minRefreshInterval = time.Minute
+)
+
+var (
+	ErrKeyNotFound    = errors.New("jwks: key not found")
+	ErrUnsupportedKey = errors.New("jwks: unsupported key")
+)
+
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	Crv string `json:"crv"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+// Key is a public key of the set.
+type Key struct {
+	Id string
+	// Alg is the algorithm the key is restricted to, empty if any
+	// algorithm of the key type is allowed.
+	Alg       string
+	PublicKey crypto.PublicKey
+}
+
+// KeySet is a JSON Web Key Set loaded from a file or an http(s) url.
+// Keys are replaced as a whole on every successful refresh, a failed
+// refresh keeps the previous keys.
+type KeySet struct {
+	source string
+	client *http.Client
+	logger log.Logger
+
+	mu          sync.RWMutex
+	keys        map[string]*Key
+	lastRefresh time.Time
+	refreshing  sync.Mutex
+}
+
+// New loads the key set from the source, a file path or an http(s) url.
+func New(ctx context.Context, source string, logger log.Logger) (*KeySet, error) {
+
+	ks := &KeySet{
+		source: source,
+		client: &http.Client{Timeout: fetchTimeout},
+		logger: logger,
+	}
+
+	err := ks.Refresh(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return ks, nil
+}
+
+// Run refreshes the keys every interval until the context is done.
+func (ks *KeySet) Run(ctx context.Context, interval time.Duration) {
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			err := ks.Refresh(ctx)
+			if err != nil {
+				level.Error(ks.logger).Log("msg", "failed to refresh jwks", "source", ks.source, "err", err)
+			}
+		}
+	}
+}
+
+// Refresh loads the key set from the source.
+func (ks *KeySet) Refresh(ctx context.Context) error {
+
+	ks.refreshing.Lock()
+	defer ks.refreshing.Unlock()
+
+	return ks.refresh(ctx)
+}
+
+// refreshStale refreshes the keys unless they were refreshed less than
+// minRefreshInterval ago.
+func (ks *KeySet) refreshStale(ctx context.Context) error {
+
+	ks.refreshing.Lock()
+	defer ks.refreshing.Unlock()
+
+	ks.mu.RLock()
+	stale := time.Since(ks.lastRefresh) >= minRefreshInterval
+	ks.mu.RUnlock()
+
+	if !stale {
+		return nil
+	}
+
+	return ks.refresh(ctx)
+}
+
+func (ks *KeySet) refresh(ctx context.Context) error {
+
+	data, err := ks.fetch(ctx)
+	if err != nil {
+		return err
+	}
+
+	keys, err := parse(data)
+	if err != nil {
+		return err
+	}
+
+	ks.mu.Lock()
+	ks.keys = keys
+	ks.lastRefresh = time.Now()
+	ks.mu.Unlock()
+
+	return nil
+}
+
+// Key returns the key with the id. If there is no such key, the set is
+// refreshed once, at most every minRefreshInterval, so keys added by a
+// rotation are picked up before the next periodic refresh.
+func (ks *KeySet) Key(ctx context.Context, id string) (*Key, error) {
+
+	ks.mu.RLock()
+	key, ok := ks.keys[id]
+	ks.mu.RUnlock()
+
+	if ok {
+		return key, nil
+	}
+
+	err := ks.refreshStale(ctx)
+	if err != nil {
+		level.Error(ks.logger).Log("msg", "failed to refresh jwks", "source", ks.source, "err", err)
+	}
+
+	ks.mu.RLock()
+	key, ok = ks.keys[id]
+	ks.mu.RUnlock()
+
+	if !ok {
+		return nil, ErrKeyNotFound
+	}
+
+	return key, nil
+}
+
+func (ks *KeySet) fetch(ctx context.Context) ([]byte, error) {
+
+	if !strings.HasPrefix(ks.source, "http://") && !strings.HasPrefix(ks.source, "https://") {
+		return os.ReadFile(strings.TrimPrefix(ks.source, "file://"))
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, ks.source, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := ks.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("jwks: unexpected status %d", resp.StatusCode)
+	}
+
+	return io.ReadAll(io.LimitReader(resp.Body, maxSetSize))
+}
+
+// parse decodes the key set. Keys that are not for signatures or have an
+// unsupported type are skipped, a set without usable keys is an error.
+func parse(data []byte) (map[string]*Key, error) {
+
+	var set struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+
+	err := json.Unmarshal(data, &set)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make(map[string]*Key)
+	for _, jwk := range set.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+
+		pub, err := jwk.publicKey()
+		if err != nil {
+			continue
+		}
+
+		keys[jwk.Kid] = &Key{Id: jwk.Kid, Alg: jwk.Alg, PublicKey: pub}
+	}
+
+	if len(keys) == 0 {
+		return nil, errors.New("jwks: no usable keys")
+	}
+
+	return keys, nil
+}
+
+func (jwk jsonWebKey) publicKey() (crypto.PublicKey, error) {
+
+	switch jwk.Kty {
+	case "RSA":
+		n, err := decodeInt(jwk.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := decodeInt(jwk.E)
+		if err != nil {
+			return nil, err
+		}
+		if !e.IsInt64() || e.Int64() < 3 || e.Int64() > 1<<31-1 {
+			return nil, ErrUnsupportedKey
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+
+	case "EC":
+		var curve elliptic.Curve
+		switch jwk.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, ErrUnsupportedKey
+		}
+		x, err := decodeInt(jwk.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := decodeInt(jwk.Y)
+		if err != nil {
+			return nil, err
+		}
+		if !curve.IsOnCurve(x, y) {
+			return nil, ErrUnsupportedKey
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+
+	case "OKP":
+		if jwk.Crv != "Ed25519" {
+			return nil, ErrUnsupportedKey
+		}
+		x, err := base64.RawURLEncoding.DecodeString(jwk.X)
+		if err != nil {
+			return nil, err
+		}
+		if len(x) != ed25519.PublicKeySize {
+			return nil, ErrUnsupportedKey
+		}
+		return ed25519.PublicKey(x), nil
+
+	default:
+		return nil, ErrUnsupportedKey
+	}
+}
+
+func decodeInt(s string) (*big.Int, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+	if len(b) == 0 {
+		return nil, ErrUnsupportedKey
+	}
+	return new(big.Int).SetBytes(b), nil
+}
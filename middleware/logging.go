@@ -2,6 +2,7 @@ package middleware
 
 import (
 	"context"
+	"path"
 	"time"
 
 	"github.com/go-kit/log"
@@ -9,6 +10,7 @@ import (
 	"github.com/NexusIT-Dev/nexusmicro_publications/pb"
 	"github.com/go-kit/kit/metrics"
 	"github.com/go-kit/log/level"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -57,6 +59,23 @@ func (mw *loggingMiddleware) logfunc(begin time.Time, method string, err error)
 	mw.requestLatency.With(lvs...).Observe(float64(time.Since(begin).Microseconds()))
 }
 
+// StreamLoggingInterceptor logs streaming RPCs and records their metrics
+// like LoggingMiddleware does for unary ones. The latency of a stream is
+// the time until it ends.
+func StreamLoggingInterceptor(logger log.Logger, requestCount metrics.Counter, requestLatency metrics.Histogram) grpc.StreamServerInterceptor {
+	mw := &loggingMiddleware{
+		logger:         logger,
+		requestCount:   requestCount,
+		requestLatency: requestLatency,
+	}
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		start_time := time.Now()
+		err := handler(srv, ss)
+		mw.logfunc(start_time, path.Base(info.FullMethod), err)
+		return err
+	}
+}
+
 func (mw *loggingMiddleware) NewPost(ctx context.Context, req *pb.NewPostRequest) (*pb.NewPostResponse, error) {
 	start_time := time.Now()
 	res, err := mw.next.NewPost(ctx, req)
@@ -112,3 +131,138 @@ func (mw *loggingMiddleware) GetPostById(ctx context.Context, req *pb.GetPostByI
 	mw.logfunc(start_time, "GetPostById", err)
 	return res, err
 }
+
+func (mw *loggingMiddleware) SaveDraft(ctx context.Context, req *pb.SaveDraftRequest) (*pb.SaveDraftResponse, error) {
+	start_time := time.Now()
+	res, err := mw.next.SaveDraft(ctx, req)
+	mw.logfunc(start_time, "SaveDraft", err)
+	return res, err
+}
+func (mw *loggingMiddleware) ListDrafts(ctx context.Context, req *pb.ListDraftsRequest) (*pb.ListDraftsResponse, error) {
+	start_time := time.Now()
+	res, err := mw.next.ListDrafts(ctx, req)
+	mw.logfunc(start_time, "ListDrafts", err)
+	return res, err
+}
+func (mw *loggingMiddleware) GetDraft(ctx context.Context, req *pb.GetDraftRequest) (*pb.GetDraftResponse, error) {
+	start_time := time.Now()
+	res, err := mw.next.GetDraft(ctx, req)
+	mw.logfunc(start_time, "GetDraft", err)
+	return res, err
+}
+func (mw *loggingMiddleware) DeleteDraft(ctx context.Context, req *pb.DeleteDraftRequest) (*pb.DeleteDraftResponse, error) {
+	start_time := time.Now()
+	res, err := mw.next.DeleteDraft(ctx, req)
+	mw.logfunc(start_time, "DeleteDraft", err)
+	return res, err
+}
+func (mw *loggingMiddleware) PublishDraft(ctx context.Context, req *pb.PublishDraftRequest) (*pb.PublishDraftResponse, error) {
+	start_time := time.Now()
+	res, err := mw.next.PublishDraft(ctx, req)
+	mw.logfunc(start_time, "PublishDraft", err)
+	return res, err
+}
+func (mw *loggingMiddleware) CreateAudience(ctx context.Context, req *pb.CreateAudienceRequest) (*pb.CreateAudienceResponse, error) {
+	start_time := time.Now()
+	res, err := mw.next.CreateAudience(ctx, req)
+	mw.logfunc(start_time, "CreateAudience", err)
+	return res, err
+}
+func (mw *loggingMiddleware) AddToAudience(ctx context.Context, req *pb.AddToAudienceRequest) (*pb.AddToAudienceResponse, error) {
+	start_time := time.Now()
+	res, err := mw.next.AddToAudience(ctx, req)
+	mw.logfunc(start_time, "AddToAudience", err)
+	return res, err
+}
+func (mw *loggingMiddleware) ListAudiences(ctx context.Context, req *pb.ListAudiencesRequest) (*pb.ListAudiencesResponse, error) {
+	start_time := time.Now()
+	res, err := mw.next.ListAudiences(ctx, req)
+	mw.logfunc(start_time, "ListAudiences", err)
+	return res, err
+}
+func (mw *loggingMiddleware) GetPostsByTag(ctx context.Context, req *pb.GetPostsByTagRequest) (*pb.GetPostsByTagResponse, error) {
+	start_time := time.Now()
+	res, err := mw.next.GetPostsByTag(ctx, req)
+	mw.logfunc(start_time, "GetPostsByTag", err)
+	return res, err
+}
+func (mw *loggingMiddleware) GetTagsFeed(ctx context.Context, req *pb.GetTagsFeedRequest) (*pb.GetTagsFeedResponse, error) {
+	start_time := time.Now()
+	res, err := mw.next.GetTagsFeed(ctx, req)
+	mw.logfunc(start_time, "GetTagsFeed", err)
+	return res, err
+}
+func (mw *loggingMiddleware) GetMentions(ctx context.Context, req *pb.GetMentionsRequest) (*pb.GetMentionsResponse, error) {
+	start_time := time.Now()
+	res, err := mw.next.GetMentions(ctx, req)
+	mw.logfunc(start_time, "GetMentions", err)
+	return res, err
+}
+func (mw *loggingMiddleware) VotePoll(ctx context.Context, req *pb.VotePollRequest) (*pb.VotePollResponse, error) {
+	start_time := time.Now()
+	res, err := mw.next.VotePoll(ctx, req)
+	mw.logfunc(start_time, "VotePoll", err)
+	return res, err
+}
+func (mw *loggingMiddleware) RetractVote(ctx context.Context, req *pb.RetractVoteRequest) (*pb.RetractVoteResponse, error) {
+	start_time := time.Now()
+	res, err := mw.next.RetractVote(ctx, req)
+	mw.logfunc(start_time, "RetractVote", err)
+	return res, err
+}
+func (mw *loggingMiddleware) DeletePost(ctx context.Context, req *pb.DeletePostRequest) (*pb.DeletePostResponse, error) {
+	start_time := time.Now()
+	res, err := mw.next.DeletePost(ctx, req)
+	mw.logfunc(start_time, "DeletePost", err)
+	return res, err
+}
+func (mw *loggingMiddleware) SearchPosts(ctx context.Context, req *pb.SearchPostsRequest) (*pb.SearchPostsResponse, error) {
+	start_time := time.Now()
+	res, err := mw.next.SearchPosts(ctx, req)
+	mw.logfunc(start_time, "SearchPosts", err)
+	return res, err
+}
+func (mw *loggingMiddleware) GetTrendingPosts(ctx context.Context, req *pb.GetTrendingPostsRequest) (*pb.GetTrendingPostsResponse, error) {
+	start_time := time.Now()
+	res, err := mw.next.GetTrendingPosts(ctx, req)
+	mw.logfunc(start_time, "GetTrendingPosts", err)
+	return res, err
+}
+func (mw *loggingMiddleware) GetRankedFeed(ctx context.Context, req *pb.GetRankedFeedRequest) (*pb.GetRankedFeedResponse, error) {
+	start_time := time.Now()
+	res, err := mw.next.GetRankedFeed(ctx, req)
+	mw.logfunc(start_time, "GetRankedFeed", err)
+	return res, err
+}
+func (mw *loggingMiddleware) MarkSeen(ctx context.Context, req *pb.MarkSeenRequest) (*pb.MarkSeenResponse, error) {
+	start_time := time.Now()
+	res, err := mw.next.MarkSeen(ctx, req)
+	mw.logfunc(start_time, "MarkSeen", err)
+	return res, err
+}
+func (mw *loggingMiddleware) GetNewPostsCount(ctx context.Context, req *pb.GetNewPostsCountRequest) (*pb.GetNewPostsCountResponse, error) {
+	start_time := time.Now()
+	res, err := mw.next.GetNewPostsCount(ctx, req)
+	mw.logfunc(start_time, "GetNewPostsCount", err)
+	return res, err
+}
+func (mw *loggingMiddleware) GetPostStats(ctx context.Context, req *pb.GetPostStatsRequest) (*pb.GetPostStatsResponse, error) {
+	start_time := time.Now()
+	res, err := mw.next.GetPostStats(ctx, req)
+	mw.logfunc(start_time, "GetPostStats", err)
+	return res, err
+}
+func (mw *loggingMiddleware) GetAuthorStats(ctx context.Context, req *pb.GetAuthorStatsRequest) (*pb.GetAuthorStatsResponse, error) {
+	start_time := time.Now()
+	res, err := mw.next.GetAuthorStats(ctx, req)
+	mw.logfunc(start_time, "GetAuthorStats", err)
+	return res, err
+}
+
+// streaming RPCs are logged by StreamLoggingInterceptor.
+func (mw *loggingMiddleware) SubscribeFeed(req *pb.SubscribeFeedRequest, stream pb.Posts_SubscribeFeedServer) error {
+	return mw.next.SubscribeFeed(req, stream)
+}
+func (mw *loggingMiddleware) SubscribePostComments(req *pb.SubscribePostCommentsRequest, stream pb.Posts_SubscribePostCommentsServer) error {
+	return mw.next.SubscribePostComments(req, stream)
+}
@@ -0,0 +1,75 @@
+// Package netguard keeps the requests to the urls given by users and
+// remote servers from reaching the hosts inside the cluster.
+package netguard
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/url"
+	"syscall"
+	"time"
+)
+
+// ErrForbiddenAddress is returned when the url resolves to a loopback,
+// private or otherwise internal address.
+var ErrForbiddenAddress = errors.New("forbidden address")
+
+// Forbidden tells if the address is internal.
+func Forbidden(ip net.IP) bool {
+	return ip == nil || ip.IsLoopback() || ip.IsPrivate() || ip.IsUnspecified() ||
+		ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() || ip.IsMulticast()
+}
+
+// Dialer returns a dialer that refuses to connect to internal addresses
+// unless allowPrivate is set. The addresses are checked when connecting,
+// so a host can not resolve to another address after CheckURL.
+func Dialer(timeout time.Duration, allowPrivate bool) *net.Dialer {
+	return &net.Dialer{
+		Timeout: timeout,
+		Control: func(network, address string, c syscall.RawConn) error {
+			if allowPrivate {
+				return nil
+			}
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			if Forbidden(net.ParseIP(host)) {
+				return ErrForbiddenAddress
+			}
+			return nil
+		},
+	}
+}
+
+// CheckURL rejects the urls that are not https or resolve to internal
+// addresses. With allowPrivate any http url is accepted.
+func CheckURL(ctx context.Context, rawurl string, allowPrivate bool) error {
+
+	u, err := url.Parse(rawurl)
+	if err != nil {
+		return err
+	}
+	if allowPrivate {
+		if u.Scheme != "https" && u.Scheme != "http" {
+			return errors.New("url is not http")
+		}
+		return nil
+	}
+	if u.Scheme != "https" || u.Hostname() == "" {
+		return errors.New("url is not https")
+	}
+
+	addrs, err := net.DefaultResolver.LookupIPAddr(ctx, u.Hostname())
+	if err != nil {
+		return err
+	}
+	for _, addr := range addrs {
+		if Forbidden(addr.IP) {
+			return ErrForbiddenAddress
+		}
+	}
+
+	return nil
+}
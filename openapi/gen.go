@@ -0,0 +1,461 @@
+//go:build ignore
+
+// gen writes the OpenAPI v2 document of the REST gateway. The routes and
+// the schemas are taken from the descriptors in pb, the doc comments from
+// the proto files, because protoc-gen-go does not keep them.
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"log"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/NexusIT-Dev/nexusmicro_publications/pb"
+	"google.golang.org/genproto/googleapis/api/annotations"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const (
+	protoDir = "../nexusmicro_proto"
+	output   = "posts.swagger.json"
+)
+
+// object is a json object that keeps the order of its members.
+type object []member
+
+type member struct {
+	key   string
+	value any
+}
+
+func (o *object) set(key string, value any) {
+	*o = append(*o, member{key, value})
+}
+
+func (o object) MarshalJSON() ([]byte, error) {
+
+	buf := &bytes.Buffer{}
+	buf.WriteByte('{')
+	for i, m := range o {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		key, err := marshal(m.key)
+		if err != nil {
+			return nil, err
+		}
+		value, err := marshal(m.value)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(key)
+		buf.WriteByte(':')
+		buf.Write(value)
+	}
+	buf.WriteByte('}')
+
+	return buf.Bytes(), nil
+}
+
+// marshal is json.Marshal without escaping of <, > and &.
+func marshal(v any) ([]byte, error) {
+
+	buf := &bytes.Buffer{}
+	enc := json.NewEncoder(buf)
+	enc.SetEscapeHTML(false)
+
+	err := enc.Encode(v)
+	if err != nil {
+		return nil, err
+	}
+
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), nil
+}
+
+var (
+	declRe   = regexp.MustCompile(`^(service|message|enum|oneof)\s+(\w+)`)
+	rpcRe    = regexp.MustCompile(`^rpc\s+(\w+)`)
+	fieldRe  = regexp.MustCompile(`^(?:repeated\s+|optional\s+)?[\w.]+\s+(\w+)\s*=\s*\d+`)
+	valueRe  = regexp.MustCompile(`^(\w+)\s*=\s*-?\d+`)
+	comments = make(map[string]string)
+	parsed   = make(map[string]bool)
+)
+
+type scope struct {
+	kind string
+	name string
+}
+
+// parseComments reads the leading comments of the declarations of the
+// proto file. The keys are Message, Message.field, Enum.value and
+// Service.Method, the files have no package.
+func parseComments(file string) {
+
+	if parsed[file] {
+		return
+	}
+	parsed[file] = true
+
+	f, err := os.Open(filepath.Join(protoDir, file))
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer f.Close()
+
+	stack := make([]scope, 0)
+	lines := make([]string, 0)
+
+	sc := bufio.NewScanner(f)
+	for sc.Scan() {
+		line := strings.TrimSpace(sc.Text())
+
+		if strings.HasPrefix(line, "//") {
+			lines = append(lines, strings.TrimSpace(strings.TrimPrefix(line, "//")))
+			continue
+		}
+
+		key := ""
+		decl := scope{}
+		if m := declRe.FindStringSubmatch(line); m != nil {
+			decl = scope{kind: m[1], name: m[2]}
+			if decl.kind != "oneof" {
+				key = decl.name
+			}
+		} else if len(stack) != 0 {
+			parent := stack[len(stack)-1]
+			if parent.kind == "oneof" && len(stack) > 1 {
+				parent = stack[len(stack)-2]
+			}
+			if m := rpcRe.FindStringSubmatch(line); m != nil && parent.kind == "service" {
+				key = parent.name + "." + m[1]
+			} else if m := fieldRe.FindStringSubmatch(line); m != nil && parent.kind == "message" {
+				key = parent.name + "." + m[1]
+			} else if m := valueRe.FindStringSubmatch(line); m != nil && parent.kind == "enum" {
+				key = parent.name + "." + m[1]
+			}
+		}
+
+		if key != "" {
+			if text := commentText(lines, key); text != "" {
+				comments[key] = text
+			}
+		}
+		lines = lines[:0]
+
+		for _, r := range line {
+			switch r {
+			case '{':
+				stack = append(stack, decl)
+				decl = scope{}
+			case '}':
+				if len(stack) != 0 {
+					stack = stack[:len(stack)-1]
+				}
+			}
+		}
+	}
+
+	if err := sc.Err(); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// commentText joins the comment lines. The comments of the methods
+// start with the name of the method, it is dropped.
+func commentText(lines []string, key string) string {
+
+	if len(lines) != 0 && lines[0] == key[strings.LastIndex(key, ".")+1:] {
+		lines = lines[1:]
+	}
+
+	return strings.TrimSpace(strings.Join(lines, "\n"))
+}
+
+func comment(d protoreflect.Descriptor) string {
+
+	parseComments(d.ParentFile().Path())
+
+	key := string(d.Name())
+	if parent, ok := d.Parent().(protoreflect.Descriptor); ok && parent != d.ParentFile() {
+		key = string(parent.Name()) + "." + key
+	}
+
+	return comments[key]
+}
+
+var definitions = object{}
+var defined = make(map[protoreflect.FullName]bool)
+
+func ref(name protoreflect.Name) object {
+	return object{{"$ref", "#/definitions/" + string(name)}}
+}
+
+// fieldSchema returns the schema of a value of the field in the proto3
+// json mapping used by the gateway.
+func fieldSchema(fd protoreflect.FieldDescriptor) object {
+
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		return object{{"type", "boolean"}}
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		return object{{"type", "integer"}, {"format", "int32"}}
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return object{{"type", "integer"}, {"format", "int64"}}
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return object{{"type", "string"}, {"format", "int64"}}
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return object{{"type", "string"}, {"format", "uint64"}}
+	case protoreflect.FloatKind:
+		return object{{"type", "number"}, {"format", "float"}}
+	case protoreflect.DoubleKind:
+		return object{{"type", "number"}, {"format", "double"}}
+	case protoreflect.StringKind:
+		return object{{"type", "string"}}
+	case protoreflect.BytesKind:
+		return object{{"type", "string"}, {"format", "byte"}}
+	case protoreflect.EnumKind:
+		defineEnum(fd.Enum())
+		return ref(fd.Enum().Name())
+	default:
+		if fd.Message().FullName() == "google.protobuf.Timestamp" {
+			return object{{"type", "string"}, {"format", "date-time"}}
+		}
+		defineMessage(fd.Message())
+		return ref(fd.Message().Name())
+	}
+}
+
+func defineEnum(ed protoreflect.EnumDescriptor) {
+
+	if defined[ed.FullName()] {
+		return
+	}
+	defined[ed.FullName()] = true
+
+	values := make([]string, 0, ed.Values().Len())
+	lines := make([]string, 0)
+	if c := comment(ed); c != "" {
+		lines = append(lines, c, "")
+	}
+	for i := 0; i < ed.Values().Len(); i++ {
+		v := ed.Values().Get(i)
+		values = append(values, string(v.Name()))
+		line := " - " + string(v.Name())
+		if c := comment(v); c != "" {
+			line += ": " + strings.ReplaceAll(c, "\n", " ")
+		}
+		lines = append(lines, line)
+	}
+
+	schema := object{{"type", "string"}, {"enum", values}, {"default", values[0]}}
+	schema.set("description", strings.Join(lines, "\n"))
+
+	definitions.set(string(ed.Name()), schema)
+}
+
+func defineMessage(md protoreflect.MessageDescriptor) {
+
+	if defined[md.FullName()] {
+		return
+	}
+	defined[md.FullName()] = true
+
+	properties := object{}
+	schema := object{{"type", "object"}}
+	if c := comment(md); c != "" {
+		schema.set("description", c)
+	}
+	schema.set("properties", &properties)
+	definitions.set(string(md.Name()), schema)
+
+	for i := 0; i < md.Fields().Len(); i++ {
+		fd := md.Fields().Get(i)
+
+		var property object
+		switch {
+		case fd.IsMap():
+			property = object{{"type", "object"}, {"additionalProperties", fieldSchema(fd.MapValue())}}
+		case fd.IsList():
+			property = object{{"type", "array"}, {"items", fieldSchema(fd)}}
+		default:
+			property = fieldSchema(fd)
+		}
+		if c := comment(fd); c != "" {
+			property.set("description", c)
+		}
+
+		properties.set(fd.JSONName(), property)
+	}
+}
+
+// queryParameters returns the fields of the request that can be set by
+// query parameters, nested messages are not listed.
+func queryParameters(md protoreflect.MessageDescriptor) []object {
+
+	res := make([]object, 0)
+	for i := 0; i < md.Fields().Len(); i++ {
+		fd := md.Fields().Get(i)
+		if fd.IsMap() || (fd.Kind() == protoreflect.MessageKind && fd.Message().FullName() != "google.protobuf.Timestamp") {
+			continue
+		}
+
+		param := object{{"name", fd.JSONName()}, {"in", "query"}, {"required", false}}
+		if c := comment(fd); c != "" {
+			param.set("description", c)
+		}
+
+		schema := fieldSchema(fd)
+		if fd.Kind() == protoreflect.EnumKind {
+			ed := fd.Enum()
+			values := make([]string, 0, ed.Values().Len())
+			for j := 0; j < ed.Values().Len(); j++ {
+				values = append(values, string(ed.Values().Get(j).Name()))
+			}
+			schema = object{{"type", "string"}, {"enum", values}}
+		}
+
+		if fd.IsList() {
+			param.set("type", "array")
+			param.set("items", schema)
+			param.set("collectionFormat", "multi")
+		} else {
+			param = append(param, schema...)
+		}
+
+		res = append(res, param)
+	}
+
+	return res
+}
+
+func main() {
+
+	parseComments("posts.proto")
+
+	definitions.set("rpcStatus", object{
+		{"type", "object"},
+		{"properties", object{
+			{"code", object{{"type", "integer"}, {"format", "int32"}, {"description", "Код ошибки gRPC."}}},
+			{"message", object{{"type", "string"}}},
+			{"details", object{{"type", "array"}, {"items", object{{"type", "object"}}}}},
+		}},
+	})
+
+	paths := object{}
+
+	sd := pb.File_posts_proto.Services().ByName(protoreflect.Name(pb.Posts_ServiceDesc.ServiceName))
+	for i := 0; i < sd.Methods().Len(); i++ {
+		m := sd.Methods().Get(i)
+
+		rule, ok := proto.GetExtension(m.Options(), annotations.E_Http).(*annotations.HttpRule)
+		if !ok || rule == nil {
+			continue
+		}
+
+		var method, path string
+		switch p := rule.Pattern.(type) {
+		case *annotations.HttpRule_Get:
+			method, path = "get", p.Get
+		case *annotations.HttpRule_Post:
+			method, path = "post", p.Post
+		case *annotations.HttpRule_Put:
+			method, path = "put", p.Put
+		case *annotations.HttpRule_Delete:
+			method, path = "delete", p.Delete
+		case *annotations.HttpRule_Patch:
+			method, path = "patch", p.Patch
+		default:
+			log.Fatalf("unsupported http rule of %s", m.Name())
+		}
+
+		op := object{{"operationId", string(m.Name())}}
+		if c := comment(m); c != "" {
+			summary, description, _ := strings.Cut(c, "\n")
+			op.set("summary", summary)
+			if description = strings.TrimSpace(description); description != "" {
+				op.set("description", description)
+			}
+		}
+		op.set("tags", []string{string(sd.Name())})
+
+		var params []object
+		switch rule.Body {
+		case "":
+			params = queryParameters(m.Input())
+		case "*":
+			defineMessage(m.Input())
+			params = []object{{{"name", "body"}, {"in", "body"}, {"required", true}, {"schema", ref(m.Input().Name())}}}
+		default:
+			fd := m.Input().Fields().ByName(protoreflect.Name(rule.Body))
+			params = []object{{{"name", string(fd.JSONName())}, {"in", "body"}, {"required", true}, {"schema", fieldSchema(fd)}}}
+		}
+		op.set("parameters", params)
+
+		defineMessage(m.Output())
+		response := object{}
+		if m.IsStreamingServer() {
+			response.set("description", "Поток JSON-объектов, по одному на строку. Поток завершается объектом с error.")
+			response.set("schema", object{
+				{"type", "object"},
+				{"properties", object{
+					{"result", ref(m.Output().Name())},
+					{"error", ref("rpcStatus")},
+				}},
+			})
+		} else {
+			response.set("description", "Успешный ответ.")
+			response.set("schema", ref(m.Output().Name()))
+		}
+		op.set("responses", object{
+			{"200", response},
+			{"default", object{{"description", "Ошибка, код HTTP соответствует коду gRPC."}, {"schema", ref("rpcStatus")}}},
+		})
+
+		paths.set(path, object{{method, op}})
+	}
+
+	doc := object{
+		{"swagger", "2.0"},
+		{"info", object{
+			{"title", string(sd.Name())},
+			{"description", "REST-шлюз сервиса публикаций. Запросы и ответы в JSON, поля запроса GET передаются в параметрах строки запроса."},
+			{"version", "1.0"},
+		}},
+		{"consumes", []string{"application/json"}},
+		{"produces", []string{"application/json"}},
+		{"securityDefinitions", object{
+			{"Bearer", object{
+				{"type", "apiKey"},
+				{"name", "Authorization"},
+				{"in", "header"},
+				{"description", "Токен доступа в виде: Bearer <token>"},
+			}},
+		}},
+		{"security", []object{{{"Bearer", []string{}}}}},
+		{"paths", paths},
+		{"definitions", definitions},
+	}
+
+	data, err := marshal(doc)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	buf := &bytes.Buffer{}
+	err = json.Indent(buf, data, "", "  ")
+	if err != nil {
+		log.Fatal(err)
+	}
+	buf.WriteByte('\n')
+
+	err = os.WriteFile(output, buf.Bytes(), 0644)
+	if err != nil {
+		log.Fatal(err)
+	}
+}
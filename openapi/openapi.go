@@ -0,0 +1,66 @@
+// Package openapi serves the OpenAPI document of the REST gateway and a
+// Swagger UI page for it. The page is a small explorer of the document
+// written for this service, it loads no third party scripts. The document is generated from the
+// google.api.http annotations and the doc comments of posts.proto by
+// go generate, it must be regenerated with the pb package.
+package openapi
+
+import (
+	"bytes"
+	"embed"
+	"net/http"
+	"strings"
+	"time"
+)
+
+//go:generate go run gen.go
+
+//go:embed posts.swagger.json
+var document []byte
+
+//go:embed swagger.html swagger.js swagger.css
+var ui embed.FS
+
+const (
+	// DocumentPath is the path the document is served at by Handler.
+	DocumentPath = "/openapi.json"
+	// UIPath is the path UIHandler is mounted at.
+	UIPath = "/swagger/"
+)
+
+// uiPolicy allows the page to load only its own files.
+const uiPolicy = "default-src 'self'"
+
+// Handler serves the document.
+func Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(document)
+	})
+}
+
+// UIHandler serves the Swagger UI page of the document at DocumentPath
+// under UIPath. The page sends requests to the server it is served by,
+// so a read only page that sends none is served where there is no
+// gateway, like next to the metrics.
+func UIHandler(readOnly bool) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+
+		name := strings.TrimPrefix(r.URL.Path, UIPath)
+		if name == "" {
+			name = "swagger.html"
+		}
+
+		b, err := ui.ReadFile(name)
+		if err != nil {
+			http.NotFound(w, r)
+			return
+		}
+		if name == "swagger.html" && readOnly {
+			b = bytes.Replace(b, []byte("<body>"), []byte("<body data-readonly>"), 1)
+		}
+
+		w.Header().Set("Content-Security-Policy", uiPolicy)
+		http.ServeContent(w, r, name, time.Time{}, bytes.NewReader(b))
+	})
+}
@@ -0,0 +1,38 @@
+package openapi
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func get(h http.Handler, path string) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, path, nil))
+	return w
+}
+
+func TestUIHandler(t *testing.T) {
+
+	for _, path := range []string{UIPath, UIPath + "swagger.js", UIPath + "swagger.css"} {
+		w := get(UIHandler(false), path)
+		if w.Code != http.StatusOK {
+			t.Errorf("%s: got status %d", path, w.Code)
+		}
+		if w.Header().Get("Content-Security-Policy") != uiPolicy {
+			t.Errorf("%s: got policy %q", path, w.Header().Get("Content-Security-Policy"))
+		}
+	}
+
+	if w := get(UIHandler(false), UIPath+"openapi.go"); w.Code != http.StatusNotFound {
+		t.Errorf("source file: got status %d, want 404", w.Code)
+	}
+
+	if body := get(UIHandler(false), UIPath).Body.String(); strings.Contains(body, "data-readonly") {
+		t.Error("gateway page is read only")
+	}
+	if body := get(UIHandler(true), UIPath).Body.String(); !strings.Contains(body, "<body data-readonly>") {
+		t.Error("metrics page is not read only")
+	}
+}
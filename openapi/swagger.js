@@ -0,0 +1,204 @@
+// The explorer of the OpenAPI document: it lists the operations with
+// their parameters and schemas and sends requests to the gateway. The
+// page serves from the same origin as the gateway, so requests go to the
+// paths of the document as is. With data-readonly on the body requests
+// are not sent, the copy next to the metrics has no gateway routes.
+"use strict";
+
+(function () {
+
+  var root = document.getElementById("api");
+  var readOnly = document.body.hasAttribute("data-readonly");
+  var token = "";
+
+  function el(tag, attrs, children) {
+    var node = document.createElement(tag);
+    Object.keys(attrs || {}).forEach(function (key) {
+      node.setAttribute(key, attrs[key]);
+    });
+    (children || []).forEach(function (child) {
+      node.append(child);
+    });
+    return node;
+  }
+
+  function resolve(doc, schema) {
+    while (schema && schema.$ref) {
+      schema = doc.definitions[schema.$ref.replace("#/definitions/", "")];
+    }
+    return schema || {};
+  }
+
+  // example builds a sample value of the schema, recursive messages stop
+  // at the depth limit.
+  function example(doc, schema, depth) {
+    schema = resolve(doc, schema);
+    if (depth > 4) {
+      return null;
+    }
+    if (schema.enum) {
+      return schema.enum[0];
+    }
+    switch (schema.type) {
+    case "object":
+      var obj = {};
+      Object.keys(schema.properties || {}).forEach(function (key) {
+        obj[key] = example(doc, schema.properties[key], depth + 1);
+      });
+      return obj;
+    case "array":
+      return [example(doc, schema.items, depth + 1)];
+    case "boolean":
+      return false;
+    case "integer":
+    case "number":
+      return 0;
+    case "string":
+      if (schema.format === "int64" || schema.format === "uint64") {
+        return "0";
+      }
+      if (schema.format === "date-time") {
+        return new Date(0).toISOString();
+      }
+      return "";
+    }
+    return {};
+  }
+
+  function json(value) {
+    return JSON.stringify(value, null, 2);
+  }
+
+  function parameters(op) {
+    var rows = (op.parameters || []).filter(function (p) {
+      return p.in !== "body";
+    }).map(function (p) {
+      var type = p.type === "array" ? "array of " + (p.items.enum ? p.items.enum.join(" | ") : p.items.type) : p.type;
+      if (p.format) {
+        type += " (" + p.format + ")";
+      }
+      var input = el("input", {name: p.name, placeholder: p.type === "array" ? "через запятую" : ""});
+      return el("tr", {}, [el("td", {}, [p.name]), el("td", {}, [type]), el("td", {}, [p.description || ""]), el("td", {}, readOnly ? [] : [input])]);
+    });
+    if (rows.length === 0) {
+      return null;
+    }
+    return el("table", {}, [el("tr", {}, [el("th", {}, ["Параметр"]), el("th", {}, ["Тип"]), el("th", {}, ["Описание"]), el("th", {}, [])])].concat(rows));
+  }
+
+  function send(method, path, op, form, output) {
+    var query = new URLSearchParams();
+    form.querySelectorAll("input[name]").forEach(function (input) {
+      if (input.value === "") {
+        return;
+      }
+      var param = op.parameters.find(function (p) { return p.name === input.name; });
+      if (param && param.type === "array") {
+        input.value.split(",").forEach(function (v) { query.append(input.name, v.trim()); });
+      } else {
+        query.append(input.name, input.value);
+      }
+    });
+
+    var init = {method: method.toUpperCase(), headers: {}};
+    if (token !== "") {
+      init.headers.Authorization = "Bearer " + token;
+    }
+    var body = form.querySelector("textarea");
+    if (body) {
+      init.headers["Content-Type"] = "application/json";
+      init.body = body.value;
+    }
+
+    var url = path + (query.toString() ? "?" + query.toString() : "");
+    output.textContent = "…";
+    fetch(url, init).then(function (res) {
+      return res.text().then(function (text) {
+        try {
+          text = json(JSON.parse(text));
+        } catch (e) {
+        }
+        output.textContent = res.status + " " + res.statusText + "\n\n" + text;
+      });
+    }).catch(function (err) {
+      output.textContent = String(err);
+    });
+  }
+
+  function operation(doc, path, method, op) {
+    var children = [];
+    if (op.description) {
+      children.push(el("p", {}, [op.description]));
+    }
+
+    var form = el("form", {}, []);
+    var params = parameters(op);
+    if (params) {
+      form.append(params);
+    }
+
+    var body = (op.parameters || []).find(function (p) { return p.in === "body"; });
+    if (body) {
+      form.append(el("h4", {}, ["Тело запроса"]));
+      var sample = json(example(doc, body.schema, 0));
+      if (readOnly) {
+        form.append(el("pre", {}, [sample]));
+      } else {
+        var textarea = el("textarea", {}, []);
+        textarea.value = sample;
+        form.append(textarea);
+      }
+    }
+    children.push(form);
+
+    var ok = op.responses && op.responses["200"];
+    if (ok && ok.schema) {
+      children.push(el("h4", {}, ["Ответ"]));
+      children.push(el("pre", {}, [json(example(doc, ok.schema, 0))]));
+    }
+
+    if (!readOnly) {
+      var output = el("pre", {}, []);
+      var button = el("button", {type: "submit"}, ["Выполнить"]);
+      form.append(button);
+      form.addEventListener("submit", function (e) {
+        e.preventDefault();
+        send(method, path, op, form, output);
+      });
+      children.push(output);
+    }
+
+    var summary = el("summary", {}, [el("span", {class: "method " + method}, [method.toUpperCase()]), el("span", {class: "path"}, [path]), op.summary || ""]);
+    return el("details", {}, [summary, el("div", {class: "operation"}, children)]);
+  }
+
+  function render(doc) {
+    root.append(el("h1", {}, [doc.info.title + " " + doc.info.version]));
+    if (doc.info.description) {
+      root.append(el("p", {}, [doc.info.description]));
+    }
+
+    if (readOnly) {
+      root.append(el("p", {class: "note"}, ["Эта копия опубликована рядом с метриками и не отправляет запросы. Выполнить запросы можно на странице /swagger/ шлюза (HTTP_PORT)."]));
+    } else {
+      var input = el("input", {type: "password", placeholder: "токен доступа"}, []);
+      input.addEventListener("input", function () { token = input.value.trim(); });
+      root.append(el("div", {class: "auth"}, ["Bearer ", input]));
+    }
+
+    Object.keys(doc.paths).forEach(function (path) {
+      Object.keys(doc.paths[path]).forEach(function (method) {
+        root.append(operation(doc, path, method, doc.paths[path][method]));
+      });
+    });
+  }
+
+  fetch("/openapi.json").then(function (res) {
+    if (!res.ok) {
+      throw new Error(res.status + " " + res.statusText);
+    }
+    return res.json();
+  }).then(render).catch(function (err) {
+    root.append(el("p", {class: "error"}, ["Не удалось загрузить /openapi.json: " + err]));
+  });
+})();
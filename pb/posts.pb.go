@@ -22,27 +22,233 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
-type GetPostByIdRequest struct {
+type TextEntityType int32
+
+const (
+	TextEntityType_bold          TextEntityType = 0
+	TextEntityType_italic        TextEntityType = 1
+	TextEntityType_strikethrough TextEntityType = 2
+	TextEntityType_code          TextEntityType = 3
+	TextEntityType_link          TextEntityType = 4
+)
+
+// Enum value maps for TextEntityType.
+var (
+	TextEntityType_name = map[int32]string{
+		0: "bold",
+		1: "italic",
+		2: "strikethrough",
+		3: "code",
+		4: "link",
+	}
+	TextEntityType_value = map[string]int32{
+		"bold":          0,
+		"italic":        1,
+		"strikethrough": 2,
+		"code":          3,
+		"link":          4,
+	}
+)
+
+func (x TextEntityType) Enum() *TextEntityType {
+	p := new(TextEntityType)
+	*p = x
+	return p
+}
+
+func (x TextEntityType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TextEntityType) Descriptor() protoreflect.EnumDescriptor {
+	return file_posts_proto_enumTypes[0].Descriptor()
+}
+
+func (TextEntityType) Type() protoreflect.EnumType {
+	return &file_posts_proto_enumTypes[0]
+}
+
+func (x TextEntityType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TextEntityType.Descriptor instead.
+func (TextEntityType) EnumDescriptor() ([]byte, []int) {
+	return file_posts_proto_rawDescGZIP(), []int{0}
+}
+
+// Кому виден пост.
+type Visibility int32
+
+const (
+	// Всем пользователям.
+	Visibility_public Visibility = 0
+	// Только подписчикам владельца.
+	Visibility_subscribers Visibility = 1
+	// Только владельцу.
+	Visibility_only_me Visibility = 2
+	// Только пользователям из allowed_ids.
+	Visibility_custom Visibility = 3
+	// Только участникам списка audience_id.
+	Visibility_audience Visibility = 4
+)
+
+// Enum value maps for Visibility.
+var (
+	Visibility_name = map[int32]string{
+		0: "public",
+		1: "subscribers",
+		2: "only_me",
+		3: "custom",
+		4: "audience",
+	}
+	Visibility_value = map[string]int32{
+		"public":      0,
+		"subscribers": 1,
+		"only_me":     2,
+		"custom":      3,
+		"audience":    4,
+	}
+)
+
+func (x Visibility) Enum() *Visibility {
+	p := new(Visibility)
+	*p = x
+	return p
+}
+
+func (x Visibility) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Visibility) Descriptor() protoreflect.EnumDescriptor {
+	return file_posts_proto_enumTypes[1].Descriptor()
+}
+
+func (Visibility) Type() protoreflect.EnumType {
+	return &file_posts_proto_enumTypes[1]
+}
+
+func (x Visibility) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Visibility.Descriptor instead.
+func (Visibility) EnumDescriptor() ([]byte, []int) {
+	return file_posts_proto_rawDescGZIP(), []int{1}
+}
+
+type StatsGranularity int32
+
+const (
+	StatsGranularity_hour StatsGranularity = 0
+	StatsGranularity_day  StatsGranularity = 1
+)
+
+// Enum value maps for StatsGranularity.
+var (
+	StatsGranularity_name = map[int32]string{
+		0: "hour",
+		1: "day",
+	}
+	StatsGranularity_value = map[string]int32{
+		"hour": 0,
+		"day":  1,
+	}
+)
+
+func (x StatsGranularity) Enum() *StatsGranularity {
+	p := new(StatsGranularity)
+	*p = x
+	return p
+}
+
+func (x StatsGranularity) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (StatsGranularity) Descriptor() protoreflect.EnumDescriptor {
+	return file_posts_proto_enumTypes[2].Descriptor()
+}
+
+func (StatsGranularity) Type() protoreflect.EnumType {
+	return &file_posts_proto_enumTypes[2]
+}
+
+func (x StatsGranularity) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use StatsGranularity.Descriptor instead.
+func (StatsGranularity) EnumDescriptor() ([]byte, []int) {
+	return file_posts_proto_rawDescGZIP(), []int{2}
+}
+
+// Тип обновления в подписках.
+type UpdateType int32
+
+const (
+	// Новый пост, задан post.
+	UpdateType_post_created UpdateType = 0
+	// Новый комментарий, задан comment.
+	UpdateType_comment_created UpdateType = 1
+	// Изменилось количество лайков поста, задано likes.
+	UpdateType_likes_changed UpdateType = 2
+)
+
+// Enum value maps for UpdateType.
+var (
+	UpdateType_name = map[int32]string{
+		0: "post_created",
+		1: "comment_created",
+		2: "likes_changed",
+	}
+	UpdateType_value = map[string]int32{
+		"post_created":    0,
+		"comment_created": 1,
+		"likes_changed":   2,
+	}
+)
+
+func (x UpdateType) Enum() *UpdateType {
+	p := new(UpdateType)
+	*p = x
+	return p
+}
+
+func (x UpdateType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (UpdateType) Descriptor() protoreflect.EnumDescriptor {
+	return file_posts_proto_enumTypes[3].Descriptor()
+}
+
+func (UpdateType) Type() protoreflect.EnumType {
+	return &file_posts_proto_enumTypes[3]
+}
+
+func (x UpdateType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use UpdateType.Descriptor instead.
+func (UpdateType) EnumDescriptor() ([]byte, []int) {
+	return file_posts_proto_rawDescGZIP(), []int{3}
+}
+
+type VotePollRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id       uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Extended bool   `protobuf:"varint,3,opt,name=extended,proto3" json:"extended,omitempty"`
-	// если true, вернется информация о владельцах комментариев.
-	CommentsExtended bool `protobuf:"varint,4,opt,name=comments_extended,json=commentsExtended,proto3" json:"comments_extended,omitempty"`
-	// количество комментариев, которые необходимо вернуть.
-	CommentsLimit int64 `protobuf:"varint,5,opt,name=comments_limit,json=commentsLimit,proto3" json:"comments_limit,omitempty"`
-	// Список дополнительных полей владельцев комментариев, которые необходимо вернуть.
-	CommentsFields []UserFields `protobuf:"varint,6,rep,packed,name=comments_fields,json=commentsFields,proto3,enum=UserFields" json:"comments_fields,omitempty"`
-	// Направление сортировки комментариев. false - сначала новые, true - сначала старые.
-	CommentsSortDir bool `protobuf:"varint,7,opt,name=comments_sort_dir,json=commentsSortDir,proto3" json:"comments_sort_dir,omitempty"`
-	// Список дополнительных полей владельцев постов, которые необходимо вернуть.
-	Fields []UserFields `protobuf:"varint,8,rep,packed,name=fields,proto3,enum=UserFields" json:"fields,omitempty"`
+	PostId uint64 `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	// Выбранные варианты. Если опрос не multiple, ровно один.
+	OptionIds []int32 `protobuf:"varint,2,rep,packed,name=option_ids,json=optionIds,proto3" json:"option_ids,omitempty"`
 }
 
-func (x *GetPostByIdRequest) Reset() {
-	*x = GetPostByIdRequest{}
+func (x *VotePollRequest) Reset() {
+	*x = VotePollRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_posts_proto_msgTypes[0]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -50,13 +256,13 @@ func (x *GetPostByIdRequest) Reset() {
 	}
 }
 
-func (x *GetPostByIdRequest) String() string {
+func (x *VotePollRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPostByIdRequest) ProtoMessage() {}
+func (*VotePollRequest) ProtoMessage() {}
 
-func (x *GetPostByIdRequest) ProtoReflect() protoreflect.Message {
+func (x *VotePollRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_posts_proto_msgTypes[0]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -68,70 +274,35 @@ func (x *GetPostByIdRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPostByIdRequest.ProtoReflect.Descriptor instead.
-func (*GetPostByIdRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use VotePollRequest.ProtoReflect.Descriptor instead.
+func (*VotePollRequest) Descriptor() ([]byte, []int) {
 	return file_posts_proto_rawDescGZIP(), []int{0}
 }
 
-func (x *GetPostByIdRequest) GetId() uint64 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
-}
-
-func (x *GetPostByIdRequest) GetExtended() bool {
-	if x != nil {
-		return x.Extended
-	}
-	return false
-}
-
-func (x *GetPostByIdRequest) GetCommentsExtended() bool {
-	if x != nil {
-		return x.CommentsExtended
-	}
-	return false
-}
-
-func (x *GetPostByIdRequest) GetCommentsLimit() int64 {
+func (x *VotePollRequest) GetPostId() uint64 {
 	if x != nil {
-		return x.CommentsLimit
+		return x.PostId
 	}
 	return 0
 }
 
-func (x *GetPostByIdRequest) GetCommentsFields() []UserFields {
-	if x != nil {
-		return x.CommentsFields
-	}
-	return nil
-}
-
-func (x *GetPostByIdRequest) GetCommentsSortDir() bool {
-	if x != nil {
-		return x.CommentsSortDir
-	}
-	return false
-}
-
-func (x *GetPostByIdRequest) GetFields() []UserFields {
+func (x *VotePollRequest) GetOptionIds() []int32 {
 	if x != nil {
-		return x.Fields
+		return x.OptionIds
 	}
 	return nil
 }
 
-type GetPostByIdResponse struct {
+type VotePollResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Post *Post `protobuf:"bytes,1,opt,name=post,proto3" json:"post,omitempty"`
+	Poll *Poll `protobuf:"bytes,1,opt,name=poll,proto3" json:"poll,omitempty"`
 }
 
-func (x *GetPostByIdResponse) Reset() {
-	*x = GetPostByIdResponse{}
+func (x *VotePollResponse) Reset() {
+	*x = VotePollResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_posts_proto_msgTypes[1]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -139,13 +310,13 @@ func (x *GetPostByIdResponse) Reset() {
 	}
 }
 
-func (x *GetPostByIdResponse) String() string {
+func (x *VotePollResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPostByIdResponse) ProtoMessage() {}
+func (*VotePollResponse) ProtoMessage() {}
 
-func (x *GetPostByIdResponse) ProtoReflect() protoreflect.Message {
+func (x *VotePollResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_posts_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -157,19 +328,19 @@ func (x *GetPostByIdResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPostByIdResponse.ProtoReflect.Descriptor instead.
-func (*GetPostByIdResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use VotePollResponse.ProtoReflect.Descriptor instead.
+func (*VotePollResponse) Descriptor() ([]byte, []int) {
 	return file_posts_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *GetPostByIdResponse) GetPost() *Post {
+func (x *VotePollResponse) GetPoll() *Poll {
 	if x != nil {
-		return x.Post
+		return x.Poll
 	}
 	return nil
 }
 
-type UpdatePostRequest struct {
+type RetractVoteRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -177,8 +348,8 @@ type UpdatePostRequest struct {
 	PostId uint64 `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
 }
 
-func (x *UpdatePostRequest) Reset() {
-	*x = UpdatePostRequest{}
+func (x *RetractVoteRequest) Reset() {
+	*x = RetractVoteRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_posts_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -186,13 +357,13 @@ func (x *UpdatePostRequest) Reset() {
 	}
 }
 
-func (x *UpdatePostRequest) String() string {
+func (x *RetractVoteRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdatePostRequest) ProtoMessage() {}
+func (*RetractVoteRequest) ProtoMessage() {}
 
-func (x *UpdatePostRequest) ProtoReflect() protoreflect.Message {
+func (x *RetractVoteRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_posts_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -204,28 +375,28 @@ func (x *UpdatePostRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdatePostRequest.ProtoReflect.Descriptor instead.
-func (*UpdatePostRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RetractVoteRequest.ProtoReflect.Descriptor instead.
+func (*RetractVoteRequest) Descriptor() ([]byte, []int) {
 	return file_posts_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *UpdatePostRequest) GetPostId() uint64 {
+func (x *RetractVoteRequest) GetPostId() uint64 {
 	if x != nil {
 		return x.PostId
 	}
 	return 0
 }
 
-type UpdatePostResponse struct {
+type RetractVoteResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Post *Post `protobuf:"bytes,1,opt,name=post,proto3" json:"post,omitempty"`
+	Poll *Poll `protobuf:"bytes,1,opt,name=poll,proto3" json:"poll,omitempty"`
 }
 
-func (x *UpdatePostResponse) Reset() {
-	*x = UpdatePostResponse{}
+func (x *RetractVoteResponse) Reset() {
+	*x = RetractVoteResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_posts_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -233,13 +404,13 @@ func (x *UpdatePostResponse) Reset() {
 	}
 }
 
-func (x *UpdatePostResponse) String() string {
+func (x *RetractVoteResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdatePostResponse) ProtoMessage() {}
+func (*RetractVoteResponse) ProtoMessage() {}
 
-func (x *UpdatePostResponse) ProtoReflect() protoreflect.Message {
+func (x *RetractVoteResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_posts_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -251,36 +422,34 @@ func (x *UpdatePostResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdatePostResponse.ProtoReflect.Descriptor instead.
-func (*UpdatePostResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use RetractVoteResponse.ProtoReflect.Descriptor instead.
+func (*RetractVoteResponse) Descriptor() ([]byte, []int) {
 	return file_posts_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *UpdatePostResponse) GetPost() *Post {
+func (x *RetractVoteResponse) GetPoll() *Poll {
 	if x != nil {
-		return x.Post
+		return x.Poll
 	}
 	return nil
 }
 
-type GetCommentsListRequest struct {
+type GetMentionsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PostId uint64 `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
-	Limit  int64  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
-	LastId uint64 `protobuf:"varint,3,opt,name=last_id,json=lastId,proto3" json:"last_id,omitempty"`
-	// Если true, вернет информацию о вледельцах (пользователях).
-	Extended bool `protobuf:"varint,4,opt,name=extended,proto3" json:"extended,omitempty"`
-	// Направление сортировки. false - сначала новые. true - сначала старые.
-	SortDir bool `protobuf:"varint,5,opt,name=sort_dir,json=sortDir,proto3" json:"sort_dir,omitempty"`
-	// Список полей владельцев (пользователей), которые нужно вернуть.
-	Fields []UserFields `protobuf:"varint,6,rep,packed,name=fields,proto3,enum=UserFields" json:"fields,omitempty"`
+	Limit int64 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	// id поста или комментария, полученного в предыдущей выборке.
+	LastId uint64 `protobuf:"varint,2,opt,name=last_id,json=lastId,proto3" json:"last_id,omitempty"`
+	// если true, вернется информация о владельцах постов и комментариев.
+	Extended bool `protobuf:"varint,3,opt,name=extended,proto3" json:"extended,omitempty"`
+	// Список дополнительных полей владельцев, которые необходимо вернуть.
+	Fields []UserFields `protobuf:"varint,4,rep,packed,name=fields,proto3,enum=UserFields" json:"fields,omitempty"`
 }
 
-func (x *GetCommentsListRequest) Reset() {
-	*x = GetCommentsListRequest{}
+func (x *GetMentionsRequest) Reset() {
+	*x = GetMentionsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_posts_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -288,13 +457,13 @@ func (x *GetCommentsListRequest) Reset() {
 	}
 }
 
-func (x *GetCommentsListRequest) String() string {
+func (x *GetMentionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCommentsListRequest) ProtoMessage() {}
+func (*GetMentionsRequest) ProtoMessage() {}
 
-func (x *GetCommentsListRequest) ProtoReflect() protoreflect.Message {
+func (x *GetMentionsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_posts_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -306,63 +475,51 @@ func (x *GetCommentsListRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCommentsListRequest.ProtoReflect.Descriptor instead.
-func (*GetCommentsListRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetMentionsRequest.ProtoReflect.Descriptor instead.
+func (*GetMentionsRequest) Descriptor() ([]byte, []int) {
 	return file_posts_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *GetCommentsListRequest) GetPostId() uint64 {
-	if x != nil {
-		return x.PostId
-	}
-	return 0
-}
-
-func (x *GetCommentsListRequest) GetLimit() int64 {
+func (x *GetMentionsRequest) GetLimit() int64 {
 	if x != nil {
 		return x.Limit
 	}
 	return 0
 }
 
-func (x *GetCommentsListRequest) GetLastId() uint64 {
+func (x *GetMentionsRequest) GetLastId() uint64 {
 	if x != nil {
 		return x.LastId
 	}
 	return 0
 }
 
-func (x *GetCommentsListRequest) GetExtended() bool {
+func (x *GetMentionsRequest) GetExtended() bool {
 	if x != nil {
 		return x.Extended
 	}
 	return false
 }
 
-func (x *GetCommentsListRequest) GetSortDir() bool {
-	if x != nil {
-		return x.SortDir
-	}
-	return false
-}
-
-func (x *GetCommentsListRequest) GetFields() []UserFields {
+func (x *GetMentionsRequest) GetFields() []UserFields {
 	if x != nil {
 		return x.Fields
 	}
 	return nil
 }
 
-type GetCommentsListResponse struct {
+// Пост или комментарий с упоминанием. Задано только одно из полей.
+type MentionItem struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Comments []*Comment `protobuf:"bytes,1,rep,name=comments,proto3" json:"comments,omitempty"`
+	Post    *Post    `protobuf:"bytes,1,opt,name=post,proto3" json:"post,omitempty"`
+	Comment *Comment `protobuf:"bytes,2,opt,name=comment,proto3" json:"comment,omitempty"`
 }
 
-func (x *GetCommentsListResponse) Reset() {
-	*x = GetCommentsListResponse{}
+func (x *MentionItem) Reset() {
+	*x = MentionItem{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_posts_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -370,13 +527,13 @@ func (x *GetCommentsListResponse) Reset() {
 	}
 }
 
-func (x *GetCommentsListResponse) String() string {
+func (x *MentionItem) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCommentsListResponse) ProtoMessage() {}
+func (*MentionItem) ProtoMessage() {}
 
-func (x *GetCommentsListResponse) ProtoReflect() protoreflect.Message {
+func (x *MentionItem) ProtoReflect() protoreflect.Message {
 	mi := &file_posts_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -388,35 +545,35 @@ func (x *GetCommentsListResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCommentsListResponse.ProtoReflect.Descriptor instead.
-func (*GetCommentsListResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use MentionItem.ProtoReflect.Descriptor instead.
+func (*MentionItem) Descriptor() ([]byte, []int) {
 	return file_posts_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *GetCommentsListResponse) GetComments() []*Comment {
+func (x *MentionItem) GetPost() *Post {
 	if x != nil {
-		return x.Comments
+		return x.Post
 	}
 	return nil
 }
 
-type Comment struct {
+func (x *MentionItem) GetComment() *Comment {
+	if x != nil {
+		return x.Comment
+	}
+	return nil
+}
+
+type GetMentionsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id          uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	PostId      uint64                 `protobuf:"varint,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
-	OwnerId     int64                  `protobuf:"varint,3,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
-	Message     string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
-	Attachments []*Attachment          `protobuf:"bytes,5,rep,name=attachments,proto3" json:"attachments,omitempty"`
-	Time        *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=time,proto3" json:"time,omitempty"`
-	// Тот, кто оставил комментарий. Возвращается, если extended = true.
-	Owner *User `protobuf:"bytes,7,opt,name=owner,proto3" json:"owner,omitempty"`
+	Items []*MentionItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
 }
 
-func (x *Comment) Reset() {
-	*x = Comment{}
+func (x *GetMentionsResponse) Reset() {
+	*x = GetMentionsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_posts_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -424,13 +581,13 @@ func (x *Comment) Reset() {
 	}
 }
 
-func (x *Comment) String() string {
+func (x *GetMentionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Comment) ProtoMessage() {}
+func (*GetMentionsResponse) ProtoMessage() {}
 
-func (x *Comment) ProtoReflect() protoreflect.Message {
+func (x *GetMentionsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_posts_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -442,74 +599,43 @@ func (x *Comment) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Comment.ProtoReflect.Descriptor instead.
-func (*Comment) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetMentionsResponse.ProtoReflect.Descriptor instead.
+func (*GetMentionsResponse) Descriptor() ([]byte, []int) {
 	return file_posts_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *Comment) GetId() uint64 {
+func (x *GetMentionsResponse) GetItems() []*MentionItem {
 	if x != nil {
-		return x.Id
+		return x.Items
 	}
-	return 0
+	return nil
 }
 
-func (x *Comment) GetPostId() uint64 {
-	if x != nil {
-		return x.PostId
-	}
-	return 0
-}
-
-func (x *Comment) GetOwnerId() int64 {
-	if x != nil {
-		return x.OwnerId
-	}
-	return 0
-}
-
-func (x *Comment) GetMessage() string {
-	if x != nil {
-		return x.Message
-	}
-	return ""
-}
-
-func (x *Comment) GetAttachments() []*Attachment {
-	if x != nil {
-		return x.Attachments
-	}
-	return nil
-}
-
-func (x *Comment) GetTime() *timestamppb.Timestamp {
-	if x != nil {
-		return x.Time
-	}
-	return nil
-}
-
-func (x *Comment) GetOwner() *User {
-	if x != nil {
-		return x.Owner
-	}
-	return nil
-}
-
-type WriteCommentRequest struct {
+type GetPostsByTagRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PostId uint64 `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
-	// Сообщение. Обязательно, если не задан attachments.
-	Messaage string `protobuf:"bytes,2,opt,name=messaage,proto3" json:"messaage,omitempty"`
-	// Вложения. Обязательно, если не задан messaage.
-	AttachmentsIds []*AttachmentId `protobuf:"bytes,3,rep,name=attachmentsIds,proto3" json:"attachmentsIds,omitempty"`
+	// Хештег, можно с #.
+	Tag    string `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	Limit  int64  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	LastId uint64 `protobuf:"varint,3,opt,name=last_id,json=lastId,proto3" json:"last_id,omitempty"`
+	// если true, вернется информация о пользователях и комментариях.
+	Extended bool `protobuf:"varint,4,opt,name=extended,proto3" json:"extended,omitempty"`
+	// если true, вернется информация о владельцах комментариев.
+	CommentsExtended bool `protobuf:"varint,5,opt,name=comments_extended,json=commentsExtended,proto3" json:"comments_extended,omitempty"`
+	// количество комментариев, которые необходимо вернуть.
+	CommentsLimit int64 `protobuf:"varint,6,opt,name=comments_limit,json=commentsLimit,proto3" json:"comments_limit,omitempty"`
+	// Список дополнительных полей владельцев комментариев, которые необходимо вернуть.
+	CommentsFields []UserFields `protobuf:"varint,7,rep,packed,name=comments_fields,json=commentsFields,proto3,enum=UserFields" json:"comments_fields,omitempty"`
+	// Направление сортировки комментариев. false - сначала новые, true - сначала старые.
+	CommentsSortDir bool `protobuf:"varint,8,opt,name=comments_sort_dir,json=commentsSortDir,proto3" json:"comments_sort_dir,omitempty"`
+	// Список дополнительных полей владельцев постов, которые необходимо вернуть.
+	Fields []UserFields `protobuf:"varint,9,rep,packed,name=fields,proto3,enum=UserFields" json:"fields,omitempty"`
 }
 
-func (x *WriteCommentRequest) Reset() {
-	*x = WriteCommentRequest{}
+func (x *GetPostsByTagRequest) Reset() {
+	*x = GetPostsByTagRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_posts_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -517,13 +643,13 @@ func (x *WriteCommentRequest) Reset() {
 	}
 }
 
-func (x *WriteCommentRequest) String() string {
+func (x *GetPostsByTagRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WriteCommentRequest) ProtoMessage() {}
+func (*GetPostsByTagRequest) ProtoMessage() {}
 
-func (x *WriteCommentRequest) ProtoReflect() protoreflect.Message {
+func (x *GetPostsByTagRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_posts_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -535,42 +661,84 @@ func (x *WriteCommentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WriteCommentRequest.ProtoReflect.Descriptor instead.
-func (*WriteCommentRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetPostsByTagRequest.ProtoReflect.Descriptor instead.
+func (*GetPostsByTagRequest) Descriptor() ([]byte, []int) {
 	return file_posts_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *WriteCommentRequest) GetPostId() uint64 {
+func (x *GetPostsByTagRequest) GetTag() string {
 	if x != nil {
-		return x.PostId
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *GetPostsByTagRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
 	}
 	return 0
 }
 
-func (x *WriteCommentRequest) GetMessaage() string {
+func (x *GetPostsByTagRequest) GetLastId() uint64 {
 	if x != nil {
-		return x.Messaage
+		return x.LastId
 	}
-	return ""
+	return 0
 }
 
-func (x *WriteCommentRequest) GetAttachmentsIds() []*AttachmentId {
+func (x *GetPostsByTagRequest) GetExtended() bool {
 	if x != nil {
-		return x.AttachmentsIds
+		return x.Extended
+	}
+	return false
+}
+
+func (x *GetPostsByTagRequest) GetCommentsExtended() bool {
+	if x != nil {
+		return x.CommentsExtended
+	}
+	return false
+}
+
+func (x *GetPostsByTagRequest) GetCommentsLimit() int64 {
+	if x != nil {
+		return x.CommentsLimit
+	}
+	return 0
+}
+
+func (x *GetPostsByTagRequest) GetCommentsFields() []UserFields {
+	if x != nil {
+		return x.CommentsFields
 	}
 	return nil
 }
 
-type WriteCommentResponse struct {
+func (x *GetPostsByTagRequest) GetCommentsSortDir() bool {
+	if x != nil {
+		return x.CommentsSortDir
+	}
+	return false
+}
+
+func (x *GetPostsByTagRequest) GetFields() []UserFields {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+type GetPostsByTagResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Comment *Comment `protobuf:"bytes,1,opt,name=comment,proto3" json:"comment,omitempty"`
+	Posts []*Post `protobuf:"bytes,1,rep,name=posts,proto3" json:"posts,omitempty"`
 }
 
-func (x *WriteCommentResponse) Reset() {
-	*x = WriteCommentResponse{}
+func (x *GetPostsByTagResponse) Reset() {
+	*x = GetPostsByTagResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_posts_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -578,13 +746,13 @@ func (x *WriteCommentResponse) Reset() {
 	}
 }
 
-func (x *WriteCommentResponse) String() string {
+func (x *GetPostsByTagResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WriteCommentResponse) ProtoMessage() {}
+func (*GetPostsByTagResponse) ProtoMessage() {}
 
-func (x *WriteCommentResponse) ProtoReflect() protoreflect.Message {
+func (x *GetPostsByTagResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_posts_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -596,30 +764,43 @@ func (x *WriteCommentResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WriteCommentResponse.ProtoReflect.Descriptor instead.
-func (*WriteCommentResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetPostsByTagResponse.ProtoReflect.Descriptor instead.
+func (*GetPostsByTagResponse) Descriptor() ([]byte, []int) {
 	return file_posts_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *WriteCommentResponse) GetComment() *Comment {
+func (x *GetPostsByTagResponse) GetPosts() []*Post {
 	if x != nil {
-		return x.Comment
+		return x.Posts
 	}
 	return nil
 }
 
-type LikesInfo struct {
+type GetTagsFeedRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Лайкнут ли комментарий текущим пользователем.
-	Liked *bool  `protobuf:"varint,1,opt,name=liked,proto3,oneof" json:"liked,omitempty"`
-	Count *int64 `protobuf:"varint,2,opt,name=count,proto3,oneof" json:"count,omitempty"`
+	Limit  int64  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	LastId uint64 `protobuf:"varint,2,opt,name=last_id,json=lastId,proto3" json:"last_id,omitempty"`
+	// если true, вернется информация о пользователях и комментариях.
+	Extended bool `protobuf:"varint,3,opt,name=extended,proto3" json:"extended,omitempty"`
+	// если true, вернется информация о владельцах комментариев.
+	CommentsExtended bool `protobuf:"varint,4,opt,name=comments_extended,json=commentsExtended,proto3" json:"comments_extended,omitempty"`
+	// количество комментариев, которые необходимо вернуть.
+	CommentsLimit int64 `protobuf:"varint,5,opt,name=comments_limit,json=commentsLimit,proto3" json:"comments_limit,omitempty"`
+	// Список дополнительных полей владельцев комментариев, которые необходимо вернуть.
+	CommentsFields []UserFields `protobuf:"varint,6,rep,packed,name=comments_fields,json=commentsFields,proto3,enum=UserFields" json:"comments_fields,omitempty"`
+	// Направление сортировки комментариев. false - сначала новые, true - сначала старые.
+	CommentsSortDir bool `protobuf:"varint,7,opt,name=comments_sort_dir,json=commentsSortDir,proto3" json:"comments_sort_dir,omitempty"`
+	// Список дополнительных полей владельцев постов, которые необходимо вернуть.
+	Fields []UserFields `protobuf:"varint,8,rep,packed,name=fields,proto3,enum=UserFields" json:"fields,omitempty"`
+	// Если true, просмотренные посты не возвращаются.
+	HideSeen bool `protobuf:"varint,9,opt,name=hide_seen,json=hideSeen,proto3" json:"hide_seen,omitempty"`
 }
 
-func (x *LikesInfo) Reset() {
-	*x = LikesInfo{}
+func (x *GetTagsFeedRequest) Reset() {
+	*x = GetTagsFeedRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_posts_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -627,13 +808,13 @@ func (x *LikesInfo) Reset() {
 	}
 }
 
-func (x *LikesInfo) String() string {
+func (x *GetTagsFeedRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LikesInfo) ProtoMessage() {}
+func (*GetTagsFeedRequest) ProtoMessage() {}
 
-func (x *LikesInfo) ProtoReflect() protoreflect.Message {
+func (x *GetTagsFeedRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_posts_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -645,37 +826,84 @@ func (x *LikesInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LikesInfo.ProtoReflect.Descriptor instead.
-func (*LikesInfo) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetTagsFeedRequest.ProtoReflect.Descriptor instead.
+func (*GetTagsFeedRequest) Descriptor() ([]byte, []int) {
 	return file_posts_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *LikesInfo) GetLiked() bool {
-	if x != nil && x.Liked != nil {
-		return *x.Liked
+func (x *GetTagsFeedRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetTagsFeedRequest) GetLastId() uint64 {
+	if x != nil {
+		return x.LastId
+	}
+	return 0
+}
+
+func (x *GetTagsFeedRequest) GetExtended() bool {
+	if x != nil {
+		return x.Extended
 	}
 	return false
 }
 
-func (x *LikesInfo) GetCount() int64 {
-	if x != nil && x.Count != nil {
-		return *x.Count
+func (x *GetTagsFeedRequest) GetCommentsExtended() bool {
+	if x != nil {
+		return x.CommentsExtended
+	}
+	return false
+}
+
+func (x *GetTagsFeedRequest) GetCommentsLimit() int64 {
+	if x != nil {
+		return x.CommentsLimit
 	}
 	return 0
 }
 
-type CommentsInfo struct {
+func (x *GetTagsFeedRequest) GetCommentsFields() []UserFields {
+	if x != nil {
+		return x.CommentsFields
+	}
+	return nil
+}
+
+func (x *GetTagsFeedRequest) GetCommentsSortDir() bool {
+	if x != nil {
+		return x.CommentsSortDir
+	}
+	return false
+}
+
+func (x *GetTagsFeedRequest) GetFields() []UserFields {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *GetTagsFeedRequest) GetHideSeen() bool {
+	if x != nil {
+		return x.HideSeen
+	}
+	return false
+}
+
+type GetTagsFeedResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Count *int64 `protobuf:"varint,1,opt,name=count,proto3,oneof" json:"count,omitempty"`
-	// список комментариев.
-	Items []*Comment `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	Posts []*Post `protobuf:"bytes,1,rep,name=posts,proto3" json:"posts,omitempty"`
 }
 
-func (x *CommentsInfo) Reset() {
-	*x = CommentsInfo{}
+func (x *GetTagsFeedResponse) Reset() {
+	*x = GetTagsFeedResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_posts_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -683,13 +911,13 @@ func (x *CommentsInfo) Reset() {
 	}
 }
 
-func (x *CommentsInfo) String() string {
+func (x *GetTagsFeedResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CommentsInfo) ProtoMessage() {}
+func (*GetTagsFeedResponse) ProtoMessage() {}
 
-func (x *CommentsInfo) ProtoReflect() protoreflect.Message {
+func (x *GetTagsFeedResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_posts_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -701,42 +929,31 @@ func (x *CommentsInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CommentsInfo.ProtoReflect.Descriptor instead.
-func (*CommentsInfo) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetTagsFeedResponse.ProtoReflect.Descriptor instead.
+func (*GetTagsFeedResponse) Descriptor() ([]byte, []int) {
 	return file_posts_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *CommentsInfo) GetCount() int64 {
-	if x != nil && x.Count != nil {
-		return *x.Count
-	}
-	return 0
-}
-
-func (x *CommentsInfo) GetItems() []*Comment {
+func (x *GetTagsFeedResponse) GetPosts() []*Post {
 	if x != nil {
-		return x.Items
+		return x.Posts
 	}
 	return nil
 }
 
-type Post struct {
+type Audience struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id          uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Time        *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=time,proto3" json:"time,omitempty"`
-	OwnerId     int64                  `protobuf:"varint,3,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
-	Message     string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
-	Attachments []*Attachment          `protobuf:"bytes,5,rep,name=attachments,proto3" json:"attachments,omitempty"`
-	Likes       *LikesInfo             `protobuf:"bytes,6,opt,name=likes,proto3" json:"likes,omitempty"`
-	Comments    *CommentsInfo          `protobuf:"bytes,7,opt,name=comments,proto3" json:"comments,omitempty"`
-	Owner       *User                  `protobuf:"bytes,8,opt,name=owner,proto3" json:"owner,omitempty"`
+	Id           uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	OwnerId      int64  `protobuf:"varint,2,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	Name         string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	MembersCount int64  `protobuf:"varint,4,opt,name=members_count,json=membersCount,proto3" json:"members_count,omitempty"`
 }
 
-func (x *Post) Reset() {
-	*x = Post{}
+func (x *Audience) Reset() {
+	*x = Audience{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_posts_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -744,13 +961,13 @@ func (x *Post) Reset() {
 	}
 }
 
-func (x *Post) String() string {
+func (x *Audience) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Post) ProtoMessage() {}
+func (*Audience) ProtoMessage() {}
 
-func (x *Post) ProtoReflect() protoreflect.Message {
+func (x *Audience) ProtoReflect() protoreflect.Message {
 	mi := &file_posts_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -762,80 +979,51 @@ func (x *Post) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Post.ProtoReflect.Descriptor instead.
-func (*Post) Descriptor() ([]byte, []int) {
+// Deprecated: Use Audience.ProtoReflect.Descriptor instead.
+func (*Audience) Descriptor() ([]byte, []int) {
 	return file_posts_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *Post) GetId() uint64 {
+func (x *Audience) GetId() uint64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *Post) GetTime() *timestamppb.Timestamp {
-	if x != nil {
-		return x.Time
-	}
-	return nil
-}
-
-func (x *Post) GetOwnerId() int64 {
+func (x *Audience) GetOwnerId() int64 {
 	if x != nil {
 		return x.OwnerId
 	}
 	return 0
 }
 
-func (x *Post) GetMessage() string {
+func (x *Audience) GetName() string {
 	if x != nil {
-		return x.Message
+		return x.Name
 	}
 	return ""
 }
 
-func (x *Post) GetAttachments() []*Attachment {
+func (x *Audience) GetMembersCount() int64 {
 	if x != nil {
-		return x.Attachments
+		return x.MembersCount
 	}
-	return nil
+	return 0
 }
 
-func (x *Post) GetLikes() *LikesInfo {
-	if x != nil {
-		return x.Likes
-	}
-	return nil
-}
-
-func (x *Post) GetComments() *CommentsInfo {
-	if x != nil {
-		return x.Comments
-	}
-	return nil
-}
-
-func (x *Post) GetOwner() *User {
-	if x != nil {
-		return x.Owner
-	}
-	return nil
-}
-
-type NewPostRequest struct {
+type CreateAudienceRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AttachmentsIds []*AttachmentId `protobuf:"bytes,1,rep,name=attachmentsIds,proto3" json:"attachmentsIds,omitempty"`
-	Message        string          `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	// Список подключенных аккаунтов в которые нужно написать пост
-	LinkedaccIds []*LinkedAccountInp `protobuf:"bytes,3,rep,name=linkedacc_ids,json=linkedaccIds,proto3" json:"linkedacc_ids,omitempty"`
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Пользователи, которых нужно сразу добавить в список, не более 100.
+	UserIds []int64 `protobuf:"varint,2,rep,packed,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
 }
 
-func (x *NewPostRequest) Reset() {
-	*x = NewPostRequest{}
+func (x *CreateAudienceRequest) Reset() {
+	*x = CreateAudienceRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_posts_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -843,13 +1031,13 @@ func (x *NewPostRequest) Reset() {
 	}
 }
 
-func (x *NewPostRequest) String() string {
+func (x *CreateAudienceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NewPostRequest) ProtoMessage() {}
+func (*CreateAudienceRequest) ProtoMessage() {}
 
-func (x *NewPostRequest) ProtoReflect() protoreflect.Message {
+func (x *CreateAudienceRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_posts_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -861,42 +1049,35 @@ func (x *NewPostRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NewPostRequest.ProtoReflect.Descriptor instead.
-func (*NewPostRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateAudienceRequest.ProtoReflect.Descriptor instead.
+func (*CreateAudienceRequest) Descriptor() ([]byte, []int) {
 	return file_posts_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *NewPostRequest) GetAttachmentsIds() []*AttachmentId {
-	if x != nil {
-		return x.AttachmentsIds
-	}
-	return nil
-}
-
-func (x *NewPostRequest) GetMessage() string {
+func (x *CreateAudienceRequest) GetName() string {
 	if x != nil {
-		return x.Message
+		return x.Name
 	}
 	return ""
 }
 
-func (x *NewPostRequest) GetLinkedaccIds() []*LinkedAccountInp {
+func (x *CreateAudienceRequest) GetUserIds() []int64 {
 	if x != nil {
-		return x.LinkedaccIds
+		return x.UserIds
 	}
 	return nil
 }
 
-type NewPostResponse struct {
+type CreateAudienceResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Post *Post `protobuf:"bytes,1,opt,name=Post,proto3" json:"Post,omitempty"`
+	Audience *Audience `protobuf:"bytes,1,opt,name=audience,proto3" json:"audience,omitempty"`
 }
 
-func (x *NewPostResponse) Reset() {
-	*x = NewPostResponse{}
+func (x *CreateAudienceResponse) Reset() {
+	*x = CreateAudienceResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_posts_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -904,13 +1085,13 @@ func (x *NewPostResponse) Reset() {
 	}
 }
 
-func (x *NewPostResponse) String() string {
+func (x *CreateAudienceResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NewPostResponse) ProtoMessage() {}
+func (*CreateAudienceResponse) ProtoMessage() {}
 
-func (x *NewPostResponse) ProtoReflect() protoreflect.Message {
+func (x *CreateAudienceResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_posts_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -922,41 +1103,30 @@ func (x *NewPostResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NewPostResponse.ProtoReflect.Descriptor instead.
-func (*NewPostResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateAudienceResponse.ProtoReflect.Descriptor instead.
+func (*CreateAudienceResponse) Descriptor() ([]byte, []int) {
 	return file_posts_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *NewPostResponse) GetPost() *Post {
+func (x *CreateAudienceResponse) GetAudience() *Audience {
 	if x != nil {
-		return x.Post
+		return x.Audience
 	}
 	return nil
 }
 
-type GetPostsListRequest struct {
+type AddToAudienceRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Limit  int64  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
-	LastId uint64 `protobuf:"varint,2,opt,name=last_id,json=lastId,proto3" json:"last_id,omitempty"`
-	// если true, вернется информация о пользователях и комментариях.
-	Extended bool `protobuf:"varint,3,opt,name=extended,proto3" json:"extended,omitempty"`
-	// если true, вернется информация о владельцах комментариев.
-	CommentsExtended bool `protobuf:"varint,4,opt,name=comments_extended,json=commentsExtended,proto3" json:"comments_extended,omitempty"`
-	// количество комментариев, которые необходимо вернуть.
-	CommentsLimit int64 `protobuf:"varint,5,opt,name=comments_limit,json=commentsLimit,proto3" json:"comments_limit,omitempty"`
-	// Список дополнительных полей владельцев комментариев, которые необходимо вернуть.
-	CommentsFields []UserFields `protobuf:"varint,6,rep,packed,name=comments_fields,json=commentsFields,proto3,enum=UserFields" json:"comments_fields,omitempty"`
-	// Направление сортировки комментариев. false - сначала новые, true - сначала старые.
-	CommentsSortDir bool `protobuf:"varint,7,opt,name=comments_sort_dir,json=commentsSortDir,proto3" json:"comments_sort_dir,omitempty"`
-	// Список дополнительных полей владельцев постов, которые необходимо вернуть.
-	Fields []UserFields `protobuf:"varint,8,rep,packed,name=fields,proto3,enum=UserFields" json:"fields,omitempty"`
+	AudienceId uint64 `protobuf:"varint,1,opt,name=audience_id,json=audienceId,proto3" json:"audience_id,omitempty"`
+	// Не более 100 пользователей за запрос.
+	UserIds []int64 `protobuf:"varint,2,rep,packed,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
 }
 
-func (x *GetPostsListRequest) Reset() {
-	*x = GetPostsListRequest{}
+func (x *AddToAudienceRequest) Reset() {
+	*x = AddToAudienceRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_posts_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -964,13 +1134,13 @@ func (x *GetPostsListRequest) Reset() {
 	}
 }
 
-func (x *GetPostsListRequest) String() string {
+func (x *AddToAudienceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPostsListRequest) ProtoMessage() {}
+func (*AddToAudienceRequest) ProtoMessage() {}
 
-func (x *GetPostsListRequest) ProtoReflect() protoreflect.Message {
+func (x *AddToAudienceRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_posts_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -982,77 +1152,33 @@ func (x *GetPostsListRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPostsListRequest.ProtoReflect.Descriptor instead.
-func (*GetPostsListRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use AddToAudienceRequest.ProtoReflect.Descriptor instead.
+func (*AddToAudienceRequest) Descriptor() ([]byte, []int) {
 	return file_posts_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *GetPostsListRequest) GetLimit() int64 {
-	if x != nil {
-		return x.Limit
-	}
-	return 0
-}
-
-func (x *GetPostsListRequest) GetLastId() uint64 {
-	if x != nil {
-		return x.LastId
-	}
-	return 0
-}
-
-func (x *GetPostsListRequest) GetExtended() bool {
-	if x != nil {
-		return x.Extended
-	}
-	return false
-}
-
-func (x *GetPostsListRequest) GetCommentsExtended() bool {
-	if x != nil {
-		return x.CommentsExtended
-	}
-	return false
-}
-
-func (x *GetPostsListRequest) GetCommentsLimit() int64 {
+func (x *AddToAudienceRequest) GetAudienceId() uint64 {
 	if x != nil {
-		return x.CommentsLimit
+		return x.AudienceId
 	}
 	return 0
 }
 
-func (x *GetPostsListRequest) GetCommentsFields() []UserFields {
-	if x != nil {
-		return x.CommentsFields
-	}
-	return nil
-}
-
-func (x *GetPostsListRequest) GetCommentsSortDir() bool {
-	if x != nil {
-		return x.CommentsSortDir
-	}
-	return false
-}
-
-func (x *GetPostsListRequest) GetFields() []UserFields {
+func (x *AddToAudienceRequest) GetUserIds() []int64 {
 	if x != nil {
-		return x.Fields
+		return x.UserIds
 	}
 	return nil
 }
 
-type GetPostsListResponse struct {
+type AddToAudienceResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Posts []*Post `protobuf:"bytes,1,rep,name=posts,proto3" json:"posts,omitempty"`
 }
 
-func (x *GetPostsListResponse) Reset() {
-	*x = GetPostsListResponse{}
+func (x *AddToAudienceResponse) Reset() {
+	*x = AddToAudienceResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_posts_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1060,13 +1186,13 @@ func (x *GetPostsListResponse) Reset() {
 	}
 }
 
-func (x *GetPostsListResponse) String() string {
+func (x *AddToAudienceResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPostsListResponse) ProtoMessage() {}
+func (*AddToAudienceResponse) ProtoMessage() {}
 
-func (x *GetPostsListResponse) ProtoReflect() protoreflect.Message {
+func (x *AddToAudienceResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_posts_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1078,42 +1204,19 @@ func (x *GetPostsListResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPostsListResponse.ProtoReflect.Descriptor instead.
-func (*GetPostsListResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use AddToAudienceResponse.ProtoReflect.Descriptor instead.
+func (*AddToAudienceResponse) Descriptor() ([]byte, []int) {
 	return file_posts_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *GetPostsListResponse) GetPosts() []*Post {
-	if x != nil {
-		return x.Posts
-	}
-	return nil
-}
-
-type GetPostsUserRequest struct {
+type ListAudiencesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Limit  int64  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
-	UserId int64  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	LastId uint64 `protobuf:"varint,3,opt,name=last_id,json=lastId,proto3" json:"last_id,omitempty"`
-	// если true, вернется информация о пользователях и комментариях.
-	Extended bool `protobuf:"varint,4,opt,name=extended,proto3" json:"extended,omitempty"`
-	// если true, вернется информация о владельцах комментариев.
-	CommentsExtended bool `protobuf:"varint,5,opt,name=comments_extended,json=commentsExtended,proto3" json:"comments_extended,omitempty"`
-	// количество комментариев, которые необходимо вернуть.
-	CommentsLimit int64 `protobuf:"varint,6,opt,name=comments_limit,json=commentsLimit,proto3" json:"comments_limit,omitempty"`
-	// Список дополнительных полей владельцев комментариев, которые необходимо вернуть.
-	CommentsFields []UserFields `protobuf:"varint,7,rep,packed,name=comments_fields,json=commentsFields,proto3,enum=UserFields" json:"comments_fields,omitempty"`
-	// Направление сортировки комментариев. false - сначала новые, true - сначала старые.
-	CommentsSortDir bool `protobuf:"varint,8,opt,name=comments_sort_dir,json=commentsSortDir,proto3" json:"comments_sort_dir,omitempty"`
-	// Список дополнительных полей владельцев постов, которые необходимо вернуть.
-	Fields []UserFields `protobuf:"varint,9,rep,packed,name=fields,proto3,enum=UserFields" json:"fields,omitempty"`
 }
 
-func (x *GetPostsUserRequest) Reset() {
-	*x = GetPostsUserRequest{}
+func (x *ListAudiencesRequest) Reset() {
+	*x = ListAudiencesRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_posts_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1121,13 +1224,13 @@ func (x *GetPostsUserRequest) Reset() {
 	}
 }
 
-func (x *GetPostsUserRequest) String() string {
+func (x *ListAudiencesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPostsUserRequest) ProtoMessage() {}
+func (*ListAudiencesRequest) ProtoMessage() {}
 
-func (x *GetPostsUserRequest) ProtoReflect() protoreflect.Message {
+func (x *ListAudiencesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_posts_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1139,84 +1242,21 @@ func (x *GetPostsUserRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPostsUserRequest.ProtoReflect.Descriptor instead.
-func (*GetPostsUserRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListAudiencesRequest.ProtoReflect.Descriptor instead.
+func (*ListAudiencesRequest) Descriptor() ([]byte, []int) {
 	return file_posts_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *GetPostsUserRequest) GetLimit() int64 {
-	if x != nil {
-		return x.Limit
-	}
-	return 0
-}
-
-func (x *GetPostsUserRequest) GetUserId() int64 {
-	if x != nil {
-		return x.UserId
-	}
-	return 0
-}
-
-func (x *GetPostsUserRequest) GetLastId() uint64 {
-	if x != nil {
-		return x.LastId
-	}
-	return 0
-}
-
-func (x *GetPostsUserRequest) GetExtended() bool {
-	if x != nil {
-		return x.Extended
-	}
-	return false
-}
-
-func (x *GetPostsUserRequest) GetCommentsExtended() bool {
-	if x != nil {
-		return x.CommentsExtended
-	}
-	return false
-}
-
-func (x *GetPostsUserRequest) GetCommentsLimit() int64 {
-	if x != nil {
-		return x.CommentsLimit
-	}
-	return 0
-}
-
-func (x *GetPostsUserRequest) GetCommentsFields() []UserFields {
-	if x != nil {
-		return x.CommentsFields
-	}
-	return nil
-}
-
-func (x *GetPostsUserRequest) GetCommentsSortDir() bool {
-	if x != nil {
-		return x.CommentsSortDir
-	}
-	return false
-}
-
-func (x *GetPostsUserRequest) GetFields() []UserFields {
-	if x != nil {
-		return x.Fields
-	}
-	return nil
-}
-
-type GetPostsUserResponse struct {
+type ListAudiencesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Posts []*Post `protobuf:"bytes,1,rep,name=posts,proto3" json:"posts,omitempty"`
+	Audiences []*Audience `protobuf:"bytes,1,rep,name=audiences,proto3" json:"audiences,omitempty"`
 }
 
-func (x *GetPostsUserResponse) Reset() {
-	*x = GetPostsUserResponse{}
+func (x *ListAudiencesResponse) Reset() {
+	*x = ListAudiencesResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_posts_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1224,13 +1264,13 @@ func (x *GetPostsUserResponse) Reset() {
 	}
 }
 
-func (x *GetPostsUserResponse) String() string {
+func (x *ListAudiencesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPostsUserResponse) ProtoMessage() {}
+func (*ListAudiencesResponse) ProtoMessage() {}
 
-func (x *GetPostsUserResponse) ProtoReflect() protoreflect.Message {
+func (x *ListAudiencesResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_posts_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1242,28 +1282,35 @@ func (x *GetPostsUserResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPostsUserResponse.ProtoReflect.Descriptor instead.
-func (*GetPostsUserResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListAudiencesResponse.ProtoReflect.Descriptor instead.
+func (*ListAudiencesResponse) Descriptor() ([]byte, []int) {
 	return file_posts_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *GetPostsUserResponse) GetPosts() []*Post {
+func (x *ListAudiencesResponse) GetAudiences() []*Audience {
 	if x != nil {
-		return x.Posts
+		return x.Audiences
 	}
 	return nil
 }
 
-type AddLikeRequest struct {
+type Draft struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PostId uint64 `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	Id          uint64        `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	OwnerId     int64         `protobuf:"varint,2,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	Message     string        `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Attachments []*Attachment `protobuf:"bytes,4,rep,name=attachments,proto3" json:"attachments,omitempty"`
+	// Список подключенных аккаунтов, в которые нужно написать пост при публикации.
+	LinkedaccIds []*LinkedAccountInp `protobuf:"bytes,5,rep,name=linkedacc_ids,json=linkedaccIds,proto3" json:"linkedacc_ids,omitempty"`
+	// Время последнего сохранения.
+	Time *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=time,proto3" json:"time,omitempty"`
 }
 
-func (x *AddLikeRequest) Reset() {
-	*x = AddLikeRequest{}
+func (x *Draft) Reset() {
+	*x = Draft{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_posts_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1271,13 +1318,13 @@ func (x *AddLikeRequest) Reset() {
 	}
 }
 
-func (x *AddLikeRequest) String() string {
+func (x *Draft) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddLikeRequest) ProtoMessage() {}
+func (*Draft) ProtoMessage() {}
 
-func (x *AddLikeRequest) ProtoReflect() protoreflect.Message {
+func (x *Draft) ProtoReflect() protoreflect.Message {
 	mi := &file_posts_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1289,26 +1336,68 @@ func (x *AddLikeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddLikeRequest.ProtoReflect.Descriptor instead.
-func (*AddLikeRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use Draft.ProtoReflect.Descriptor instead.
+func (*Draft) Descriptor() ([]byte, []int) {
 	return file_posts_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *AddLikeRequest) GetPostId() uint64 {
+func (x *Draft) GetId() uint64 {
 	if x != nil {
-		return x.PostId
+		return x.Id
 	}
 	return 0
 }
 
-type AddLikeResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-}
-
-func (x *AddLikeResponse) Reset() {
-	*x = AddLikeResponse{}
+func (x *Draft) GetOwnerId() int64 {
+	if x != nil {
+		return x.OwnerId
+	}
+	return 0
+}
+
+func (x *Draft) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *Draft) GetAttachments() []*Attachment {
+	if x != nil {
+		return x.Attachments
+	}
+	return nil
+}
+
+func (x *Draft) GetLinkedaccIds() []*LinkedAccountInp {
+	if x != nil {
+		return x.LinkedaccIds
+	}
+	return nil
+}
+
+func (x *Draft) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+type SaveDraftRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id черновика. Если не задан, будет создан новый черновик.
+	Id             uint64          `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	AttachmentsIds []*AttachmentId `protobuf:"bytes,2,rep,name=attachmentsIds,proto3" json:"attachmentsIds,omitempty"`
+	Message        string          `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	// Список подключенных аккаунтов, в которые нужно написать пост при публикации.
+	LinkedaccIds []*LinkedAccountInp `protobuf:"bytes,4,rep,name=linkedacc_ids,json=linkedaccIds,proto3" json:"linkedacc_ids,omitempty"`
+}
+
+func (x *SaveDraftRequest) Reset() {
+	*x = SaveDraftRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_posts_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1316,449 +1405,6141 @@ func (x *AddLikeResponse) Reset() {
 	}
 }
 
-func (x *AddLikeResponse) String() string {
+func (x *SaveDraftRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddLikeResponse) ProtoMessage() {}
+func (*SaveDraftRequest) ProtoMessage() {}
 
-func (x *AddLikeResponse) ProtoReflect() protoreflect.Message {
+func (x *SaveDraftRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_posts_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use AddLikeResponse.ProtoReflect.Descriptor instead.
-func (*AddLikeResponse) Descriptor() ([]byte, []int) {
-	return file_posts_proto_rawDescGZIP(), []int{19}
-}
-
-type DeleteLikeRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	PostId uint64 `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
-}
-
-func (x *DeleteLikeRequest) Reset() {
-	*x = DeleteLikeRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_posts_proto_msgTypes[20]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *DeleteLikeRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*DeleteLikeRequest) ProtoMessage() {}
-
-func (x *DeleteLikeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_posts_proto_msgTypes[20]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveDraftRequest.ProtoReflect.Descriptor instead.
+func (*SaveDraftRequest) Descriptor() ([]byte, []int) {
+	return file_posts_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *SaveDraftRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SaveDraftRequest) GetAttachmentsIds() []*AttachmentId {
+	if x != nil {
+		return x.AttachmentsIds
+	}
+	return nil
+}
+
+func (x *SaveDraftRequest) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *SaveDraftRequest) GetLinkedaccIds() []*LinkedAccountInp {
+	if x != nil {
+		return x.LinkedaccIds
+	}
+	return nil
+}
+
+type SaveDraftResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Draft *Draft `protobuf:"bytes,1,opt,name=draft,proto3" json:"draft,omitempty"`
+}
+
+func (x *SaveDraftResponse) Reset() {
+	*x = SaveDraftResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_posts_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SaveDraftResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveDraftResponse) ProtoMessage() {}
+
+func (x *SaveDraftResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveDraftResponse.ProtoReflect.Descriptor instead.
+func (*SaveDraftResponse) Descriptor() ([]byte, []int) {
+	return file_posts_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *SaveDraftResponse) GetDraft() *Draft {
+	if x != nil {
+		return x.Draft
+	}
+	return nil
+}
+
+type ListDraftsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Limit  int64  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	LastId uint64 `protobuf:"varint,2,opt,name=last_id,json=lastId,proto3" json:"last_id,omitempty"`
+}
+
+func (x *ListDraftsRequest) Reset() {
+	*x = ListDraftsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_posts_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListDraftsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDraftsRequest) ProtoMessage() {}
+
+func (x *ListDraftsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDraftsRequest.ProtoReflect.Descriptor instead.
+func (*ListDraftsRequest) Descriptor() ([]byte, []int) {
+	return file_posts_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ListDraftsRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListDraftsRequest) GetLastId() uint64 {
+	if x != nil {
+		return x.LastId
+	}
+	return 0
+}
+
+type ListDraftsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Drafts []*Draft `protobuf:"bytes,1,rep,name=drafts,proto3" json:"drafts,omitempty"`
+}
+
+func (x *ListDraftsResponse) Reset() {
+	*x = ListDraftsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_posts_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListDraftsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDraftsResponse) ProtoMessage() {}
+
+func (x *ListDraftsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDraftsResponse.ProtoReflect.Descriptor instead.
+func (*ListDraftsResponse) Descriptor() ([]byte, []int) {
+	return file_posts_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ListDraftsResponse) GetDrafts() []*Draft {
+	if x != nil {
+		return x.Drafts
+	}
+	return nil
+}
+
+type GetDraftRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetDraftRequest) Reset() {
+	*x = GetDraftRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_posts_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDraftRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDraftRequest) ProtoMessage() {}
+
+func (x *GetDraftRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDraftRequest.ProtoReflect.Descriptor instead.
+func (*GetDraftRequest) Descriptor() ([]byte, []int) {
+	return file_posts_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetDraftRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetDraftResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Draft *Draft `protobuf:"bytes,1,opt,name=draft,proto3" json:"draft,omitempty"`
+}
+
+func (x *GetDraftResponse) Reset() {
+	*x = GetDraftResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_posts_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDraftResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDraftResponse) ProtoMessage() {}
+
+func (x *GetDraftResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDraftResponse.ProtoReflect.Descriptor instead.
+func (*GetDraftResponse) Descriptor() ([]byte, []int) {
+	return file_posts_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetDraftResponse) GetDraft() *Draft {
+	if x != nil {
+		return x.Draft
+	}
+	return nil
+}
+
+type DeleteDraftRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *DeleteDraftRequest) Reset() {
+	*x = DeleteDraftRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_posts_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteDraftRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteDraftRequest) ProtoMessage() {}
+
+func (x *DeleteDraftRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteDraftRequest.ProtoReflect.Descriptor instead.
+func (*DeleteDraftRequest) Descriptor() ([]byte, []int) {
+	return file_posts_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *DeleteDraftRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteDraftResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteDraftResponse) Reset() {
+	*x = DeleteDraftResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_posts_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteDraftResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteDraftResponse) ProtoMessage() {}
+
+func (x *DeleteDraftResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteDraftResponse.ProtoReflect.Descriptor instead.
+func (*DeleteDraftResponse) Descriptor() ([]byte, []int) {
+	return file_posts_proto_rawDescGZIP(), []int{26}
+}
+
+type PublishDraftRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *PublishDraftRequest) Reset() {
+	*x = PublishDraftRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_posts_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PublishDraftRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishDraftRequest) ProtoMessage() {}
+
+func (x *PublishDraftRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishDraftRequest.ProtoReflect.Descriptor instead.
+func (*PublishDraftRequest) Descriptor() ([]byte, []int) {
+	return file_posts_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *PublishDraftRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type PublishDraftResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Post *Post `protobuf:"bytes,1,opt,name=post,proto3" json:"post,omitempty"`
+}
+
+func (x *PublishDraftResponse) Reset() {
+	*x = PublishDraftResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_posts_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PublishDraftResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishDraftResponse) ProtoMessage() {}
+
+func (x *PublishDraftResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishDraftResponse.ProtoReflect.Descriptor instead.
+func (*PublishDraftResponse) Descriptor() ([]byte, []int) {
+	return file_posts_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *PublishDraftResponse) GetPost() *Post {
+	if x != nil {
+		return x.Post
+	}
+	return nil
+}
+
+type GetPostByIdRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Extended bool   `protobuf:"varint,3,opt,name=extended,proto3" json:"extended,omitempty"`
+	// если true, вернется информация о владельцах комментариев.
+	CommentsExtended bool `protobuf:"varint,4,opt,name=comments_extended,json=commentsExtended,proto3" json:"comments_extended,omitempty"`
+	// количество комментариев, которые необходимо вернуть.
+	CommentsLimit int64 `protobuf:"varint,5,opt,name=comments_limit,json=commentsLimit,proto3" json:"comments_limit,omitempty"`
+	// Список дополнительных полей владельцев комментариев, которые необходимо вернуть.
+	CommentsFields []UserFields `protobuf:"varint,6,rep,packed,name=comments_fields,json=commentsFields,proto3,enum=UserFields" json:"comments_fields,omitempty"`
+	// Направление сортировки комментариев. false - сначала новые, true - сначала старые.
+	CommentsSortDir bool `protobuf:"varint,7,opt,name=comments_sort_dir,json=commentsSortDir,proto3" json:"comments_sort_dir,omitempty"`
+	// Список дополнительных полей владельцев постов, которые необходимо вернуть.
+	Fields []UserFields `protobuf:"varint,8,rep,packed,name=fields,proto3,enum=UserFields" json:"fields,omitempty"`
+}
+
+func (x *GetPostByIdRequest) Reset() {
+	*x = GetPostByIdRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_posts_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPostByIdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPostByIdRequest) ProtoMessage() {}
+
+func (x *GetPostByIdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPostByIdRequest.ProtoReflect.Descriptor instead.
+func (*GetPostByIdRequest) Descriptor() ([]byte, []int) {
+	return file_posts_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetPostByIdRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GetPostByIdRequest) GetExtended() bool {
+	if x != nil {
+		return x.Extended
+	}
+	return false
+}
+
+func (x *GetPostByIdRequest) GetCommentsExtended() bool {
+	if x != nil {
+		return x.CommentsExtended
+	}
+	return false
+}
+
+func (x *GetPostByIdRequest) GetCommentsLimit() int64 {
+	if x != nil {
+		return x.CommentsLimit
+	}
+	return 0
+}
+
+func (x *GetPostByIdRequest) GetCommentsFields() []UserFields {
+	if x != nil {
+		return x.CommentsFields
+	}
+	return nil
+}
+
+func (x *GetPostByIdRequest) GetCommentsSortDir() bool {
+	if x != nil {
+		return x.CommentsSortDir
+	}
+	return false
+}
+
+func (x *GetPostByIdRequest) GetFields() []UserFields {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+type GetPostByIdResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Post *Post `protobuf:"bytes,1,opt,name=post,proto3" json:"post,omitempty"`
+}
+
+func (x *GetPostByIdResponse) Reset() {
+	*x = GetPostByIdResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_posts_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPostByIdResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPostByIdResponse) ProtoMessage() {}
+
+func (x *GetPostByIdResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPostByIdResponse.ProtoReflect.Descriptor instead.
+func (*GetPostByIdResponse) Descriptor() ([]byte, []int) {
+	return file_posts_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetPostByIdResponse) GetPost() *Post {
+	if x != nil {
+		return x.Post
+	}
+	return nil
+}
+
+type UpdatePostRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PostId uint64 `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	// Новое сообщение. Если не задано, сообщение не изменяется.
+	Message *string `protobuf:"bytes,2,opt,name=message,proto3,oneof" json:"message,omitempty"`
+	// Новые вложения. Если не заданы, вложения не изменяются.
+	Attachments *AttachmentIds `protobuf:"bytes,3,opt,name=attachments,proto3,oneof" json:"attachments,omitempty"`
+}
+
+func (x *UpdatePostRequest) Reset() {
+	*x = UpdatePostRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_posts_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdatePostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePostRequest) ProtoMessage() {}
+
+func (x *UpdatePostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePostRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePostRequest) Descriptor() ([]byte, []int) {
+	return file_posts_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *UpdatePostRequest) GetPostId() uint64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *UpdatePostRequest) GetMessage() string {
+	if x != nil && x.Message != nil {
+		return *x.Message
+	}
+	return ""
+}
+
+func (x *UpdatePostRequest) GetAttachments() *AttachmentIds {
+	if x != nil {
+		return x.Attachments
+	}
+	return nil
+}
+
+type AttachmentIds struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ids []*AttachmentId `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+}
+
+func (x *AttachmentIds) Reset() {
+	*x = AttachmentIds{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_posts_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AttachmentIds) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttachmentIds) ProtoMessage() {}
+
+func (x *AttachmentIds) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttachmentIds.ProtoReflect.Descriptor instead.
+func (*AttachmentIds) Descriptor() ([]byte, []int) {
+	return file_posts_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *AttachmentIds) GetIds() []*AttachmentId {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+type UpdatePostResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Post *Post `protobuf:"bytes,1,opt,name=post,proto3" json:"post,omitempty"`
+}
+
+func (x *UpdatePostResponse) Reset() {
+	*x = UpdatePostResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_posts_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdatePostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePostResponse) ProtoMessage() {}
+
+func (x *UpdatePostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePostResponse.ProtoReflect.Descriptor instead.
+func (*UpdatePostResponse) Descriptor() ([]byte, []int) {
+	return file_posts_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *UpdatePostResponse) GetPost() *Post {
+	if x != nil {
+		return x.Post
+	}
+	return nil
+}
+
+type GetCommentsListRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PostId uint64 `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	Limit  int64  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	LastId uint64 `protobuf:"varint,3,opt,name=last_id,json=lastId,proto3" json:"last_id,omitempty"`
+	// Если true, вернет информацию о вледельцах (пользователях).
+	Extended bool `protobuf:"varint,4,opt,name=extended,proto3" json:"extended,omitempty"`
+	// Направление сортировки. false - сначала новые. true - сначала старые.
+	SortDir bool `protobuf:"varint,5,opt,name=sort_dir,json=sortDir,proto3" json:"sort_dir,omitempty"`
+	// Список полей владельцев (пользователей), которые нужно вернуть.
+	Fields []UserFields `protobuf:"varint,6,rep,packed,name=fields,proto3,enum=UserFields" json:"fields,omitempty"`
+}
+
+func (x *GetCommentsListRequest) Reset() {
+	*x = GetCommentsListRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_posts_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCommentsListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCommentsListRequest) ProtoMessage() {}
+
+func (x *GetCommentsListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCommentsListRequest.ProtoReflect.Descriptor instead.
+func (*GetCommentsListRequest) Descriptor() ([]byte, []int) {
+	return file_posts_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GetCommentsListRequest) GetPostId() uint64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *GetCommentsListRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetCommentsListRequest) GetLastId() uint64 {
+	if x != nil {
+		return x.LastId
+	}
+	return 0
+}
+
+func (x *GetCommentsListRequest) GetExtended() bool {
+	if x != nil {
+		return x.Extended
+	}
+	return false
+}
+
+func (x *GetCommentsListRequest) GetSortDir() bool {
+	if x != nil {
+		return x.SortDir
+	}
+	return false
+}
+
+func (x *GetCommentsListRequest) GetFields() []UserFields {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+type GetCommentsListResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Comments []*Comment `protobuf:"bytes,1,rep,name=comments,proto3" json:"comments,omitempty"`
+}
+
+func (x *GetCommentsListResponse) Reset() {
+	*x = GetCommentsListResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_posts_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCommentsListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCommentsListResponse) ProtoMessage() {}
+
+func (x *GetCommentsListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCommentsListResponse.ProtoReflect.Descriptor instead.
+func (*GetCommentsListResponse) Descriptor() ([]byte, []int) {
+	return file_posts_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GetCommentsListResponse) GetComments() []*Comment {
+	if x != nil {
+		return x.Comments
+	}
+	return nil
+}
+
+type Comment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	PostId      uint64                 `protobuf:"varint,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	OwnerId     int64                  `protobuf:"varint,3,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	Message     string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	Attachments []*Attachment          `protobuf:"bytes,5,rep,name=attachments,proto3" json:"attachments,omitempty"`
+	Time        *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=time,proto3" json:"time,omitempty"`
+	// Тот, кто оставил комментарий. Возвращается, если extended = true.
+	Owner *User `protobuf:"bytes,7,opt,name=owner,proto3" json:"owner,omitempty"`
+	// Упоминания пользователей в сообщении.
+	Mentions []*Mention `protobuf:"bytes,8,rep,name=mentions,proto3" json:"mentions,omitempty"`
+	// Форматирование сообщения.
+	Entities []*TextEntity `protobuf:"bytes,9,rep,name=entities,proto3" json:"entities,omitempty"`
+	// Автор ответа из федерации (ActivityPub), адрес актора. Для таких комментариев owner_id равен 0, owner не задан.
+	RemoteActor string `protobuf:"bytes,10,opt,name=remote_actor,json=remoteActor,proto3" json:"remote_actor,omitempty"`
+}
+
+func (x *Comment) Reset() {
+	*x = Comment{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_posts_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Comment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Comment) ProtoMessage() {}
+
+func (x *Comment) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Comment.ProtoReflect.Descriptor instead.
+func (*Comment) Descriptor() ([]byte, []int) {
+	return file_posts_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *Comment) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Comment) GetPostId() uint64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *Comment) GetOwnerId() int64 {
+	if x != nil {
+		return x.OwnerId
+	}
+	return 0
+}
+
+func (x *Comment) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *Comment) GetAttachments() []*Attachment {
+	if x != nil {
+		return x.Attachments
+	}
+	return nil
+}
+
+func (x *Comment) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *Comment) GetOwner() *User {
+	if x != nil {
+		return x.Owner
+	}
+	return nil
+}
+
+func (x *Comment) GetMentions() []*Mention {
+	if x != nil {
+		return x.Mentions
+	}
+	return nil
+}
+
+func (x *Comment) GetEntities() []*TextEntity {
+	if x != nil {
+		return x.Entities
+	}
+	return nil
+}
+
+func (x *Comment) GetRemoteActor() string {
+	if x != nil {
+		return x.RemoteActor
+	}
+	return ""
+}
+
+// Форматирование участка сообщения. offset и length задаются в символах (unicode code points).
+//
+// При записи message разбирается как подмножество Markdown: **жирный**, *курсив*, ~~зачеркнутый~~, `код` и [текст](https://ссылка).
+// Разметка удаляется из message и возвращается в виде списка TextEntity. Символы разметки можно экранировать с помощью \.
+type TextEntity struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type   TextEntityType `protobuf:"varint,1,opt,name=type,proto3,enum=TextEntityType" json:"type,omitempty"`
+	Offset int32          `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Length int32          `protobuf:"varint,3,opt,name=length,proto3" json:"length,omitempty"`
+	// Адрес ссылки для type = link.
+	Url string `protobuf:"bytes,4,opt,name=url,proto3" json:"url,omitempty"`
+}
+
+func (x *TextEntity) Reset() {
+	*x = TextEntity{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_posts_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TextEntity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TextEntity) ProtoMessage() {}
+
+func (x *TextEntity) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TextEntity.ProtoReflect.Descriptor instead.
+func (*TextEntity) Descriptor() ([]byte, []int) {
+	return file_posts_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *TextEntity) GetType() TextEntityType {
+	if x != nil {
+		return x.Type
+	}
+	return TextEntityType_bold
+}
+
+func (x *TextEntity) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *TextEntity) GetLength() int32 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+func (x *TextEntity) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+// Упоминание пользователя в сообщении в виде @id123. offset и length задаются в символах (unicode code points) и включают @.
+type Mention struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Offset int32 `protobuf:"varint,1,opt,name=offset,proto3" json:"offset,omitempty"`
+	Length int32 `protobuf:"varint,2,opt,name=length,proto3" json:"length,omitempty"`
+	UserId int64 `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *Mention) Reset() {
+	*x = Mention{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_posts_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Mention) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Mention) ProtoMessage() {}
+
+func (x *Mention) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Mention.ProtoReflect.Descriptor instead.
+func (*Mention) Descriptor() ([]byte, []int) {
+	return file_posts_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *Mention) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *Mention) GetLength() int32 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+func (x *Mention) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type WriteCommentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PostId uint64 `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	// Сообщение. Обязательно, если не задан attachments.
+	Messaage string `protobuf:"bytes,2,opt,name=messaage,proto3" json:"messaage,omitempty"`
+	// Вложения. Обязательно, если не задан messaage.
+	AttachmentsIds []*AttachmentId `protobuf:"bytes,3,rep,name=attachmentsIds,proto3" json:"attachmentsIds,omitempty"`
+}
+
+func (x *WriteCommentRequest) Reset() {
+	*x = WriteCommentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_posts_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WriteCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WriteCommentRequest) ProtoMessage() {}
+
+func (x *WriteCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WriteCommentRequest.ProtoReflect.Descriptor instead.
+func (*WriteCommentRequest) Descriptor() ([]byte, []int) {
+	return file_posts_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *WriteCommentRequest) GetPostId() uint64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *WriteCommentRequest) GetMessaage() string {
+	if x != nil {
+		return x.Messaage
+	}
+	return ""
+}
+
+func (x *WriteCommentRequest) GetAttachmentsIds() []*AttachmentId {
+	if x != nil {
+		return x.AttachmentsIds
+	}
+	return nil
+}
+
+type WriteCommentResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Comment *Comment `protobuf:"bytes,1,opt,name=comment,proto3" json:"comment,omitempty"`
+}
+
+func (x *WriteCommentResponse) Reset() {
+	*x = WriteCommentResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_posts_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WriteCommentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WriteCommentResponse) ProtoMessage() {}
+
+func (x *WriteCommentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WriteCommentResponse.ProtoReflect.Descriptor instead.
+func (*WriteCommentResponse) Descriptor() ([]byte, []int) {
+	return file_posts_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *WriteCommentResponse) GetComment() *Comment {
+	if x != nil {
+		return x.Comment
+	}
+	return nil
+}
+
+type LikesInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Лайкнут ли комментарий текущим пользователем. Не задано для гостя.
+	Liked *bool  `protobuf:"varint,1,opt,name=liked,proto3,oneof" json:"liked,omitempty"`
+	Count *int64 `protobuf:"varint,2,opt,name=count,proto3,oneof" json:"count,omitempty"`
+}
+
+func (x *LikesInfo) Reset() {
+	*x = LikesInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_posts_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LikesInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LikesInfo) ProtoMessage() {}
+
+func (x *LikesInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LikesInfo.ProtoReflect.Descriptor instead.
+func (*LikesInfo) Descriptor() ([]byte, []int) {
+	return file_posts_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *LikesInfo) GetLiked() bool {
+	if x != nil && x.Liked != nil {
+		return *x.Liked
+	}
+	return false
+}
+
+func (x *LikesInfo) GetCount() int64 {
+	if x != nil && x.Count != nil {
+		return *x.Count
+	}
+	return 0
+}
+
+type CommentsInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Count *int64 `protobuf:"varint,1,opt,name=count,proto3,oneof" json:"count,omitempty"`
+	// список комментариев.
+	Items []*Comment `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (x *CommentsInfo) Reset() {
+	*x = CommentsInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_posts_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommentsInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommentsInfo) ProtoMessage() {}
+
+func (x *CommentsInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommentsInfo.ProtoReflect.Descriptor instead.
+func (*CommentsInfo) Descriptor() ([]byte, []int) {
+	return file_posts_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *CommentsInfo) GetCount() int64 {
+	if x != nil && x.Count != nil {
+		return *x.Count
+	}
+	return 0
+}
+
+func (x *CommentsInfo) GetItems() []*Comment {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type Post struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Time        *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=time,proto3" json:"time,omitempty"`
+	OwnerId     int64                  `protobuf:"varint,3,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	Message     string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	Attachments []*Attachment          `protobuf:"bytes,5,rep,name=attachments,proto3" json:"attachments,omitempty"`
+	Likes       *LikesInfo             `protobuf:"bytes,6,opt,name=likes,proto3" json:"likes,omitempty"`
+	Comments    *CommentsInfo          `protobuf:"bytes,7,opt,name=comments,proto3" json:"comments,omitempty"`
+	Owner       *User                  `protobuf:"bytes,8,opt,name=owner,proto3" json:"owner,omitempty"`
+	Visibility  Visibility             `protobuf:"varint,9,opt,name=visibility,proto3,enum=Visibility" json:"visibility,omitempty"`
+	// Список пользователей, которым виден пост. Возвращается только владельцу.
+	AllowedIds []int64 `protobuf:"varint,10,rep,packed,name=allowed_ids,json=allowedIds,proto3" json:"allowed_ids,omitempty"`
+	// Список, участникам которого виден пост. Возвращается только владельцу.
+	AudienceId uint64 `protobuf:"varint,11,opt,name=audience_id,json=audienceId,proto3" json:"audience_id,omitempty"`
+	// Хештеги из сообщения.
+	Tags []*Hashtag `protobuf:"bytes,12,rep,name=tags,proto3" json:"tags,omitempty"`
+	// Упоминания пользователей в сообщении.
+	Mentions []*Mention `protobuf:"bytes,13,rep,name=mentions,proto3" json:"mentions,omitempty"`
+	// Форматирование сообщения.
+	Entities []*TextEntity `protobuf:"bytes,14,rep,name=entities,proto3" json:"entities,omitempty"`
+	// Превью ссылок из сообщения. Заполняются асинхронно после создания или изменения поста.
+	Previews []*LinkPreview `protobuf:"bytes,15,rep,name=previews,proto3" json:"previews,omitempty"`
+	// Опрос. Не задан, если в посте нет опроса.
+	Poll *Poll `protobuf:"bytes,16,opt,name=poll,proto3" json:"poll,omitempty"`
+	// Просмотры. Возвращаются только владельцу.
+	Views *ViewsInfo `protobuf:"bytes,17,opt,name=views,proto3" json:"views,omitempty"`
+}
+
+func (x *Post) Reset() {
+	*x = Post{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_posts_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Post) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Post) ProtoMessage() {}
+
+func (x *Post) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Post.ProtoReflect.Descriptor instead.
+func (*Post) Descriptor() ([]byte, []int) {
+	return file_posts_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *Post) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Post) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *Post) GetOwnerId() int64 {
+	if x != nil {
+		return x.OwnerId
+	}
+	return 0
+}
+
+func (x *Post) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *Post) GetAttachments() []*Attachment {
+	if x != nil {
+		return x.Attachments
+	}
+	return nil
+}
+
+func (x *Post) GetLikes() *LikesInfo {
+	if x != nil {
+		return x.Likes
+	}
+	return nil
+}
+
+func (x *Post) GetComments() *CommentsInfo {
+	if x != nil {
+		return x.Comments
+	}
+	return nil
+}
+
+func (x *Post) GetOwner() *User {
+	if x != nil {
+		return x.Owner
+	}
+	return nil
+}
+
+func (x *Post) GetVisibility() Visibility {
+	if x != nil {
+		return x.Visibility
+	}
+	return Visibility_public
+}
+
+func (x *Post) GetAllowedIds() []int64 {
+	if x != nil {
+		return x.AllowedIds
+	}
+	return nil
+}
+
+func (x *Post) GetAudienceId() uint64 {
+	if x != nil {
+		return x.AudienceId
+	}
+	return 0
+}
+
+func (x *Post) GetTags() []*Hashtag {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Post) GetMentions() []*Mention {
+	if x != nil {
+		return x.Mentions
+	}
+	return nil
+}
+
+func (x *Post) GetEntities() []*TextEntity {
+	if x != nil {
+		return x.Entities
+	}
+	return nil
+}
+
+func (x *Post) GetPreviews() []*LinkPreview {
+	if x != nil {
+		return x.Previews
+	}
+	return nil
+}
+
+func (x *Post) GetPoll() *Poll {
+	if x != nil {
+		return x.Poll
+	}
+	return nil
+}
+
+func (x *Post) GetViews() *ViewsInfo {
+	if x != nil {
+		return x.Views
+	}
+	return nil
+}
+
+type PollOption struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Номер варианта, начиная с 0.
+	Id   int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Text string `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	// Количество голосов за вариант.
+	Votes int64 `protobuf:"varint,3,opt,name=votes,proto3" json:"votes,omitempty"`
+	// Голосовал ли за вариант текущий пользователь.
+	Voted bool `protobuf:"varint,4,opt,name=voted,proto3" json:"voted,omitempty"`
+}
+
+func (x *PollOption) Reset() {
+	*x = PollOption{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_posts_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PollOption) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PollOption) ProtoMessage() {}
+
+func (x *PollOption) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PollOption.ProtoReflect.Descriptor instead.
+func (*PollOption) Descriptor() ([]byte, []int) {
+	return file_posts_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *PollOption) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PollOption) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *PollOption) GetVotes() int64 {
+	if x != nil {
+		return x.Votes
+	}
+	return 0
+}
+
+func (x *PollOption) GetVoted() bool {
+	if x != nil {
+		return x.Voted
+	}
+	return false
+}
+
+type Poll struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Question string        `protobuf:"bytes,1,opt,name=question,proto3" json:"question,omitempty"`
+	Options  []*PollOption `protobuf:"bytes,2,rep,name=options,proto3" json:"options,omitempty"`
+	// Можно ли выбрать несколько вариантов.
+	Multiple bool `protobuf:"varint,3,opt,name=multiple,proto3" json:"multiple,omitempty"`
+	// Время закрытия опроса. Не задано, если опрос бессрочный.
+	CloseTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=close_time,json=closeTime,proto3" json:"close_time,omitempty"`
+	Closed    bool                   `protobuf:"varint,5,opt,name=closed,proto3" json:"closed,omitempty"`
+	// Количество проголосовавших пользователей.
+	Voters int64 `protobuf:"varint,6,opt,name=voters,proto3" json:"voters,omitempty"`
+}
+
+func (x *Poll) Reset() {
+	*x = Poll{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_posts_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Poll) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Poll) ProtoMessage() {}
+
+func (x *Poll) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Poll.ProtoReflect.Descriptor instead.
+func (*Poll) Descriptor() ([]byte, []int) {
+	return file_posts_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *Poll) GetQuestion() string {
+	if x != nil {
+		return x.Question
+	}
+	return ""
+}
+
+func (x *Poll) GetOptions() []*PollOption {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *Poll) GetMultiple() bool {
+	if x != nil {
+		return x.Multiple
+	}
+	return false
+}
+
+func (x *Poll) GetCloseTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CloseTime
+	}
+	return nil
+}
+
+func (x *Poll) GetClosed() bool {
+	if x != nil {
+		return x.Closed
+	}
+	return false
+}
+
+func (x *Poll) GetVoters() int64 {
+	if x != nil {
+		return x.Voters
+	}
+	return 0
+}
+
+type NewPoll struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Question string `protobuf:"bytes,1,opt,name=question,proto3" json:"question,omitempty"`
+	// Варианты ответа. От 2 до 10.
+	Options []string `protobuf:"bytes,2,rep,name=options,proto3" json:"options,omitempty"`
+	// Можно ли выбрать несколько вариантов.
+	Multiple bool `protobuf:"varint,3,opt,name=multiple,proto3" json:"multiple,omitempty"`
+	// Время закрытия опроса. Если не задано, опрос бессрочный.
+	CloseTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=close_time,json=closeTime,proto3" json:"close_time,omitempty"`
+}
+
+func (x *NewPoll) Reset() {
+	*x = NewPoll{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_posts_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NewPoll) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NewPoll) ProtoMessage() {}
+
+func (x *NewPoll) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NewPoll.ProtoReflect.Descriptor instead.
+func (*NewPoll) Descriptor() ([]byte, []int) {
+	return file_posts_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *NewPoll) GetQuestion() string {
+	if x != nil {
+		return x.Question
+	}
+	return ""
+}
+
+func (x *NewPoll) GetOptions() []string {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *NewPoll) GetMultiple() bool {
+	if x != nil {
+		return x.Multiple
+	}
+	return false
+}
+
+func (x *NewPoll) GetCloseTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CloseTime
+	}
+	return nil
+}
+
+type LinkPreview struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Url         string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Title       string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// Адрес картинки. Может быть пустым.
+	Image string `protobuf:"bytes,4,opt,name=image,proto3" json:"image,omitempty"`
+}
+
+func (x *LinkPreview) Reset() {
+	*x = LinkPreview{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_posts_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LinkPreview) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LinkPreview) ProtoMessage() {}
+
+func (x *LinkPreview) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LinkPreview.ProtoReflect.Descriptor instead.
+func (*LinkPreview) Descriptor() ([]byte, []int) {
+	return file_posts_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *LinkPreview) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *LinkPreview) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *LinkPreview) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *LinkPreview) GetImage() string {
+	if x != nil {
+		return x.Image
+	}
+	return ""
+}
+
+// Хештег в сообщении. offset и length задаются в символах (unicode code points) и включают #.
+type Hashtag struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Хештег без # в нижнем регистре.
+	Tag    string `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	Offset int32  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Length int32  `protobuf:"varint,3,opt,name=length,proto3" json:"length,omitempty"`
+}
+
+func (x *Hashtag) Reset() {
+	*x = Hashtag{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_posts_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Hashtag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Hashtag) ProtoMessage() {}
+
+func (x *Hashtag) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Hashtag.ProtoReflect.Descriptor instead.
+func (*Hashtag) Descriptor() ([]byte, []int) {
+	return file_posts_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *Hashtag) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *Hashtag) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *Hashtag) GetLength() int32 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+type NewPostRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AttachmentsIds []*AttachmentId `protobuf:"bytes,1,rep,name=attachmentsIds,proto3" json:"attachmentsIds,omitempty"`
+	Message        string          `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// Список подключенных аккаунтов в которые нужно написать пост
+	LinkedaccIds []*LinkedAccountInp `protobuf:"bytes,3,rep,name=linkedacc_ids,json=linkedaccIds,proto3" json:"linkedacc_ids,omitempty"`
+	// Кому виден пост. По умолчанию всем. Писать в подключенные аккаунты можно только публичные посты.
+	Visibility Visibility `protobuf:"varint,4,opt,name=visibility,proto3,enum=Visibility" json:"visibility,omitempty"`
+	// Список пользователей, которым виден пост. Обязателен, если visibility = custom.
+	AllowedIds []int64 `protobuf:"varint,5,rep,packed,name=allowed_ids,json=allowedIds,proto3" json:"allowed_ids,omitempty"`
+	// Список, участникам которого виден пост. Обязателен, если visibility = audience.
+	AudienceId uint64 `protobuf:"varint,6,opt,name=audience_id,json=audienceId,proto3" json:"audience_id,omitempty"`
+	// Опрос.
+	Poll *NewPoll `protobuf:"bytes,7,opt,name=poll,proto3" json:"poll,omitempty"`
+}
+
+func (x *NewPostRequest) Reset() {
+	*x = NewPostRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_posts_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NewPostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NewPostRequest) ProtoMessage() {}
+
+func (x *NewPostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NewPostRequest.ProtoReflect.Descriptor instead.
+func (*NewPostRequest) Descriptor() ([]byte, []int) {
+	return file_posts_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *NewPostRequest) GetAttachmentsIds() []*AttachmentId {
+	if x != nil {
+		return x.AttachmentsIds
+	}
+	return nil
+}
+
+func (x *NewPostRequest) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *NewPostRequest) GetLinkedaccIds() []*LinkedAccountInp {
+	if x != nil {
+		return x.LinkedaccIds
+	}
+	return nil
+}
+
+func (x *NewPostRequest) GetVisibility() Visibility {
+	if x != nil {
+		return x.Visibility
+	}
+	return Visibility_public
+}
+
+func (x *NewPostRequest) GetAllowedIds() []int64 {
+	if x != nil {
+		return x.AllowedIds
+	}
+	return nil
+}
+
+func (x *NewPostRequest) GetAudienceId() uint64 {
+	if x != nil {
+		return x.AudienceId
+	}
+	return 0
+}
+
+func (x *NewPostRequest) GetPoll() *NewPoll {
+	if x != nil {
+		return x.Poll
+	}
+	return nil
+}
+
+type NewPostResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Post *Post `protobuf:"bytes,1,opt,name=Post,proto3" json:"Post,omitempty"`
+}
+
+func (x *NewPostResponse) Reset() {
+	*x = NewPostResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_posts_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NewPostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NewPostResponse) ProtoMessage() {}
+
+func (x *NewPostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NewPostResponse.ProtoReflect.Descriptor instead.
+func (*NewPostResponse) Descriptor() ([]byte, []int) {
+	return file_posts_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *NewPostResponse) GetPost() *Post {
+	if x != nil {
+		return x.Post
+	}
+	return nil
+}
+
+type GetPostsListRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Limit  int64  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	LastId uint64 `protobuf:"varint,2,opt,name=last_id,json=lastId,proto3" json:"last_id,omitempty"`
+	// если true, вернется информация о пользователях и комментариях.
+	Extended bool `protobuf:"varint,3,opt,name=extended,proto3" json:"extended,omitempty"`
+	// если true, вернется информация о владельцах комментариев.
+	CommentsExtended bool `protobuf:"varint,4,opt,name=comments_extended,json=commentsExtended,proto3" json:"comments_extended,omitempty"`
+	// количество комментариев, которые необходимо вернуть.
+	CommentsLimit int64 `protobuf:"varint,5,opt,name=comments_limit,json=commentsLimit,proto3" json:"comments_limit,omitempty"`
+	// Список дополнительных полей владельцев комментариев, которые необходимо вернуть.
+	CommentsFields []UserFields `protobuf:"varint,6,rep,packed,name=comments_fields,json=commentsFields,proto3,enum=UserFields" json:"comments_fields,omitempty"`
+	// Направление сортировки комментариев. false - сначала новые, true - сначала старые.
+	CommentsSortDir bool `protobuf:"varint,7,opt,name=comments_sort_dir,json=commentsSortDir,proto3" json:"comments_sort_dir,omitempty"`
+	// Список дополнительных полей владельцев постов, которые необходимо вернуть.
+	Fields []UserFields `protobuf:"varint,8,rep,packed,name=fields,proto3,enum=UserFields" json:"fields,omitempty"`
+	// Если true, просмотренные посты не возвращаются.
+	HideSeen bool `protobuf:"varint,9,opt,name=hide_seen,json=hideSeen,proto3" json:"hide_seen,omitempty"`
+}
+
+func (x *GetPostsListRequest) Reset() {
+	*x = GetPostsListRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_posts_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPostsListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPostsListRequest) ProtoMessage() {}
+
+func (x *GetPostsListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPostsListRequest.ProtoReflect.Descriptor instead.
+func (*GetPostsListRequest) Descriptor() ([]byte, []int) {
+	return file_posts_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *GetPostsListRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetPostsListRequest) GetLastId() uint64 {
+	if x != nil {
+		return x.LastId
+	}
+	return 0
+}
+
+func (x *GetPostsListRequest) GetExtended() bool {
+	if x != nil {
+		return x.Extended
+	}
+	return false
+}
+
+func (x *GetPostsListRequest) GetCommentsExtended() bool {
+	if x != nil {
+		return x.CommentsExtended
+	}
+	return false
+}
+
+func (x *GetPostsListRequest) GetCommentsLimit() int64 {
+	if x != nil {
+		return x.CommentsLimit
+	}
+	return 0
+}
+
+func (x *GetPostsListRequest) GetCommentsFields() []UserFields {
+	if x != nil {
+		return x.CommentsFields
+	}
+	return nil
+}
+
+func (x *GetPostsListRequest) GetCommentsSortDir() bool {
+	if x != nil {
+		return x.CommentsSortDir
+	}
+	return false
+}
+
+func (x *GetPostsListRequest) GetFields() []UserFields {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *GetPostsListRequest) GetHideSeen() bool {
+	if x != nil {
+		return x.HideSeen
+	}
+	return false
+}
+
+type GetPostsListResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Posts []*Post `protobuf:"bytes,1,rep,name=posts,proto3" json:"posts,omitempty"`
+}
+
+func (x *GetPostsListResponse) Reset() {
+	*x = GetPostsListResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_posts_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPostsListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPostsListResponse) ProtoMessage() {}
+
+func (x *GetPostsListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPostsListResponse.ProtoReflect.Descriptor instead.
+func (*GetPostsListResponse) Descriptor() ([]byte, []int) {
+	return file_posts_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *GetPostsListResponse) GetPosts() []*Post {
+	if x != nil {
+		return x.Posts
+	}
+	return nil
+}
+
+type GetPostsUserRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Limit  int64  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	UserId int64  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	LastId uint64 `protobuf:"varint,3,opt,name=last_id,json=lastId,proto3" json:"last_id,omitempty"`
+	// если true, вернется информация о пользователях и комментариях.
+	Extended bool `protobuf:"varint,4,opt,name=extended,proto3" json:"extended,omitempty"`
+	// если true, вернется информация о владельцах комментариев.
+	CommentsExtended bool `protobuf:"varint,5,opt,name=comments_extended,json=commentsExtended,proto3" json:"comments_extended,omitempty"`
+	// количество комментариев, которые необходимо вернуть.
+	CommentsLimit int64 `protobuf:"varint,6,opt,name=comments_limit,json=commentsLimit,proto3" json:"comments_limit,omitempty"`
+	// Список дополнительных полей владельцев комментариев, которые необходимо вернуть.
+	CommentsFields []UserFields `protobuf:"varint,7,rep,packed,name=comments_fields,json=commentsFields,proto3,enum=UserFields" json:"comments_fields,omitempty"`
+	// Направление сортировки комментариев. false - сначала новые, true - сначала старые.
+	CommentsSortDir bool `protobuf:"varint,8,opt,name=comments_sort_dir,json=commentsSortDir,proto3" json:"comments_sort_dir,omitempty"`
+	// Список дополнительных полей владельцев постов, которые необходимо вернуть.
+	Fields []UserFields `protobuf:"varint,9,rep,packed,name=fields,proto3,enum=UserFields" json:"fields,omitempty"`
+}
+
+func (x *GetPostsUserRequest) Reset() {
+	*x = GetPostsUserRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_posts_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPostsUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPostsUserRequest) ProtoMessage() {}
+
+func (x *GetPostsUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPostsUserRequest.ProtoReflect.Descriptor instead.
+func (*GetPostsUserRequest) Descriptor() ([]byte, []int) {
+	return file_posts_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *GetPostsUserRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetPostsUserRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetPostsUserRequest) GetLastId() uint64 {
+	if x != nil {
+		return x.LastId
+	}
+	return 0
+}
+
+func (x *GetPostsUserRequest) GetExtended() bool {
+	if x != nil {
+		return x.Extended
+	}
+	return false
+}
+
+func (x *GetPostsUserRequest) GetCommentsExtended() bool {
+	if x != nil {
+		return x.CommentsExtended
+	}
+	return false
+}
+
+func (x *GetPostsUserRequest) GetCommentsLimit() int64 {
+	if x != nil {
+		return x.CommentsLimit
+	}
+	return 0
+}
+
+func (x *GetPostsUserRequest) GetCommentsFields() []UserFields {
+	if x != nil {
+		return x.CommentsFields
+	}
+	return nil
+}
+
+func (x *GetPostsUserRequest) GetCommentsSortDir() bool {
+	if x != nil {
+		return x.CommentsSortDir
+	}
+	return false
+}
+
+func (x *GetPostsUserRequest) GetFields() []UserFields {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+type GetPostsUserResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Posts []*Post `protobuf:"bytes,1,rep,name=posts,proto3" json:"posts,omitempty"`
+}
+
+func (x *GetPostsUserResponse) Reset() {
+	*x = GetPostsUserResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_posts_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPostsUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPostsUserResponse) ProtoMessage() {}
+
+func (x *GetPostsUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPostsUserResponse.ProtoReflect.Descriptor instead.
+func (*GetPostsUserResponse) Descriptor() ([]byte, []int) {
+	return file_posts_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *GetPostsUserResponse) GetPosts() []*Post {
+	if x != nil {
+		return x.Posts
+	}
+	return nil
+}
+
+type AddLikeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PostId uint64 `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+}
+
+func (x *AddLikeRequest) Reset() {
+	*x = AddLikeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_posts_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddLikeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddLikeRequest) ProtoMessage() {}
+
+func (x *AddLikeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddLikeRequest.ProtoReflect.Descriptor instead.
+func (*AddLikeRequest) Descriptor() ([]byte, []int) {
+	return file_posts_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *AddLikeRequest) GetPostId() uint64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+type AddLikeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *AddLikeResponse) Reset() {
+	*x = AddLikeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_posts_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddLikeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddLikeResponse) ProtoMessage() {}
+
+func (x *AddLikeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddLikeResponse.ProtoReflect.Descriptor instead.
+func (*AddLikeResponse) Descriptor() ([]byte, []int) {
+	return file_posts_proto_rawDescGZIP(), []int{56}
+}
+
+type DeleteLikeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PostId uint64 `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+}
+
+func (x *DeleteLikeRequest) Reset() {
+	*x = DeleteLikeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_posts_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteLikeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteLikeRequest) ProtoMessage() {}
+
+func (x *DeleteLikeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteLikeRequest.ProtoReflect.Descriptor instead.
+func (*DeleteLikeRequest) Descriptor() ([]byte, []int) {
+	return file_posts_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *DeleteLikeRequest) GetPostId() uint64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+type DeleteLikeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteLikeResponse) Reset() {
+	*x = DeleteLikeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_posts_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteLikeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteLikeResponse) ProtoMessage() {}
+
+func (x *DeleteLikeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteLikeResponse.ProtoReflect.Descriptor instead.
+func (*DeleteLikeResponse) Descriptor() ([]byte, []int) {
+	return file_posts_proto_rawDescGZIP(), []int{58}
+}
+
+type DeletePostRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PostId uint64 `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+}
+
+func (x *DeletePostRequest) Reset() {
+	*x = DeletePostRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_posts_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeletePostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePostRequest) ProtoMessage() {}
+
+func (x *DeletePostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePostRequest.ProtoReflect.Descriptor instead.
+func (*DeletePostRequest) Descriptor() ([]byte, []int) {
+	return file_posts_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *DeletePostRequest) GetPostId() uint64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+type DeletePostResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeletePostResponse) Reset() {
+	*x = DeletePostResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_posts_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeletePostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePostResponse) ProtoMessage() {}
+
+func (x *DeletePostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePostResponse.ProtoReflect.Descriptor instead.
+func (*DeletePostResponse) Descriptor() ([]byte, []int) {
+	return file_posts_proto_rawDescGZIP(), []int{60}
+}
+
+type SearchPostsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Слова для поиска. Регистр не учитывается.
+	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Limit int64  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Значение last_id из предыдущего ответа.
+	LastId uint64 `protobuf:"varint,3,opt,name=last_id,json=lastId,proto3" json:"last_id,omitempty"`
+	// Если true, ищет также в комментариях.
+	IncludeComments bool `protobuf:"varint,4,opt,name=include_comments,json=includeComments,proto3" json:"include_comments,omitempty"`
+	// Если задан, ищет только посты и комментарии этого пользователя.
+	OwnerId int64 `protobuf:"varint,5,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	// Если задан, ищет только посты и комментарии с этим хештегом, можно с #.
+	Tag string `protobuf:"bytes,6,opt,name=tag,proto3" json:"tag,omitempty"`
+	// Если заданы, ищет только посты и комментарии, написанные в этом промежутке.
+	From *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=from,proto3" json:"from,omitempty"`
+	To   *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=to,proto3" json:"to,omitempty"`
+	// если true, вернется информация о пользователях и комментариях.
+	Extended bool `protobuf:"varint,9,opt,name=extended,proto3" json:"extended,omitempty"`
+	// если true, вернется информация о владельцах комментариев.
+	CommentsExtended bool `protobuf:"varint,10,opt,name=comments_extended,json=commentsExtended,proto3" json:"comments_extended,omitempty"`
+	// количество комментариев, которые необходимо вернуть.
+	CommentsLimit int64 `protobuf:"varint,11,opt,name=comments_limit,json=commentsLimit,proto3" json:"comments_limit,omitempty"`
+	// Список дополнительных полей владельцев комментариев, которые необходимо вернуть.
+	CommentsFields []UserFields `protobuf:"varint,12,rep,packed,name=comments_fields,json=commentsFields,proto3,enum=UserFields" json:"comments_fields,omitempty"`
+	// Направление сортировки комментариев. false - сначала новые, true - сначала старые.
+	CommentsSortDir bool `protobuf:"varint,13,opt,name=comments_sort_dir,json=commentsSortDir,proto3" json:"comments_sort_dir,omitempty"`
+	// Список дополнительных полей владельцев постов, которые необходимо вернуть.
+	Fields []UserFields `protobuf:"varint,14,rep,packed,name=fields,proto3,enum=UserFields" json:"fields,omitempty"`
+}
+
+func (x *SearchPostsRequest) Reset() {
+	*x = SearchPostsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_posts_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchPostsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchPostsRequest) ProtoMessage() {}
+
+func (x *SearchPostsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchPostsRequest.ProtoReflect.Descriptor instead.
+func (*SearchPostsRequest) Descriptor() ([]byte, []int) {
+	return file_posts_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *SearchPostsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchPostsRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *SearchPostsRequest) GetLastId() uint64 {
+	if x != nil {
+		return x.LastId
+	}
+	return 0
+}
+
+func (x *SearchPostsRequest) GetIncludeComments() bool {
+	if x != nil {
+		return x.IncludeComments
+	}
+	return false
+}
+
+func (x *SearchPostsRequest) GetOwnerId() int64 {
+	if x != nil {
+		return x.OwnerId
+	}
+	return 0
+}
+
+func (x *SearchPostsRequest) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *SearchPostsRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *SearchPostsRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *SearchPostsRequest) GetExtended() bool {
+	if x != nil {
+		return x.Extended
+	}
+	return false
+}
+
+func (x *SearchPostsRequest) GetCommentsExtended() bool {
+	if x != nil {
+		return x.CommentsExtended
+	}
+	return false
+}
+
+func (x *SearchPostsRequest) GetCommentsLimit() int64 {
+	if x != nil {
+		return x.CommentsLimit
+	}
+	return 0
+}
+
+func (x *SearchPostsRequest) GetCommentsFields() []UserFields {
+	if x != nil {
+		return x.CommentsFields
+	}
+	return nil
+}
+
+func (x *SearchPostsRequest) GetCommentsSortDir() bool {
+	if x != nil {
+		return x.CommentsSortDir
+	}
+	return false
+}
+
+func (x *SearchPostsRequest) GetFields() []UserFields {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+type SearchPostsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Posts []*Post `protobuf:"bytes,1,rep,name=posts,proto3" json:"posts,omitempty"`
+	// Передается в last_id, чтобы получить следующую страницу. 0, если результатов больше нет.
+	// Страница может содержать меньше limit постов, даже если результаты еще есть.
+	LastId uint64 `protobuf:"varint,2,opt,name=last_id,json=lastId,proto3" json:"last_id,omitempty"`
+}
+
+func (x *SearchPostsResponse) Reset() {
+	*x = SearchPostsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_posts_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchPostsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchPostsResponse) ProtoMessage() {}
+
+func (x *SearchPostsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchPostsResponse.ProtoReflect.Descriptor instead.
+func (*SearchPostsResponse) Descriptor() ([]byte, []int) {
+	return file_posts_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *SearchPostsResponse) GetPosts() []*Post {
+	if x != nil {
+		return x.Posts
+	}
+	return nil
+}
+
+func (x *SearchPostsResponse) GetLastId() uint64 {
+	if x != nil {
+		return x.LastId
+	}
+	return 0
+}
+
+type GetTrendingPostsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Limit int64 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Позиция в рейтинге, с которой начинается страница. Значение next_offset из предыдущего ответа.
+	Offset int32 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	// Версия рейтинга из предыдущего ответа, чтобы рейтинг не изменился между страницами. 0 - текущая версия.
+	Version uint64 `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	// если true, вернется информация о пользователях и комментариях.
+	Extended bool `protobuf:"varint,4,opt,name=extended,proto3" json:"extended,omitempty"`
+	// если true, вернется информация о владельцах комментариев.
+	CommentsExtended bool `protobuf:"varint,5,opt,name=comments_extended,json=commentsExtended,proto3" json:"comments_extended,omitempty"`
+	// количество комментариев, которые необходимо вернуть.
+	CommentsLimit int64 `protobuf:"varint,6,opt,name=comments_limit,json=commentsLimit,proto3" json:"comments_limit,omitempty"`
+	// Список дополнительных полей владельцев комментариев, которые необходимо вернуть.
+	CommentsFields []UserFields `protobuf:"varint,7,rep,packed,name=comments_fields,json=commentsFields,proto3,enum=UserFields" json:"comments_fields,omitempty"`
+	// Направление сортировки комментариев. false - сначала новые, true - сначала старые.
+	CommentsSortDir bool `protobuf:"varint,8,opt,name=comments_sort_dir,json=commentsSortDir,proto3" json:"comments_sort_dir,omitempty"`
+	// Список дополнительных полей владельцев постов, которые необходимо вернуть.
+	Fields []UserFields `protobuf:"varint,9,rep,packed,name=fields,proto3,enum=UserFields" json:"fields,omitempty"`
+}
+
+func (x *GetTrendingPostsRequest) Reset() {
+	*x = GetTrendingPostsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_posts_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTrendingPostsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTrendingPostsRequest) ProtoMessage() {}
+
+func (x *GetTrendingPostsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTrendingPostsRequest.ProtoReflect.Descriptor instead.
+func (*GetTrendingPostsRequest) Descriptor() ([]byte, []int) {
+	return file_posts_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *GetTrendingPostsRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetTrendingPostsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *GetTrendingPostsRequest) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *GetTrendingPostsRequest) GetExtended() bool {
+	if x != nil {
+		return x.Extended
+	}
+	return false
+}
+
+func (x *GetTrendingPostsRequest) GetCommentsExtended() bool {
+	if x != nil {
+		return x.CommentsExtended
+	}
+	return false
+}
+
+func (x *GetTrendingPostsRequest) GetCommentsLimit() int64 {
+	if x != nil {
+		return x.CommentsLimit
+	}
+	return 0
+}
+
+func (x *GetTrendingPostsRequest) GetCommentsFields() []UserFields {
+	if x != nil {
+		return x.CommentsFields
+	}
+	return nil
+}
+
+func (x *GetTrendingPostsRequest) GetCommentsSortDir() bool {
+	if x != nil {
+		return x.CommentsSortDir
+	}
+	return false
+}
+
+func (x *GetTrendingPostsRequest) GetFields() []UserFields {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+type GetTrendingPostsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Posts   []*Post `protobuf:"bytes,1,rep,name=posts,proto3" json:"posts,omitempty"`
+	Version uint64  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	// Передается в offset, чтобы получить следующую страницу. 0, если постов больше нет.
+	NextOffset int32 `protobuf:"varint,3,opt,name=next_offset,json=nextOffset,proto3" json:"next_offset,omitempty"`
+}
+
+func (x *GetTrendingPostsResponse) Reset() {
+	*x = GetTrendingPostsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_posts_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTrendingPostsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTrendingPostsResponse) ProtoMessage() {}
+
+func (x *GetTrendingPostsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTrendingPostsResponse.ProtoReflect.Descriptor instead.
+func (*GetTrendingPostsResponse) Descriptor() ([]byte, []int) {
+	return file_posts_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *GetTrendingPostsResponse) GetPosts() []*Post {
+	if x != nil {
+		return x.Posts
+	}
+	return nil
+}
+
+func (x *GetTrendingPostsResponse) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *GetTrendingPostsResponse) GetNextOffset() int32 {
+	if x != nil {
+		return x.NextOffset
+	}
+	return 0
+}
+
+type GetRankedFeedRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Limit int64 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Позиция в ленте, с которой начинается страница. Значение next_offset из предыдущего ответа.
+	Offset int32 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	// если true, вернется информация о пользователях и комментариях.
+	Extended bool `protobuf:"varint,3,opt,name=extended,proto3" json:"extended,omitempty"`
+	// если true, вернется информация о владельцах комментариев.
+	CommentsExtended bool `protobuf:"varint,4,opt,name=comments_extended,json=commentsExtended,proto3" json:"comments_extended,omitempty"`
+	// количество комментариев, которые необходимо вернуть.
+	CommentsLimit int64 `protobuf:"varint,5,opt,name=comments_limit,json=commentsLimit,proto3" json:"comments_limit,omitempty"`
+	// Список дополнительных полей владельцев комментариев, которые необходимо вернуть.
+	CommentsFields []UserFields `protobuf:"varint,6,rep,packed,name=comments_fields,json=commentsFields,proto3,enum=UserFields" json:"comments_fields,omitempty"`
+	// Направление сортировки комментариев. false - сначала новые, true - сначала старые.
+	CommentsSortDir bool `protobuf:"varint,7,opt,name=comments_sort_dir,json=commentsSortDir,proto3" json:"comments_sort_dir,omitempty"`
+	// Список дополнительных полей владельцев постов, которые необходимо вернуть.
+	Fields []UserFields `protobuf:"varint,8,rep,packed,name=fields,proto3,enum=UserFields" json:"fields,omitempty"`
+	// Если true, просмотренные посты не возвращаются.
+	HideSeen bool `protobuf:"varint,9,opt,name=hide_seen,json=hideSeen,proto3" json:"hide_seen,omitempty"`
+	// Если true, просмотренные посты возвращаются после всех непросмотренных.
+	DeprioritizeSeen bool `protobuf:"varint,10,opt,name=deprioritize_seen,json=deprioritizeSeen,proto3" json:"deprioritize_seen,omitempty"`
+	// Версия ленты из предыдущего ответа. 0 - лента ранжируется заново, hide_seen и deprioritize_seen учитываются
+	// только при этом. Версия хранится 30 минут, после этого страницы версии пустые.
+	Version uint64 `protobuf:"varint,11,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (x *GetRankedFeedRequest) Reset() {
+	*x = GetRankedFeedRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_posts_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRankedFeedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRankedFeedRequest) ProtoMessage() {}
+
+func (x *GetRankedFeedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRankedFeedRequest.ProtoReflect.Descriptor instead.
+func (*GetRankedFeedRequest) Descriptor() ([]byte, []int) {
+	return file_posts_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *GetRankedFeedRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetRankedFeedRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *GetRankedFeedRequest) GetExtended() bool {
+	if x != nil {
+		return x.Extended
+	}
+	return false
+}
+
+func (x *GetRankedFeedRequest) GetCommentsExtended() bool {
+	if x != nil {
+		return x.CommentsExtended
+	}
+	return false
+}
+
+func (x *GetRankedFeedRequest) GetCommentsLimit() int64 {
+	if x != nil {
+		return x.CommentsLimit
+	}
+	return 0
+}
+
+func (x *GetRankedFeedRequest) GetCommentsFields() []UserFields {
+	if x != nil {
+		return x.CommentsFields
+	}
+	return nil
+}
+
+func (x *GetRankedFeedRequest) GetCommentsSortDir() bool {
+	if x != nil {
+		return x.CommentsSortDir
+	}
+	return false
+}
+
+func (x *GetRankedFeedRequest) GetFields() []UserFields {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *GetRankedFeedRequest) GetHideSeen() bool {
+	if x != nil {
+		return x.HideSeen
+	}
+	return false
+}
+
+func (x *GetRankedFeedRequest) GetDeprioritizeSeen() bool {
+	if x != nil {
+		return x.DeprioritizeSeen
+	}
+	return false
+}
+
+func (x *GetRankedFeedRequest) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type GetRankedFeedResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Posts []*Post `protobuf:"bytes,1,rep,name=posts,proto3" json:"posts,omitempty"`
+	// Передается в offset, чтобы получить следующую страницу. 0, если постов больше нет.
+	// Страница может содержать меньше limit постов, даже если посты еще есть.
+	NextOffset int32  `protobuf:"varint,2,opt,name=next_offset,json=nextOffset,proto3" json:"next_offset,omitempty"`
+	Version    uint64 `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (x *GetRankedFeedResponse) Reset() {
+	*x = GetRankedFeedResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_posts_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRankedFeedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRankedFeedResponse) ProtoMessage() {}
+
+func (x *GetRankedFeedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRankedFeedResponse.ProtoReflect.Descriptor instead.
+func (*GetRankedFeedResponse) Descriptor() ([]byte, []int) {
+	return file_posts_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *GetRankedFeedResponse) GetPosts() []*Post {
+	if x != nil {
+		return x.Posts
+	}
+	return nil
+}
+
+func (x *GetRankedFeedResponse) GetNextOffset() int32 {
+	if x != nil {
+		return x.NextOffset
+	}
+	return 0
+}
+
+func (x *GetRankedFeedResponse) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type MarkSeenRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Не больше 100 постов за запрос.
+	PostIds []uint64 `protobuf:"varint,1,rep,packed,name=post_ids,json=postIds,proto3" json:"post_ids,omitempty"`
+}
+
+func (x *MarkSeenRequest) Reset() {
+	*x = MarkSeenRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_posts_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MarkSeenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkSeenRequest) ProtoMessage() {}
+
+func (x *MarkSeenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkSeenRequest.ProtoReflect.Descriptor instead.
+func (*MarkSeenRequest) Descriptor() ([]byte, []int) {
+	return file_posts_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *MarkSeenRequest) GetPostIds() []uint64 {
+	if x != nil {
+		return x.PostIds
+	}
+	return nil
+}
+
+type MarkSeenResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MarkSeenResponse) Reset() {
+	*x = MarkSeenResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_posts_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MarkSeenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkSeenResponse) ProtoMessage() {}
+
+func (x *MarkSeenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkSeenResponse.ProtoReflect.Descriptor instead.
+func (*MarkSeenResponse) Descriptor() ([]byte, []int) {
+	return file_posts_proto_rawDescGZIP(), []int{68}
+}
+
+type GetNewPostsCountRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetNewPostsCountRequest) Reset() {
+	*x = GetNewPostsCountRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_posts_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetNewPostsCountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNewPostsCountRequest) ProtoMessage() {}
+
+func (x *GetNewPostsCountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNewPostsCountRequest.ProtoReflect.Descriptor instead.
+func (*GetNewPostsCountRequest) Descriptor() ([]byte, []int) {
+	return file_posts_proto_rawDescGZIP(), []int{69}
+}
+
+type GetNewPostsCountResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Не больше 100.
+	Count int64 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *GetNewPostsCountResponse) Reset() {
+	*x = GetNewPostsCountResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_posts_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetNewPostsCountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNewPostsCountResponse) ProtoMessage() {}
+
+func (x *GetNewPostsCountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNewPostsCountResponse.ProtoReflect.Descriptor instead.
+func (*GetNewPostsCountResponse) Descriptor() ([]byte, []int) {
+	return file_posts_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *GetNewPostsCountResponse) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type ViewsInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Количество показов поста.
+	Impressions int64 `protobuf:"varint,1,opt,name=impressions,proto3" json:"impressions,omitempty"`
+	// Приблизительное количество уникальных зрителей, погрешность около 3%.
+	UniqueViewers int64 `protobuf:"varint,2,opt,name=unique_viewers,json=uniqueViewers,proto3" json:"unique_viewers,omitempty"`
+}
+
+func (x *ViewsInfo) Reset() {
+	*x = ViewsInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_posts_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ViewsInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ViewsInfo) ProtoMessage() {}
+
+func (x *ViewsInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ViewsInfo.ProtoReflect.Descriptor instead.
+func (*ViewsInfo) Descriptor() ([]byte, []int) {
+	return file_posts_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *ViewsInfo) GetImpressions() int64 {
+	if x != nil {
+		return x.Impressions
+	}
+	return 0
+}
+
+func (x *ViewsInfo) GetUniqueViewers() int64 {
+	if x != nil {
+		return x.UniqueViewers
+	}
+	return 0
+}
+
+type StatsPoint struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Начало часа или дня в UTC.
+	Time     *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	Views    int64                  `protobuf:"varint,2,opt,name=views,proto3" json:"views,omitempty"`
+	Likes    int64                  `protobuf:"varint,3,opt,name=likes,proto3" json:"likes,omitempty"`
+	Comments int64                  `protobuf:"varint,4,opt,name=comments,proto3" json:"comments,omitempty"`
+}
+
+func (x *StatsPoint) Reset() {
+	*x = StatsPoint{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_posts_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatsPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatsPoint) ProtoMessage() {}
+
+func (x *StatsPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatsPoint.ProtoReflect.Descriptor instead.
+func (*StatsPoint) Descriptor() ([]byte, []int) {
+	return file_posts_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *StatsPoint) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *StatsPoint) GetViews() int64 {
+	if x != nil {
+		return x.Views
+	}
+	return 0
+}
+
+func (x *StatsPoint) GetLikes() int64 {
+	if x != nil {
+		return x.Likes
+	}
+	return 0
+}
+
+func (x *StatsPoint) GetComments() int64 {
+	if x != nil {
+		return x.Comments
+	}
+	return 0
+}
+
+type GetPostStatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PostId      uint64           `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	Granularity StatsGranularity `protobuf:"varint,2,opt,name=granularity,proto3,enum=StatsGranularity" json:"granularity,omitempty"`
+	// Начало промежутка. Если не задано, время создания поста.
+	From *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
+	// Конец промежутка. Если не задан, текущее время. Не больше 31 дня по часам и 366 дней по дням.
+	To *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`
+}
+
+func (x *GetPostStatsRequest) Reset() {
+	*x = GetPostStatsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_posts_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPostStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPostStatsRequest) ProtoMessage() {}
+
+func (x *GetPostStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPostStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetPostStatsRequest) Descriptor() ([]byte, []int) {
+	return file_posts_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *GetPostStatsRequest) GetPostId() uint64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *GetPostStatsRequest) GetGranularity() StatsGranularity {
+	if x != nil {
+		return x.Granularity
+	}
+	return StatsGranularity_hour
+}
+
+func (x *GetPostStatsRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *GetPostStatsRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+type GetPostStatsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Points []*StatsPoint `protobuf:"bytes,1,rep,name=points,proto3" json:"points,omitempty"`
+	// Просмотры поста за все время.
+	Views *ViewsInfo `protobuf:"bytes,2,opt,name=views,proto3" json:"views,omitempty"`
+}
+
+func (x *GetPostStatsResponse) Reset() {
+	*x = GetPostStatsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_posts_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPostStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPostStatsResponse) ProtoMessage() {}
+
+func (x *GetPostStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPostStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetPostStatsResponse) Descriptor() ([]byte, []int) {
+	return file_posts_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *GetPostStatsResponse) GetPoints() []*StatsPoint {
+	if x != nil {
+		return x.Points
+	}
+	return nil
+}
+
+func (x *GetPostStatsResponse) GetViews() *ViewsInfo {
+	if x != nil {
+		return x.Views
+	}
+	return nil
+}
+
+type GetAuthorStatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Начало промежутка. Если не задано, 30 дней до конца промежутка.
+	From *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	// Конец промежутка. Если не задан, текущее время. Промежуток не больше 366 дней.
+	To *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	// Количество лучших постов, которые необходимо вернуть. Не больше 10.
+	TopLimit int64 `protobuf:"varint,3,opt,name=top_limit,json=topLimit,proto3" json:"top_limit,omitempty"`
+	// Часовой пояс для часов публикации, например Europe/Moscow. По умолчанию UTC.
+	TimeZone string `protobuf:"bytes,4,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+}
+
+func (x *GetAuthorStatsRequest) Reset() {
+	*x = GetAuthorStatsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_posts_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAuthorStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAuthorStatsRequest) ProtoMessage() {}
+
+func (x *GetAuthorStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAuthorStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetAuthorStatsRequest) Descriptor() ([]byte, []int) {
+	return file_posts_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *GetAuthorStatsRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *GetAuthorStatsRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *GetAuthorStatsRequest) GetTopLimit() int64 {
+	if x != nil {
+		return x.TopLimit
+	}
+	return 0
+}
+
+func (x *GetAuthorStatsRequest) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+type HourStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Час публикации, от 0 до 23.
+	Hour int32 `protobuf:"varint,1,opt,name=hour,proto3" json:"hour,omitempty"`
+	// Количество постов, опубликованных в этот час.
+	Posts int64 `protobuf:"varint,2,opt,name=posts,proto3" json:"posts,omitempty"`
+	// Сумма лайков и комментариев этих постов.
+	Engagement int64 `protobuf:"varint,3,opt,name=engagement,proto3" json:"engagement,omitempty"`
+	// Среднее количество лайков и комментариев на пост.
+	AvgEngagement float64 `protobuf:"fixed64,4,opt,name=avg_engagement,json=avgEngagement,proto3" json:"avg_engagement,omitempty"`
+}
+
+func (x *HourStats) Reset() {
+	*x = HourStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_posts_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HourStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HourStats) ProtoMessage() {}
+
+func (x *HourStats) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HourStats.ProtoReflect.Descriptor instead.
+func (*HourStats) Descriptor() ([]byte, []int) {
+	return file_posts_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *HourStats) GetHour() int32 {
+	if x != nil {
+		return x.Hour
+	}
+	return 0
+}
+
+func (x *HourStats) GetPosts() int64 {
+	if x != nil {
+		return x.Posts
+	}
+	return 0
+}
+
+func (x *HourStats) GetEngagement() int64 {
+	if x != nil {
+		return x.Engagement
+	}
+	return 0
+}
+
+func (x *HourStats) GetAvgEngagement() float64 {
+	if x != nil {
+		return x.AvgEngagement
+	}
+	return 0
+}
+
+type GetAuthorStatsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Количество постов за промежуток. Учитываются не больше 1000 последних постов.
+	TotalPosts int64 `protobuf:"varint,1,opt,name=total_posts,json=totalPosts,proto3" json:"total_posts,omitempty"`
+	// true, если постов больше 1000 и учтены только последние.
+	Truncated        bool  `protobuf:"varint,2,opt,name=truncated,proto3" json:"truncated,omitempty"`
+	TotalLikes       int64 `protobuf:"varint,3,opt,name=total_likes,json=totalLikes,proto3" json:"total_likes,omitempty"`
+	TotalComments    int64 `protobuf:"varint,4,opt,name=total_comments,json=totalComments,proto3" json:"total_comments,omitempty"`
+	TotalImpressions int64 `protobuf:"varint,5,opt,name=total_impressions,json=totalImpressions,proto3" json:"total_impressions,omitempty"`
+	// Отношение суммы лайков и комментариев к количеству показов.
+	EngagementRate float64 `protobuf:"fixed64,6,opt,name=engagement_rate,json=engagementRate,proto3" json:"engagement_rate,omitempty"`
+	// Посты с наибольшим количеством лайков и комментариев.
+	TopPosts []*Post `protobuf:"bytes,7,rep,name=top_posts,json=topPosts,proto3" json:"top_posts,omitempty"`
+	// Статистика по часам публикации, 24 элемента.
+	Hours []*HourStats `protobuf:"bytes,8,rep,name=hours,proto3" json:"hours,omitempty"`
+	// До трех часов публикации с наибольшей средней вовлеченностью.
+	BestHours []int32 `protobuf:"varint,9,rep,packed,name=best_hours,json=bestHours,proto3" json:"best_hours,omitempty"`
+	// Коэффициент корреляции между вовлеченностью постов, опубликованных за день, и приростом подписчиков за этот день.
+	// Число подписчиков запоминается раз в день, начиная с первого запроса статистики, поэтому значение появляется
+	// только через несколько дней после него. Не задан, если данных недостаточно.
+	FollowerGrowthCorrelation *float64 `protobuf:"fixed64,10,opt,name=follower_growth_correlation,json=followerGrowthCorrelation,proto3,oneof" json:"follower_growth_correlation,omitempty"`
+}
+
+func (x *GetAuthorStatsResponse) Reset() {
+	*x = GetAuthorStatsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_posts_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAuthorStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAuthorStatsResponse) ProtoMessage() {}
+
+func (x *GetAuthorStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAuthorStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetAuthorStatsResponse) Descriptor() ([]byte, []int) {
+	return file_posts_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *GetAuthorStatsResponse) GetTotalPosts() int64 {
+	if x != nil {
+		return x.TotalPosts
+	}
+	return 0
+}
+
+func (x *GetAuthorStatsResponse) GetTruncated() bool {
+	if x != nil {
+		return x.Truncated
+	}
+	return false
+}
+
+func (x *GetAuthorStatsResponse) GetTotalLikes() int64 {
+	if x != nil {
+		return x.TotalLikes
+	}
+	return 0
+}
+
+func (x *GetAuthorStatsResponse) GetTotalComments() int64 {
+	if x != nil {
+		return x.TotalComments
+	}
+	return 0
+}
+
+func (x *GetAuthorStatsResponse) GetTotalImpressions() int64 {
+	if x != nil {
+		return x.TotalImpressions
+	}
+	return 0
+}
+
+func (x *GetAuthorStatsResponse) GetEngagementRate() float64 {
+	if x != nil {
+		return x.EngagementRate
+	}
+	return 0
+}
+
+func (x *GetAuthorStatsResponse) GetTopPosts() []*Post {
+	if x != nil {
+		return x.TopPosts
+	}
+	return nil
+}
+
+func (x *GetAuthorStatsResponse) GetHours() []*HourStats {
+	if x != nil {
+		return x.Hours
+	}
+	return nil
+}
+
+func (x *GetAuthorStatsResponse) GetBestHours() []int32 {
+	if x != nil {
+		return x.BestHours
+	}
+	return nil
+}
+
+func (x *GetAuthorStatsResponse) GetFollowerGrowthCorrelation() float64 {
+	if x != nil && x.FollowerGrowthCorrelation != nil {
+		return *x.FollowerGrowthCorrelation
+	}
+	return 0
+}
+
+type SubscribeFeedRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Если true, вернется информация о владельцах новых постов.
+	Extended bool `protobuf:"varint,1,opt,name=extended,proto3" json:"extended,omitempty"`
+	// Список дополнительных полей владельцев постов, которые необходимо вернуть.
+	Fields []UserFields `protobuf:"varint,2,rep,packed,name=fields,proto3,enum=UserFields" json:"fields,omitempty"`
+}
+
+func (x *SubscribeFeedRequest) Reset() {
+	*x = SubscribeFeedRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_posts_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubscribeFeedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeFeedRequest) ProtoMessage() {}
+
+func (x *SubscribeFeedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeFeedRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeFeedRequest) Descriptor() ([]byte, []int) {
+	return file_posts_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *SubscribeFeedRequest) GetExtended() bool {
+	if x != nil {
+		return x.Extended
+	}
+	return false
+}
+
+func (x *SubscribeFeedRequest) GetFields() []UserFields {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+type SubscribeFeedResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type   UpdateType `protobuf:"varint,1,opt,name=type,proto3,enum=UpdateType" json:"type,omitempty"`
+	PostId uint64     `protobuf:"varint,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	Post   *Post      `protobuf:"bytes,3,opt,name=post,proto3" json:"post,omitempty"`
+	Likes  int64      `protobuf:"varint,4,opt,name=likes,proto3" json:"likes,omitempty"`
+}
+
+func (x *SubscribeFeedResponse) Reset() {
+	*x = SubscribeFeedResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_posts_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubscribeFeedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeFeedResponse) ProtoMessage() {}
+
+func (x *SubscribeFeedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeFeedResponse.ProtoReflect.Descriptor instead.
+func (*SubscribeFeedResponse) Descriptor() ([]byte, []int) {
+	return file_posts_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *SubscribeFeedResponse) GetType() UpdateType {
+	if x != nil {
+		return x.Type
+	}
+	return UpdateType_post_created
+}
+
+func (x *SubscribeFeedResponse) GetPostId() uint64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *SubscribeFeedResponse) GetPost() *Post {
+	if x != nil {
+		return x.Post
+	}
+	return nil
+}
+
+func (x *SubscribeFeedResponse) GetLikes() int64 {
+	if x != nil {
+		return x.Likes
+	}
+	return 0
+}
+
+type SubscribePostCommentsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PostId uint64 `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	// Если true, вернется информация о владельцах комментариев.
+	Extended bool `protobuf:"varint,2,opt,name=extended,proto3" json:"extended,omitempty"`
+	// Список дополнительных полей владельцев комментариев, которые необходимо вернуть.
+	Fields []UserFields `protobuf:"varint,3,rep,packed,name=fields,proto3,enum=UserFields" json:"fields,omitempty"`
+}
+
+func (x *SubscribePostCommentsRequest) Reset() {
+	*x = SubscribePostCommentsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_posts_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubscribePostCommentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribePostCommentsRequest) ProtoMessage() {}
+
+func (x *SubscribePostCommentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribePostCommentsRequest.ProtoReflect.Descriptor instead.
+func (*SubscribePostCommentsRequest) Descriptor() ([]byte, []int) {
+	return file_posts_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *SubscribePostCommentsRequest) GetPostId() uint64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *SubscribePostCommentsRequest) GetExtended() bool {
+	if x != nil {
+		return x.Extended
+	}
+	return false
+}
+
+func (x *SubscribePostCommentsRequest) GetFields() []UserFields {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+type SubscribePostCommentsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type    UpdateType `protobuf:"varint,1,opt,name=type,proto3,enum=UpdateType" json:"type,omitempty"`
+	PostId  uint64     `protobuf:"varint,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	Comment *Comment   `protobuf:"bytes,3,opt,name=comment,proto3" json:"comment,omitempty"`
+	Likes   int64      `protobuf:"varint,4,opt,name=likes,proto3" json:"likes,omitempty"`
+}
+
+func (x *SubscribePostCommentsResponse) Reset() {
+	*x = SubscribePostCommentsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_posts_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubscribePostCommentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribePostCommentsResponse) ProtoMessage() {}
+
+func (x *SubscribePostCommentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_posts_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribePostCommentsResponse.ProtoReflect.Descriptor instead.
+func (*SubscribePostCommentsResponse) Descriptor() ([]byte, []int) {
+	return file_posts_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *SubscribePostCommentsResponse) GetType() UpdateType {
+	if x != nil {
+		return x.Type
+	}
+	return UpdateType_post_created
+}
+
+func (x *SubscribePostCommentsResponse) GetPostId() uint64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *SubscribePostCommentsResponse) GetComment() *Comment {
+	if x != nil {
+		return x.Comment
+	}
+	return nil
+}
+
+func (x *SubscribePostCommentsResponse) GetLikes() int64 {
+	if x != nil {
+		return x.Likes
+	}
+	return 0
+}
+
+var File_posts_proto protoreflect.FileDescriptor
+
+var file_posts_proto_rawDesc = []byte{
+	0x0a, 0x0b, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0d, 0x73,
+	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0b, 0x75, 0x73,
+	0x65, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0f, 0x6c, 0x69, 0x6e, 0x6b, 0x65,
+	0x64, 0x61, 0x63, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x49, 0x0a, 0x0f, 0x56, 0x6f, 0x74,
+	0x65, 0x50, 0x6f, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07,
+	0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x70,
+	0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x05, 0x52, 0x09, 0x6f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x64, 0x73, 0x22, 0x2d, 0x0a, 0x10, 0x56, 0x6f, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x6c,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x19, 0x0a, 0x04, 0x70, 0x6f, 0x6c, 0x6c,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x50, 0x6f, 0x6c, 0x6c, 0x52, 0x04, 0x70,
+	0x6f, 0x6c, 0x6c, 0x22, 0x2d, 0x0a, 0x12, 0x52, 0x65, 0x74, 0x72, 0x61, 0x63, 0x74, 0x56, 0x6f,
+	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74,
+	0x49, 0x64, 0x22, 0x30, 0x0a, 0x13, 0x52, 0x65, 0x74, 0x72, 0x61, 0x63, 0x74, 0x56, 0x6f, 0x74,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x19, 0x0a, 0x04, 0x70, 0x6f, 0x6c,
+	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x50, 0x6f, 0x6c, 0x6c, 0x52, 0x04,
+	0x70, 0x6f, 0x6c, 0x6c, 0x22, 0x84, 0x01, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6e, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x12, 0x17, 0x0a, 0x07, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x06, 0x6c, 0x61, 0x73, 0x74, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x78,
+	0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x65, 0x78,
+	0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x12, 0x23, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x0b, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x46, 0x69, 0x65,
+	0x6c, 0x64, 0x73, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x22, 0x4c, 0x0a, 0x0b, 0x4d,
+	0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x19, 0x0a, 0x04, 0x70, 0x6f,
+	0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52,
+	0x04, 0x70, 0x6f, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x39, 0x0a, 0x13, 0x47, 0x65, 0x74,
+	0x4d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x22, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0c, 0x2e, 0x4d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69,
+	0x74, 0x65, 0x6d, 0x73, 0x22, 0xce, 0x02, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74,
+	0x73, 0x42, 0x79, 0x54, 0x61, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a,
+	0x03, 0x74, 0x61, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x61, 0x67, 0x12,
+	0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6c, 0x61, 0x73, 0x74, 0x49, 0x64, 0x12, 0x1a,
+	0x0a, 0x08, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x08, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x12, 0x2b, 0x0a, 0x11, 0x63, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x45,
+	0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0d, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x34,
+	0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64,
+	0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x0b, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x46, 0x69,
+	0x65, 0x6c, 0x64, 0x73, 0x52, 0x0e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x46, 0x69,
+	0x65, 0x6c, 0x64, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x5f, 0x73, 0x6f, 0x72, 0x74, 0x5f, 0x64, 0x69, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x53, 0x6f, 0x72, 0x74, 0x44, 0x69, 0x72,
+	0x12, 0x23, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0e,
+	0x32, 0x0b, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x52, 0x06, 0x66,
+	0x69, 0x65, 0x6c, 0x64, 0x73, 0x22, 0x34, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74,
+	0x73, 0x42, 0x79, 0x54, 0x61, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b,
+	0x0a, 0x05, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x05, 0x2e,
+	0x50, 0x6f, 0x73, 0x74, 0x52, 0x05, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x22, 0xd7, 0x02, 0x0a, 0x12,
+	0x47, 0x65, 0x74, 0x54, 0x61, 0x67, 0x73, 0x46, 0x65, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x6c, 0x61, 0x73, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6c, 0x61, 0x73, 0x74, 0x49,
+	0x64, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x08, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x12, 0x2b, 0x0a,
+	0x11, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x64,
+	0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0d, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x4c, 0x69, 0x6d, 0x69,
+	0x74, 0x12, 0x34, 0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x66, 0x69,
+	0x65, 0x6c, 0x64, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x0b, 0x2e, 0x55, 0x73, 0x65,
+	0x72, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x52, 0x0e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x5f, 0x73, 0x6f, 0x72, 0x74, 0x5f, 0x64, 0x69, 0x72, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x53, 0x6f, 0x72, 0x74,
+	0x44, 0x69, 0x72, 0x12, 0x23, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x08, 0x20,
+	0x03, 0x28, 0x0e, 0x32, 0x0b, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73,
+	0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x68, 0x69, 0x64, 0x65,
+	0x5f, 0x73, 0x65, 0x65, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x68, 0x69, 0x64,
+	0x65, 0x53, 0x65, 0x65, 0x6e, 0x22, 0x32, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x54, 0x61, 0x67, 0x73,
+	0x46, 0x65, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x05,
+	0x70, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x50, 0x6f,
+	0x73, 0x74, 0x52, 0x05, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x22, 0x6e, 0x0a, 0x08, 0x41, 0x75, 0x64,
+	0x69, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x5f,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x6d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x46, 0x0a, 0x15, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x41, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x03, 0x52, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x73, 0x22, 0x3f, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x75, 0x64, 0x69, 0x65,
+	0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x08, 0x61,
+	0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e,
+	0x41, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x08, 0x61, 0x75, 0x64, 0x69, 0x65, 0x6e,
+	0x63, 0x65, 0x22, 0x52, 0x0a, 0x14, 0x41, 0x64, 0x64, 0x54, 0x6f, 0x41, 0x75, 0x64, 0x69, 0x65,
+	0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x75,
+	0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0a, 0x61, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x03, 0x52, 0x07, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x73, 0x22, 0x17, 0x0a, 0x15, 0x41, 0x64, 0x64, 0x54, 0x6f, 0x41,
+	0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x16, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x40, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x41,
+	0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x27, 0x0a, 0x09, 0x61, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x09,
+	0x61, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x22, 0xe3, 0x01, 0x0a, 0x05, 0x44, 0x72,
+	0x61, 0x66, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x12, 0x18,
+	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x2d, 0x0a, 0x0b, 0x61, 0x74, 0x74, 0x61,
+	0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e,
+	0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x61, 0x74, 0x74, 0x61,
+	0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x36, 0x0a, 0x0d, 0x6c, 0x69, 0x6e, 0x6b, 0x65,
+	0x64, 0x61, 0x63, 0x63, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11,
+	0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e,
+	0x70, 0x52, 0x0c, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x61, 0x63, 0x63, 0x49, 0x64, 0x73, 0x12,
+	0x2e, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x22,
+	0xab, 0x01, 0x0a, 0x10, 0x53, 0x61, 0x76, 0x65, 0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x35, 0x0a, 0x0e, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x49, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x41,
+	0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x52, 0x0e, 0x61, 0x74, 0x74,
+	0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x49, 0x64, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x36, 0x0a, 0x0d, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x61,
+	0x63, 0x63, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x4c,
+	0x69, 0x6e, 0x6b, 0x65, 0x64, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x70, 0x52,
+	0x0c, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x61, 0x63, 0x63, 0x49, 0x64, 0x73, 0x22, 0x31, 0x0a,
+	0x11, 0x53, 0x61, 0x76, 0x65, 0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x1c, 0x0a, 0x05, 0x64, 0x72, 0x61, 0x66, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x06, 0x2e, 0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x05, 0x64, 0x72, 0x61, 0x66, 0x74,
+	0x22, 0x42, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x72, 0x61, 0x66, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x6c,
+	0x61, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6c, 0x61,
+	0x73, 0x74, 0x49, 0x64, 0x22, 0x34, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x72, 0x61, 0x66,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x06, 0x64, 0x72,
+	0x61, 0x66, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x44, 0x72, 0x61,
+	0x66, 0x74, 0x52, 0x06, 0x64, 0x72, 0x61, 0x66, 0x74, 0x73, 0x22, 0x21, 0x0a, 0x0f, 0x47, 0x65,
+	0x74, 0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x30, 0x0a,
+	0x10, 0x47, 0x65, 0x74, 0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x1c, 0x0a, 0x05, 0x64, 0x72, 0x61, 0x66, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x06, 0x2e, 0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x05, 0x64, 0x72, 0x61, 0x66, 0x74, 0x22,
+	0x24, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x15, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44,
+	0x72, 0x61, 0x66, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x25, 0x0a, 0x13,
+	0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x02, 0x69, 0x64, 0x22, 0x31, 0x0a, 0x14, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x44, 0x72,
+	0x61, 0x66, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x19, 0x0a, 0x04, 0x70,
+	0x6f, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x50, 0x6f, 0x73, 0x74,
+	0x52, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x22, 0x9b, 0x02, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x50, 0x6f,
+	0x73, 0x74, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a,
+	0x08, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x08, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x12, 0x2b, 0x0a, 0x11, 0x63, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x45, 0x78,
+	0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d,
+	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x34, 0x0a,
+	0x0f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73,
+	0x18, 0x06, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x0b, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x46, 0x69, 0x65,
+	0x6c, 0x64, 0x73, 0x52, 0x0e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x46, 0x69, 0x65,
+	0x6c, 0x64, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x5f,
+	0x73, 0x6f, 0x72, 0x74, 0x5f, 0x64, 0x69, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f,
+	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x53, 0x6f, 0x72, 0x74, 0x44, 0x69, 0x72, 0x12,
+	0x23, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0e, 0x32,
+	0x0b, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x52, 0x06, 0x66, 0x69,
+	0x65, 0x6c, 0x64, 0x73, 0x22, 0x30, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x42,
+	0x79, 0x49, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x19, 0x0a, 0x04, 0x70,
+	0x6f, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x50, 0x6f, 0x73, 0x74,
+	0x52, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x22, 0x9e, 0x01, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07,
+	0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x70,
+	0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x88, 0x01, 0x01, 0x12, 0x35, 0x0a, 0x0b, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x41, 0x74, 0x74, 0x61,
+	0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x73, 0x48, 0x01, 0x52, 0x0b, 0x61, 0x74, 0x74,
+	0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x88, 0x01, 0x01, 0x42, 0x0a, 0x0a, 0x08, 0x5f,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x61, 0x74, 0x74, 0x61,
+	0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x30, 0x0a, 0x0d, 0x41, 0x74, 0x74, 0x61, 0x63,
+	0x68, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x73, 0x12, 0x1f, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65,
+	0x6e, 0x74, 0x49, 0x64, 0x52, 0x03, 0x69, 0x64, 0x73, 0x22, 0x2f, 0x0a, 0x12, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x19, 0x0a, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x05, 0x2e,
+	0x50, 0x6f, 0x73, 0x74, 0x52, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x22, 0xbc, 0x01, 0x0a, 0x16, 0x47,
+	0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x14,
+	0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6c, 0x61, 0x73, 0x74, 0x49, 0x64, 0x12, 0x1a, 0x0a,
+	0x08, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x08, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x6f, 0x72,
+	0x74, 0x5f, 0x64, 0x69, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x6f, 0x72,
+	0x74, 0x44, 0x69, 0x72, 0x12, 0x23, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x06,
+	0x20, 0x03, 0x28, 0x0e, 0x32, 0x0b, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x73, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x22, 0x3f, 0x0a, 0x17, 0x47, 0x65, 0x74,
+	0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0xd5, 0x02, 0x0a, 0x07, 0x43,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12,
+	0x19, 0x0a, 0x08, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x07, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x12, 0x2d, 0x0a, 0x0b, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x41, 0x74, 0x74, 0x61,
+	0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x12, 0x2e, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x74,
+	0x69, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x05, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72,
+	0x12, 0x24, 0x0a, 0x08, 0x6d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x08, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x08, 0x2e, 0x4d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x6d, 0x65,
+	0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x27, 0x0a, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69,
+	0x65, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x54, 0x65, 0x78, 0x74, 0x45,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12,
+	0x21, 0x0a, 0x0c, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x41, 0x63, 0x74,
+	0x6f, 0x72, 0x22, 0x73, 0x0a, 0x0a, 0x54, 0x65, 0x78, 0x74, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x12, 0x23, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f,
+	0x2e, 0x54, 0x65, 0x78, 0x74, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6c,
+	0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x22, 0x52, 0x0a, 0x07, 0x4d, 0x65, 0x6e, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x65,
+	0x6e, 0x67, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6c, 0x65, 0x6e, 0x67,
+	0x74, 0x68, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x81, 0x01, 0x0a, 0x13,
+	0x57, 0x72, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x61, 0x67, 0x65, 0x12, 0x35, 0x0a, 0x0e, 0x61, 0x74, 0x74, 0x61,
+	0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x49, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x0d, 0x2e, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x52,
+	0x0e, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x49, 0x64, 0x73, 0x22,
+	0x3a, 0x0a, 0x14, 0x57, 0x72, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x55, 0x0a, 0x09, 0x4c,
+	0x69, 0x6b, 0x65, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x19, 0x0a, 0x05, 0x6c, 0x69, 0x6b, 0x65,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x05, 0x6c, 0x69, 0x6b, 0x65, 0x64,
+	0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x48, 0x01, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x42, 0x08,
+	0x0a, 0x06, 0x5f, 0x6c, 0x69, 0x6b, 0x65, 0x64, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x22, 0x53, 0x0a, 0x0c, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x49, 0x6e,
+	0x66, 0x6f, 0x12, 0x19, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x48, 0x00, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x1e, 0x0a,
+	0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x43,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x42, 0x08, 0x0a,
+	0x06, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xd7, 0x04, 0x0a, 0x04, 0x50, 0x6f, 0x73, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x2e, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65,
+	0x12, 0x19, 0x0a, 0x08, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x07, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x2d, 0x0a, 0x0b, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x41, 0x74, 0x74,
+	0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x12, 0x20, 0x0a, 0x05, 0x6c, 0x69, 0x6b, 0x65, 0x73, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x4c, 0x69, 0x6b, 0x65, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x05, 0x6c, 0x69, 0x6b, 0x65, 0x73, 0x12, 0x29, 0x0a, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x12, 0x1b, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x05, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x2b,
+	0x0a, 0x0a, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x0b, 0x2e, 0x56, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52,
+	0x0a, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x61,
+	0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x03,
+	0x52, 0x0a, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x49, 0x64, 0x73, 0x12, 0x1f, 0x0a, 0x0b,
+	0x61, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0a, 0x61, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1c, 0x0a,
+	0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x48, 0x61,
+	0x73, 0x68, 0x74, 0x61, 0x67, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x24, 0x0a, 0x08, 0x6d,
+	0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x08, 0x2e,
+	0x4d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x6d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x27, 0x0a, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x0e, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x54, 0x65, 0x78, 0x74, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x52, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x08, 0x70, 0x72,
+	0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x4c,
+	0x69, 0x6e, 0x6b, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x08, 0x70, 0x72, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x73, 0x12, 0x19, 0x0a, 0x04, 0x70, 0x6f, 0x6c, 0x6c, 0x18, 0x10, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x05, 0x2e, 0x50, 0x6f, 0x6c, 0x6c, 0x52, 0x04, 0x70, 0x6f, 0x6c, 0x6c, 0x12,
+	0x20, 0x0a, 0x05, 0x76, 0x69, 0x65, 0x77, 0x73, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a,
+	0x2e, 0x56, 0x69, 0x65, 0x77, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x76, 0x69, 0x65, 0x77,
+	0x73, 0x22, 0x5c, 0x0a, 0x0a, 0x50, 0x6f, 0x6c, 0x6c, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74,
+	0x65, 0x78, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x6f, 0x74,
+	0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x64, 0x22,
+	0xd0, 0x01, 0x0a, 0x04, 0x50, 0x6f, 0x6c, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x50, 0x6f, 0x6c, 0x6c, 0x4f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x6d,
+	0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x6d,
+	0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x6c, 0x6f, 0x73, 0x65,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x54, 0x69,
+	0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x06, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x6f,
+	0x74, 0x65, 0x72, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x76, 0x6f, 0x74, 0x65,
+	0x72, 0x73, 0x22, 0x96, 0x01, 0x0a, 0x07, 0x4e, 0x65, 0x77, 0x50, 0x6f, 0x6c, 0x6c, 0x12, 0x1a,
+	0x0a, 0x08, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x65,
+	0x12, 0x39, 0x0a, 0x0a, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x09, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x6d, 0x0a, 0x0b, 0x4c,
+	0x69, 0x6e, 0x6b, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72,
+	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x14, 0x0a, 0x05,
+	0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74,
+	0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x22, 0x4b, 0x0a, 0x07, 0x48, 0x61,
+	0x73, 0x68, 0x74, 0x61, 0x67, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x74, 0x61, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x22, 0xa6, 0x02, 0x0a, 0x0e, 0x4e, 0x65, 0x77, 0x50,
+	0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x35, 0x0a, 0x0e, 0x61, 0x74,
+	0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x49, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x49,
+	0x64, 0x52, 0x0e, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x49, 0x64,
+	0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x36, 0x0a, 0x0d, 0x6c,
+	0x69, 0x6e, 0x6b, 0x65, 0x64, 0x61, 0x63, 0x63, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x49, 0x6e, 0x70, 0x52, 0x0c, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x61, 0x63, 0x63,
+	0x49, 0x64, 0x73, 0x12, 0x2b, 0x0a, 0x0a, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74,
+	0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0b, 0x2e, 0x56, 0x69, 0x73, 0x69, 0x62, 0x69,
+	0x6c, 0x69, 0x74, 0x79, 0x52, 0x0a, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79,
+	0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x69, 0x64, 0x73, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x03, 0x52, 0x0a, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x49, 0x64,
+	0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x61, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65,
+	0x49, 0x64, 0x12, 0x1c, 0x0a, 0x04, 0x70, 0x6f, 0x6c, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x08, 0x2e, 0x4e, 0x65, 0x77, 0x50, 0x6f, 0x6c, 0x6c, 0x52, 0x04, 0x70, 0x6f, 0x6c, 0x6c,
+	0x22, 0x2c, 0x0a, 0x0f, 0x4e, 0x65, 0x77, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x19, 0x0a, 0x04, 0x50, 0x6f, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x05, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x04, 0x50, 0x6f, 0x73, 0x74, 0x22, 0xd8,
+	0x02, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x17, 0x0a, 0x07,
+	0x6c, 0x61, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6c,
+	0x61, 0x73, 0x74, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65,
+	0x64, 0x12, 0x2b, 0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x65, 0x78,
+	0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x63, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x12, 0x25,
+	0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x34, 0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x0b,
+	0x2e, 0x55, 0x73, 0x65, 0x72, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x52, 0x0e, 0x63, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x63,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x73, 0x6f, 0x72, 0x74, 0x5f, 0x64, 0x69, 0x72,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x53, 0x6f, 0x72, 0x74, 0x44, 0x69, 0x72, 0x12, 0x23, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64,
+	0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x0b, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x46, 0x69,
+	0x65, 0x6c, 0x64, 0x73, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x1b, 0x0a, 0x09,
+	0x68, 0x69, 0x64, 0x65, 0x5f, 0x73, 0x65, 0x65, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x08, 0x68, 0x69, 0x64, 0x65, 0x53, 0x65, 0x65, 0x6e, 0x22, 0x33, 0x0a, 0x14, 0x47, 0x65, 0x74,
+	0x50, 0x6f, 0x73, 0x74, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x1b, 0x0a, 0x05, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x05, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x05, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x22, 0xd4,
+	0x02, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x55, 0x73, 0x65, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x17, 0x0a, 0x07,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6c, 0x61, 0x73, 0x74, 0x49, 0x64, 0x12, 0x1a,
+	0x0a, 0x08, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x08, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x12, 0x2b, 0x0a, 0x11, 0x63, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x45,
+	0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0d, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x34,
+	0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64,
+	0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x0b, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x46, 0x69,
+	0x65, 0x6c, 0x64, 0x73, 0x52, 0x0e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x46, 0x69,
+	0x65, 0x6c, 0x64, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x5f, 0x73, 0x6f, 0x72, 0x74, 0x5f, 0x64, 0x69, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x53, 0x6f, 0x72, 0x74, 0x44, 0x69, 0x72,
+	0x12, 0x23, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0e,
+	0x32, 0x0b, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x52, 0x06, 0x66,
+	0x69, 0x65, 0x6c, 0x64, 0x73, 0x22, 0x33, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74,
+	0x73, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a,
+	0x05, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x50,
+	0x6f, 0x73, 0x74, 0x52, 0x05, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x22, 0x29, 0x0a, 0x0e, 0x41, 0x64,
+	0x64, 0x4c, 0x69, 0x6b, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07,
+	0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x70,
+	0x6f, 0x73, 0x74, 0x49, 0x64, 0x22, 0x11, 0x0a, 0x0f, 0x41, 0x64, 0x64, 0x4c, 0x69, 0x6b, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2c, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x4c, 0x69, 0x6b, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a,
+	0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06,
+	0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x22, 0x14, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x4c, 0x69, 0x6b, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2c, 0x0a, 0x11,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x22, 0x14, 0x0a, 0x12, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x84, 0x04, 0x0a, 0x12, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x50, 0x6f, 0x73, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6c, 0x61, 0x73, 0x74, 0x49, 0x64, 0x12, 0x29, 0x0a, 0x10,
+	0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x43,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x77, 0x6e, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x6f, 0x77, 0x6e, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x74, 0x61, 0x67, 0x12, 0x2e, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04,
+	0x66, 0x72, 0x6f, 0x6d, 0x12, 0x2a, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x02, 0x74, 0x6f,
+	0x12, 0x1a, 0x0a, 0x08, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x08, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x12, 0x2b, 0x0a, 0x11,
+	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65,
+	0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0d, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74,
+	0x12, 0x34, 0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x66, 0x69, 0x65,
+	0x6c, 0x64, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x0b, 0x2e, 0x55, 0x73, 0x65, 0x72,
+	0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x52, 0x0e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x5f, 0x73, 0x6f, 0x72, 0x74, 0x5f, 0x64, 0x69, 0x72, 0x18, 0x0d, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x53, 0x6f, 0x72, 0x74, 0x44,
+	0x69, 0x72, 0x12, 0x23, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x0e, 0x20, 0x03,
+	0x28, 0x0e, 0x32, 0x0b, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x52,
+	0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x22, 0x4b, 0x0a, 0x13, 0x53, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b,
+	0x0a, 0x05, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x05, 0x2e,
+	0x50, 0x6f, 0x73, 0x74, 0x52, 0x05, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x6c,
+	0x61, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6c, 0x61,
+	0x73, 0x74, 0x49, 0x64, 0x22, 0xd8, 0x02, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x54, 0x72, 0x65, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x18,
+	0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x78, 0x74, 0x65,
+	0x6e, 0x64, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x65, 0x78, 0x74, 0x65,
+	0x6e, 0x64, 0x65, 0x64, 0x12, 0x2b, 0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x5f, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x10, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65,
+	0x64, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x34, 0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28,
+	0x0e, 0x32, 0x0b, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x52, 0x0e,
+	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x2a,
+	0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x73, 0x6f, 0x72, 0x74, 0x5f,
+	0x64, 0x69, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x53, 0x6f, 0x72, 0x74, 0x44, 0x69, 0x72, 0x12, 0x23, 0x0a, 0x06, 0x66, 0x69,
+	0x65, 0x6c, 0x64, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x0b, 0x2e, 0x55, 0x73, 0x65,
+	0x72, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x22,
+	0x72, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x54, 0x72, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x6f,
+	0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x05, 0x70,
+	0x6f, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x50, 0x6f, 0x73,
+	0x74, 0x52, 0x05, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x6f, 0x66, 0x66, 0x73, 0x65,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x4f, 0x66, 0x66,
+	0x73, 0x65, 0x74, 0x22, 0x9f, 0x03, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x52, 0x61, 0x6e, 0x6b, 0x65,
+	0x64, 0x46, 0x65, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x78,
+	0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x65, 0x78,
+	0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x12, 0x2b, 0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x5f, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x10, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x45, 0x78, 0x74, 0x65, 0x6e,
+	0x64, 0x65, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x5f,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x63, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x34, 0x0a, 0x0f, 0x63, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x06, 0x20,
+	0x03, 0x28, 0x0e, 0x32, 0x0b, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73,
+	0x52, 0x0e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73,
+	0x12, 0x2a, 0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x73, 0x6f, 0x72,
+	0x74, 0x5f, 0x64, 0x69, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x63, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x53, 0x6f, 0x72, 0x74, 0x44, 0x69, 0x72, 0x12, 0x23, 0x0a, 0x06,
+	0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x0b, 0x2e, 0x55,
+	0x73, 0x65, 0x72, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64,
+	0x73, 0x12, 0x1b, 0x0a, 0x09, 0x68, 0x69, 0x64, 0x65, 0x5f, 0x73, 0x65, 0x65, 0x6e, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x68, 0x69, 0x64, 0x65, 0x53, 0x65, 0x65, 0x6e, 0x12, 0x2b,
+	0x0a, 0x11, 0x64, 0x65, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x69, 0x7a, 0x65, 0x5f, 0x73,
+	0x65, 0x65, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x64, 0x65, 0x70, 0x72, 0x69,
+	0x6f, 0x72, 0x69, 0x74, 0x69, 0x7a, 0x65, 0x53, 0x65, 0x65, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x6f, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x52, 0x61, 0x6e, 0x6b,
+	0x65, 0x64, 0x46, 0x65, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b,
+	0x0a, 0x05, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x05, 0x2e,
+	0x50, 0x6f, 0x73, 0x74, 0x52, 0x05, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6e,
+	0x65, 0x78, 0x74, 0x5f, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x18, 0x0a, 0x07,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x2c, 0x0a, 0x0f, 0x4d, 0x61, 0x72, 0x6b, 0x53, 0x65,
+	0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x6f, 0x73,
+	0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x04, 0x52, 0x07, 0x70, 0x6f, 0x73,
+	0x74, 0x49, 0x64, 0x73, 0x22, 0x12, 0x0a, 0x10, 0x4d, 0x61, 0x72, 0x6b, 0x53, 0x65, 0x65, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x19, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x4e,
+	0x65, 0x77, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x22, 0x30, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x4e, 0x65, 0x77, 0x50, 0x6f, 0x73,
+	0x74, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x54, 0x0a, 0x09, 0x56, 0x69, 0x65, 0x77, 0x73, 0x49, 0x6e,
+	0x66, 0x6f, 0x12, 0x20, 0x0a, 0x0b, 0x69, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x69, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x76,
+	0x69, 0x65, 0x77, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x75, 0x6e,
+	0x69, 0x71, 0x75, 0x65, 0x56, 0x69, 0x65, 0x77, 0x65, 0x72, 0x73, 0x22, 0x84, 0x01, 0x0a, 0x0a,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x04, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x69,
+	0x65, 0x77, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x69, 0x65, 0x77, 0x73,
+	0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6b, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x05, 0x6c, 0x69, 0x6b, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x22, 0xbf, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f,
+	0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x70, 0x6f, 0x73,
+	0x74, 0x49, 0x64, 0x12, 0x33, 0x0a, 0x0b, 0x67, 0x72, 0x61, 0x6e, 0x75, 0x6c, 0x61, 0x72, 0x69,
+	0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x47, 0x72, 0x61, 0x6e, 0x75, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x52, 0x0b, 0x67, 0x72, 0x61,
+	0x6e, 0x75, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x12, 0x2e, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x2a, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x02, 0x74, 0x6f, 0x22, 0x5d, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x06,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x53,
+	0x74, 0x61, 0x74, 0x73, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x73, 0x12, 0x20, 0x0a, 0x05, 0x76, 0x69, 0x65, 0x77, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0a, 0x2e, 0x56, 0x69, 0x65, 0x77, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x76, 0x69,
+	0x65, 0x77, 0x73, 0x22, 0xad, 0x01, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x41, 0x75, 0x74, 0x68, 0x6f,
+	0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a,
+	0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x2a, 0x0a,
+	0x02, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x6f, 0x70,
+	0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x74, 0x6f,
+	0x70, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x7a,
+	0x6f, 0x6e, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x5a,
+	0x6f, 0x6e, 0x65, 0x22, 0x7c, 0x0a, 0x09, 0x48, 0x6f, 0x75, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f, 0x75, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04,
+	0x68, 0x6f, 0x75, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x05, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x6e,
+	0x67, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a,
+	0x65, 0x6e, 0x67, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x76,
+	0x67, 0x5f, 0x65, 0x6e, 0x67, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x0d, 0x61, 0x76, 0x67, 0x45, 0x6e, 0x67, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x22, 0xbf, 0x03, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x53,
+	0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x1c, 0x0a,
+	0x09, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x09, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x6c, 0x69, 0x6b, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x4c, 0x69, 0x6b, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x0e,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x69, 0x6d, 0x70,
+	0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x49, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x27, 0x0a, 0x0f, 0x65, 0x6e, 0x67, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x72,
+	0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x65, 0x6e, 0x67, 0x61, 0x67,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x61, 0x74, 0x65, 0x12, 0x22, 0x0a, 0x09, 0x74, 0x6f, 0x70,
+	0x5f, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x50,
+	0x6f, 0x73, 0x74, 0x52, 0x08, 0x74, 0x6f, 0x70, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x20, 0x0a,
+	0x05, 0x68, 0x6f, 0x75, 0x72, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x48,
+	0x6f, 0x75, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x05, 0x68, 0x6f, 0x75, 0x72, 0x73, 0x12,
+	0x1d, 0x0a, 0x0a, 0x62, 0x65, 0x73, 0x74, 0x5f, 0x68, 0x6f, 0x75, 0x72, 0x73, 0x18, 0x09, 0x20,
+	0x03, 0x28, 0x05, 0x52, 0x09, 0x62, 0x65, 0x73, 0x74, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x12, 0x43,
+	0x0a, 0x1b, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x5f, 0x67, 0x72, 0x6f, 0x77, 0x74,
+	0x68, 0x5f, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x01, 0x48, 0x00, 0x52, 0x19, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x47,
+	0x72, 0x6f, 0x77, 0x74, 0x68, 0x43, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x88, 0x01, 0x01, 0x42, 0x1e, 0x0a, 0x1c, 0x5f, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72,
+	0x5f, 0x67, 0x72, 0x6f, 0x77, 0x74, 0x68, 0x5f, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x22, 0x57, 0x0a, 0x14, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+	0x46, 0x65, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x65,
+	0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x65,
+	0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x12, 0x23, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x0b, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x46, 0x69,
+	0x65, 0x6c, 0x64, 0x73, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x22, 0x82, 0x01, 0x0a,
+	0x15, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x46, 0x65, 0x65, 0x64, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x0b, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64,
+	0x12, 0x19, 0x0a, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x05,
+	0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c,
+	0x69, 0x6b, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x69, 0x6b, 0x65,
+	0x73, 0x22, 0x78, 0x0a, 0x1c, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x50, 0x6f,
+	0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x78,
+	0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x65, 0x78,
+	0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x12, 0x23, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x0b, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x46, 0x69, 0x65,
+	0x6c, 0x64, 0x73, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x22, 0x93, 0x01, 0x0a, 0x1d,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x43, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0b, 0x2e, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x17,
+	0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c,
+	0x69, 0x6b, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x69, 0x6b, 0x65,
+	0x73, 0x2a, 0x4d, 0x0a, 0x0e, 0x54, 0x65, 0x78, 0x74, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x62, 0x6f, 0x6c, 0x64, 0x10, 0x00, 0x12, 0x0a, 0x0a,
+	0x06, 0x69, 0x74, 0x61, 0x6c, 0x69, 0x63, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x73, 0x74, 0x72,
+	0x69, 0x6b, 0x65, 0x74, 0x68, 0x72, 0x6f, 0x75, 0x67, 0x68, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04,
+	0x63, 0x6f, 0x64, 0x65, 0x10, 0x03, 0x12, 0x08, 0x0a, 0x04, 0x6c, 0x69, 0x6e, 0x6b, 0x10, 0x04,
+	0x2a, 0x50, 0x0a, 0x0a, 0x56, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x0a,
+	0x0a, 0x06, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x73, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x73, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x6f,
+	0x6e, 0x6c, 0x79, 0x5f, 0x6d, 0x65, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x63, 0x75, 0x73, 0x74,
+	0x6f, 0x6d, 0x10, 0x03, 0x12, 0x0c, 0x0a, 0x08, 0x61, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65,
+	0x10, 0x04, 0x2a, 0x25, 0x0a, 0x10, 0x53, 0x74, 0x61, 0x74, 0x73, 0x47, 0x72, 0x61, 0x6e, 0x75,
+	0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x12, 0x08, 0x0a, 0x04, 0x68, 0x6f, 0x75, 0x72, 0x10, 0x00,
+	0x12, 0x07, 0x0a, 0x03, 0x64, 0x61, 0x79, 0x10, 0x01, 0x2a, 0x46, 0x0a, 0x0a, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x10, 0x0a, 0x0c, 0x70, 0x6f, 0x73, 0x74, 0x5f,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x63, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x10, 0x01, 0x12, 0x11,
+	0x0a, 0x0d, 0x6c, 0x69, 0x6b, 0x65, 0x73, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x10,
+	0x02, 0x32, 0xcd, 0x16, 0x0a, 0x05, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x47, 0x0a, 0x07, 0x4e,
+	0x65, 0x77, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x0f, 0x2e, 0x4e, 0x65, 0x77, 0x50, 0x6f, 0x73, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x4e, 0x65, 0x77, 0x50, 0x6f, 0x73,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x13, 0x3a, 0x01, 0x2a, 0x22, 0x0e, 0x2f, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x2f, 0x4e, 0x65, 0x77,
+	0x50, 0x6f, 0x73, 0x74, 0x12, 0x58, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x73,
+	0x4c, 0x69, 0x73, 0x74, 0x12, 0x14, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x47, 0x65, 0x74,
+	0x50, 0x6f, 0x73, 0x74, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x12, 0x13, 0x2f, 0x50, 0x6f, 0x73, 0x74,
+	0x73, 0x2f, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x58,
+	0x0a, 0x0c, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x55, 0x73, 0x65, 0x72, 0x12, 0x14,
+	0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x55,
+	0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1b, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x15, 0x12, 0x13, 0x2f, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x2f, 0x47, 0x65, 0x74, 0x50,
+	0x6f, 0x73, 0x74, 0x73, 0x55, 0x73, 0x65, 0x72, 0x12, 0x47, 0x0a, 0x07, 0x41, 0x64, 0x64, 0x4c,
+	0x69, 0x6b, 0x65, 0x12, 0x0f, 0x2e, 0x41, 0x64, 0x64, 0x4c, 0x69, 0x6b, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x41, 0x64, 0x64, 0x4c, 0x69, 0x6b, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x3a, 0x01,
+	0x2a, 0x22, 0x0e, 0x2f, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x2f, 0x41, 0x64, 0x64, 0x4c, 0x69, 0x6b,
+	0x65, 0x12, 0x53, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4c, 0x69, 0x6b, 0x65, 0x12,
+	0x12, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4c, 0x69, 0x6b, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4c, 0x69, 0x6b, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16,
+	0x3a, 0x01, 0x2a, 0x22, 0x11, 0x2f, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x2f, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x4c, 0x69, 0x6b, 0x65, 0x12, 0x5b, 0x0a, 0x0c, 0x57, 0x72, 0x69, 0x74, 0x65, 0x43,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x2e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x43, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x57,
+	0x72, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x3a, 0x01, 0x2a, 0x22, 0x13,
+	0x2f, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x2f, 0x57, 0x72, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x12, 0x64, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x17, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x18, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x18, 0x12, 0x16, 0x2f, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x2f, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x53, 0x0a, 0x0a, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x12, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x3a, 0x01, 0x2a, 0x22, 0x11, 0x2f, 0x50, 0x6f,
+	0x73, 0x74, 0x73, 0x2f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x54,
+	0x0a, 0x0b, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x42, 0x79, 0x49, 0x64, 0x12, 0x13, 0x2e,
+	0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x14, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x42, 0x79, 0x49, 0x64,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14,
+	0x12, 0x12, 0x2f, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x2f, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74,
+	0x42, 0x79, 0x49, 0x64, 0x12, 0x4f, 0x0a, 0x09, 0x53, 0x61, 0x76, 0x65, 0x44, 0x72, 0x61, 0x66,
+	0x74, 0x12, 0x11, 0x2e, 0x53, 0x61, 0x76, 0x65, 0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x53, 0x61, 0x76, 0x65, 0x44, 0x72, 0x61, 0x66, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15,
+	0x3a, 0x01, 0x2a, 0x22, 0x10, 0x2f, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x2f, 0x53, 0x61, 0x76, 0x65,
+	0x44, 0x72, 0x61, 0x66, 0x74, 0x12, 0x50, 0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x72, 0x61,
+	0x66, 0x74, 0x73, 0x12, 0x12, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x72, 0x61, 0x66, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x72,
+	0x61, 0x66, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x19, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x13, 0x12, 0x11, 0x2f, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x2f, 0x4c, 0x69, 0x73,
+	0x74, 0x44, 0x72, 0x61, 0x66, 0x74, 0x73, 0x12, 0x48, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x44, 0x72,
+	0x61, 0x66, 0x74, 0x12, 0x10, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x72, 0x61, 0x66, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x17, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11,
+	0x12, 0x0f, 0x2f, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x2f, 0x47, 0x65, 0x74, 0x44, 0x72, 0x61, 0x66,
+	0x74, 0x12, 0x57, 0x0a, 0x0b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x72, 0x61, 0x66, 0x74,
+	0x12, 0x13, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x72,
+	0x61, 0x66, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1d, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x17, 0x3a, 0x01, 0x2a, 0x22, 0x12, 0x2f, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x2f, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x72, 0x61, 0x66, 0x74, 0x12, 0x5b, 0x0a, 0x0c, 0x50, 0x75,
+	0x62, 0x6c, 0x69, 0x73, 0x68, 0x44, 0x72, 0x61, 0x66, 0x74, 0x12, 0x14, 0x2e, 0x50, 0x75, 0x62,
+	0x6c, 0x69, 0x73, 0x68, 0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x15, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x44, 0x72, 0x61, 0x66, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x3a,
+	0x01, 0x2a, 0x22, 0x13, 0x2f, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x2f, 0x50, 0x75, 0x62, 0x6c, 0x69,
+	0x73, 0x68, 0x44, 0x72, 0x61, 0x66, 0x74, 0x12, 0x63, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x41, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x16, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x41, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x17, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x75, 0x64, 0x69, 0x65, 0x6e,
+	0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x1a, 0x3a, 0x01, 0x2a, 0x22, 0x15, 0x2f, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x2f, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x41, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x5f, 0x0a, 0x0d,
+	0x41, 0x64, 0x64, 0x54, 0x6f, 0x41, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x15, 0x2e,
+	0x41, 0x64, 0x64, 0x54, 0x6f, 0x41, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x41, 0x64, 0x64, 0x54, 0x6f, 0x41, 0x75, 0x64, 0x69,
+	0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1f, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x19, 0x3a, 0x01, 0x2a, 0x22, 0x14, 0x2f, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x2f,
+	0x41, 0x64, 0x64, 0x54, 0x6f, 0x41, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x5c, 0x0a,
+	0x0d, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x15,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x75, 0x64, 0x69,
+	0x65, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1c, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x16, 0x12, 0x14, 0x2f, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x2f, 0x4c, 0x69,
+	0x73, 0x74, 0x41, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x5c, 0x0a, 0x0d, 0x47,
+	0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x42, 0x79, 0x54, 0x61, 0x67, 0x12, 0x15, 0x2e, 0x47,
+	0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x42, 0x79, 0x54, 0x61, 0x67, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x42, 0x79,
+	0x54, 0x61, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1c, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x16, 0x12, 0x14, 0x2f, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x2f, 0x47, 0x65, 0x74, 0x50,
+	0x6f, 0x73, 0x74, 0x73, 0x42, 0x79, 0x54, 0x61, 0x67, 0x12, 0x54, 0x0a, 0x0b, 0x47, 0x65, 0x74,
+	0x54, 0x61, 0x67, 0x73, 0x46, 0x65, 0x65, 0x64, 0x12, 0x13, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x61,
+	0x67, 0x73, 0x46, 0x65, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e,
+	0x47, 0x65, 0x74, 0x54, 0x61, 0x67, 0x73, 0x46, 0x65, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x12, 0x12, 0x2f, 0x50, 0x6f,
+	0x73, 0x74, 0x73, 0x2f, 0x47, 0x65, 0x74, 0x54, 0x61, 0x67, 0x73, 0x46, 0x65, 0x65, 0x64, 0x12,
+	0x54, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x13,
+	0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x14, 0x12, 0x12, 0x2f, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x2f, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6e,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x4b, 0x0a, 0x08, 0x56, 0x6f, 0x74, 0x65, 0x50, 0x6f, 0x6c,
+	0x6c, 0x12, 0x10, 0x2e, 0x56, 0x6f, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x56, 0x6f, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x6c, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x3a, 0x01,
+	0x2a, 0x22, 0x0f, 0x2f, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x2f, 0x56, 0x6f, 0x74, 0x65, 0x50, 0x6f,
+	0x6c, 0x6c, 0x12, 0x57, 0x0a, 0x0b, 0x52, 0x65, 0x74, 0x72, 0x61, 0x63, 0x74, 0x56, 0x6f, 0x74,
+	0x65, 0x12, 0x13, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x61, 0x63, 0x74, 0x56, 0x6f, 0x74, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x61, 0x63, 0x74,
+	0x56, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1d, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x17, 0x3a, 0x01, 0x2a, 0x22, 0x12, 0x2f, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x2f,
+	0x52, 0x65, 0x74, 0x72, 0x61, 0x63, 0x74, 0x56, 0x6f, 0x74, 0x65, 0x12, 0x53, 0x0a, 0x0a, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x12, 0x2e, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x3a, 0x01, 0x2a, 0x22, 0x11, 0x2f,
+	0x50, 0x6f, 0x73, 0x74, 0x73, 0x2f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74,
+	0x12, 0x54, 0x0a, 0x0b, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x12,
+	0x13, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x50, 0x6f, 0x73,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x14, 0x12, 0x12, 0x2f, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x2f, 0x53, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x68, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x54, 0x72, 0x65,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x18, 0x2e, 0x47, 0x65, 0x74,
+	0x54, 0x72, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x65, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x12, 0x17, 0x2f, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x2f,
+	0x47, 0x65, 0x74, 0x54, 0x72, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x73, 0x74, 0x73,
+	0x12, 0x5c, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x52, 0x61, 0x6e, 0x6b, 0x65, 0x64, 0x46, 0x65, 0x65,
+	0x64, 0x12, 0x15, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x61, 0x6e, 0x6b, 0x65, 0x64, 0x46, 0x65, 0x65,
+	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x61,
+	0x6e, 0x6b, 0x65, 0x64, 0x46, 0x65, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x12, 0x14, 0x2f, 0x50, 0x6f, 0x73, 0x74, 0x73,
+	0x2f, 0x47, 0x65, 0x74, 0x52, 0x61, 0x6e, 0x6b, 0x65, 0x64, 0x46, 0x65, 0x65, 0x64, 0x12, 0x4b,
+	0x0a, 0x08, 0x4d, 0x61, 0x72, 0x6b, 0x53, 0x65, 0x65, 0x6e, 0x12, 0x10, 0x2e, 0x4d, 0x61, 0x72,
+	0x6b, 0x53, 0x65, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x4d,
+	0x61, 0x72, 0x6b, 0x53, 0x65, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x3a, 0x01, 0x2a, 0x22, 0x0f, 0x2f, 0x50, 0x6f, 0x73,
+	0x74, 0x73, 0x2f, 0x4d, 0x61, 0x72, 0x6b, 0x53, 0x65, 0x65, 0x6e, 0x12, 0x68, 0x0a, 0x10, 0x47,
+	0x65, 0x74, 0x4e, 0x65, 0x77, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x18, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x65, 0x77, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x47, 0x65, 0x74, 0x4e,
+	0x65, 0x77, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x12, 0x17, 0x2f, 0x50,
+	0x6f, 0x73, 0x74, 0x73, 0x2f, 0x47, 0x65, 0x74, 0x4e, 0x65, 0x77, 0x50, 0x6f, 0x73, 0x74, 0x73,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x58, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x14, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x47, 0x65,
+	0x74, 0x50, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x12, 0x13, 0x2f, 0x50, 0x6f, 0x73,
+	0x74, 0x73, 0x2f, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12,
+	0x60, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x12, 0x16, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x47, 0x65, 0x74, 0x41,
+	0x75, 0x74, 0x68, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x1d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x12, 0x15, 0x2f, 0x50, 0x6f, 0x73,
+	0x74, 0x73, 0x2f, 0x47, 0x65, 0x74, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x12, 0x5e, 0x0a, 0x0d, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x46, 0x65,
+	0x65, 0x64, 0x12, 0x15, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x46, 0x65,
+	0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x62, 0x65, 0x46, 0x65, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x12, 0x14, 0x2f, 0x50, 0x6f, 0x73, 0x74,
+	0x73, 0x2f, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x46, 0x65, 0x65, 0x64, 0x30,
+	0x01, 0x12, 0x7e, 0x0a, 0x15, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x50, 0x6f,
+	0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1d, 0x2e, 0x53, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x62, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x1e, 0x12, 0x1c, 0x2f, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x2f, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x62, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x30,
+	0x01, 0x42, 0x06, 0x5a, 0x04, 0x2e, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
+}
+
+var (
+	file_posts_proto_rawDescOnce sync.Once
+	file_posts_proto_rawDescData = file_posts_proto_rawDesc
+)
+
+func file_posts_proto_rawDescGZIP() []byte {
+	file_posts_proto_rawDescOnce.Do(func() {
+		file_posts_proto_rawDescData = protoimpl.X.CompressGZIP(file_posts_proto_rawDescData)
+	})
+	return file_posts_proto_rawDescData
+}
+
+var file_posts_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_posts_proto_msgTypes = make([]protoimpl.MessageInfo, 82)
+var file_posts_proto_goTypes = []interface{}{
+	(TextEntityType)(0),                   // 0: TextEntityType
+	(Visibility)(0),                       // 1: Visibility
+	(StatsGranularity)(0),                 // 2: StatsGranularity
+	(UpdateType)(0),                       // 3: UpdateType
+	(*VotePollRequest)(nil),               // 4: VotePollRequest
+	(*VotePollResponse)(nil),              // 5: VotePollResponse
+	(*RetractVoteRequest)(nil),            // 6: RetractVoteRequest
+	(*RetractVoteResponse)(nil),           // 7: RetractVoteResponse
+	(*GetMentionsRequest)(nil),            // 8: GetMentionsRequest
+	(*MentionItem)(nil),                   // 9: MentionItem
+	(*GetMentionsResponse)(nil),           // 10: GetMentionsResponse
+	(*GetPostsByTagRequest)(nil),          // 11: GetPostsByTagRequest
+	(*GetPostsByTagResponse)(nil),         // 12: GetPostsByTagResponse
+	(*GetTagsFeedRequest)(nil),            // 13: GetTagsFeedRequest
+	(*GetTagsFeedResponse)(nil),           // 14: GetTagsFeedResponse
+	(*Audience)(nil),                      // 15: Audience
+	(*CreateAudienceRequest)(nil),         // 16: CreateAudienceRequest
+	(*CreateAudienceResponse)(nil),        // 17: CreateAudienceResponse
+	(*AddToAudienceRequest)(nil),          // 18: AddToAudienceRequest
+	(*AddToAudienceResponse)(nil),         // 19: AddToAudienceResponse
+	(*ListAudiencesRequest)(nil),          // 20: ListAudiencesRequest
+	(*ListAudiencesResponse)(nil),         // 21: ListAudiencesResponse
+	(*Draft)(nil),                         // 22: Draft
+	(*SaveDraftRequest)(nil),              // 23: SaveDraftRequest
+	(*SaveDraftResponse)(nil),             // 24: SaveDraftResponse
+	(*ListDraftsRequest)(nil),             // 25: ListDraftsRequest
+	(*ListDraftsResponse)(nil),            // 26: ListDraftsResponse
+	(*GetDraftRequest)(nil),               // 27: GetDraftRequest
+	(*GetDraftResponse)(nil),              // 28: GetDraftResponse
+	(*DeleteDraftRequest)(nil),            // 29: DeleteDraftRequest
+	(*DeleteDraftResponse)(nil),           // 30: DeleteDraftResponse
+	(*PublishDraftRequest)(nil),           // 31: PublishDraftRequest
+	(*PublishDraftResponse)(nil),          // 32: PublishDraftResponse
+	(*GetPostByIdRequest)(nil),            // 33: GetPostByIdRequest
+	(*GetPostByIdResponse)(nil),           // 34: GetPostByIdResponse
+	(*UpdatePostRequest)(nil),             // 35: UpdatePostRequest
+	(*AttachmentIds)(nil),                 // 36: AttachmentIds
+	(*UpdatePostResponse)(nil),            // 37: UpdatePostResponse
+	(*GetCommentsListRequest)(nil),        // 38: GetCommentsListRequest
+	(*GetCommentsListResponse)(nil),       // 39: GetCommentsListResponse
+	(*Comment)(nil),                       // 40: Comment
+	(*TextEntity)(nil),                    // 41: TextEntity
+	(*Mention)(nil),                       // 42: Mention
+	(*WriteCommentRequest)(nil),           // 43: WriteCommentRequest
+	(*WriteCommentResponse)(nil),          // 44: WriteCommentResponse
+	(*LikesInfo)(nil),                     // 45: LikesInfo
+	(*CommentsInfo)(nil),                  // 46: CommentsInfo
+	(*Post)(nil),                          // 47: Post
+	(*PollOption)(nil),                    // 48: PollOption
+	(*Poll)(nil),                          // 49: Poll
+	(*NewPoll)(nil),                       // 50: NewPoll
+	(*LinkPreview)(nil),                   // 51: LinkPreview
+	(*Hashtag)(nil),                       // 52: Hashtag
+	(*NewPostRequest)(nil),                // 53: NewPostRequest
+	(*NewPostResponse)(nil),               // 54: NewPostResponse
+	(*GetPostsListRequest)(nil),           // 55: GetPostsListRequest
+	(*GetPostsListResponse)(nil),          // 56: GetPostsListResponse
+	(*GetPostsUserRequest)(nil),           // 57: GetPostsUserRequest
+	(*GetPostsUserResponse)(nil),          // 58: GetPostsUserResponse
+	(*AddLikeRequest)(nil),                // 59: AddLikeRequest
+	(*AddLikeResponse)(nil),               // 60: AddLikeResponse
+	(*DeleteLikeRequest)(nil),             // 61: DeleteLikeRequest
+	(*DeleteLikeResponse)(nil),            // 62: DeleteLikeResponse
+	(*DeletePostRequest)(nil),             // 63: DeletePostRequest
+	(*DeletePostResponse)(nil),            // 64: DeletePostResponse
+	(*SearchPostsRequest)(nil),            // 65: SearchPostsRequest
+	(*SearchPostsResponse)(nil),           // 66: SearchPostsResponse
+	(*GetTrendingPostsRequest)(nil),       // 67: GetTrendingPostsRequest
+	(*GetTrendingPostsResponse)(nil),      // 68: GetTrendingPostsResponse
+	(*GetRankedFeedRequest)(nil),          // 69: GetRankedFeedRequest
+	(*GetRankedFeedResponse)(nil),         // 70: GetRankedFeedResponse
+	(*MarkSeenRequest)(nil),               // 71: MarkSeenRequest
+	(*MarkSeenResponse)(nil),              // 72: MarkSeenResponse
+	(*GetNewPostsCountRequest)(nil),       // 73: GetNewPostsCountRequest
+	(*GetNewPostsCountResponse)(nil),      // 74: GetNewPostsCountResponse
+	(*ViewsInfo)(nil),                     // 75: ViewsInfo
+	(*StatsPoint)(nil),                    // 76: StatsPoint
+	(*GetPostStatsRequest)(nil),           // 77: GetPostStatsRequest
+	(*GetPostStatsResponse)(nil),          // 78: GetPostStatsResponse
+	(*GetAuthorStatsRequest)(nil),         // 79: GetAuthorStatsRequest
+	(*HourStats)(nil),                     // 80: HourStats
+	(*GetAuthorStatsResponse)(nil),        // 81: GetAuthorStatsResponse
+	(*SubscribeFeedRequest)(nil),          // 82: SubscribeFeedRequest
+	(*SubscribeFeedResponse)(nil),         // 83: SubscribeFeedResponse
+	(*SubscribePostCommentsRequest)(nil),  // 84: SubscribePostCommentsRequest
+	(*SubscribePostCommentsResponse)(nil), // 85: SubscribePostCommentsResponse
+	(UserFields)(0),                       // 86: UserFields
+	(*Attachment)(nil),                    // 87: Attachment
+	(*LinkedAccountInp)(nil),              // 88: LinkedAccountInp
+	(*timestamppb.Timestamp)(nil),         // 89: google.protobuf.Timestamp
+	(*AttachmentId)(nil),                  // 90: AttachmentId
+	(*User)(nil),                          // 91: User
+}
+var file_posts_proto_depIdxs = []int32{
+	49,  // 0: VotePollResponse.poll:type_name -> Poll
+	49,  // 1: RetractVoteResponse.poll:type_name -> Poll
+	86,  // 2: GetMentionsRequest.fields:type_name -> UserFields
+	47,  // 3: MentionItem.post:type_name -> Post
+	40,  // 4: MentionItem.comment:type_name -> Comment
+	9,   // 5: GetMentionsResponse.items:type_name -> MentionItem
+	86,  // 6: GetPostsByTagRequest.comments_fields:type_name -> UserFields
+	86,  // 7: GetPostsByTagRequest.fields:type_name -> UserFields
+	47,  // 8: GetPostsByTagResponse.posts:type_name -> Post
+	86,  // 9: GetTagsFeedRequest.comments_fields:type_name -> UserFields
+	86,  // 10: GetTagsFeedRequest.fields:type_name -> UserFields
+	47,  // 11: GetTagsFeedResponse.posts:type_name -> Post
+	15,  // 12: CreateAudienceResponse.audience:type_name -> Audience
+	15,  // 13: ListAudiencesResponse.audiences:type_name -> Audience
+	87,  // 14: Draft.attachments:type_name -> Attachment
+	88,  // 15: Draft.linkedacc_ids:type_name -> LinkedAccountInp
+	89,  // 16: Draft.time:type_name -> google.protobuf.Timestamp
+	90,  // 17: SaveDraftRequest.attachmentsIds:type_name -> AttachmentId
+	88,  // 18: SaveDraftRequest.linkedacc_ids:type_name -> LinkedAccountInp
+	22,  // 19: SaveDraftResponse.draft:type_name -> Draft
+	22,  // 20: ListDraftsResponse.drafts:type_name -> Draft
+	22,  // 21: GetDraftResponse.draft:type_name -> Draft
+	47,  // 22: PublishDraftResponse.post:type_name -> Post
+	86,  // 23: GetPostByIdRequest.comments_fields:type_name -> UserFields
+	86,  // 24: GetPostByIdRequest.fields:type_name -> UserFields
+	47,  // 25: GetPostByIdResponse.post:type_name -> Post
+	36,  // 26: UpdatePostRequest.attachments:type_name -> AttachmentIds
+	90,  // 27: AttachmentIds.ids:type_name -> AttachmentId
+	47,  // 28: UpdatePostResponse.post:type_name -> Post
+	86,  // 29: GetCommentsListRequest.fields:type_name -> UserFields
+	40,  // 30: GetCommentsListResponse.comments:type_name -> Comment
+	87,  // 31: Comment.attachments:type_name -> Attachment
+	89,  // 32: Comment.time:type_name -> google.protobuf.Timestamp
+	91,  // 33: Comment.owner:type_name -> User
+	42,  // 34: Comment.mentions:type_name -> Mention
+	41,  // 35: Comment.entities:type_name -> TextEntity
+	0,   // 36: TextEntity.type:type_name -> TextEntityType
+	90,  // 37: WriteCommentRequest.attachmentsIds:type_name -> AttachmentId
+	40,  // 38: WriteCommentResponse.comment:type_name -> Comment
+	40,  // 39: CommentsInfo.items:type_name -> Comment
+	89,  // 40: Post.time:type_name -> google.protobuf.Timestamp
+	87,  // 41: Post.attachments:type_name -> Attachment
+	45,  // 42: Post.likes:type_name -> LikesInfo
+	46,  // 43: Post.comments:type_name -> CommentsInfo
+	91,  // 44: Post.owner:type_name -> User
+	1,   // 45: Post.visibility:type_name -> Visibility
+	52,  // 46: Post.tags:type_name -> Hashtag
+	42,  // 47: Post.mentions:type_name -> Mention
+	41,  // 48: Post.entities:type_name -> TextEntity
+	51,  // 49: Post.previews:type_name -> LinkPreview
+	49,  // 50: Post.poll:type_name -> Poll
+	75,  // 51: Post.views:type_name -> ViewsInfo
+	48,  // 52: Poll.options:type_name -> PollOption
+	89,  // 53: Poll.close_time:type_name -> google.protobuf.Timestamp
+	89,  // 54: NewPoll.close_time:type_name -> google.protobuf.Timestamp
+	90,  // 55: NewPostRequest.attachmentsIds:type_name -> AttachmentId
+	88,  // 56: NewPostRequest.linkedacc_ids:type_name -> LinkedAccountInp
+	1,   // 57: NewPostRequest.visibility:type_name -> Visibility
+	50,  // 58: NewPostRequest.poll:type_name -> NewPoll
+	47,  // 59: NewPostResponse.Post:type_name -> Post
+	86,  // 60: GetPostsListRequest.comments_fields:type_name -> UserFields
+	86,  // 61: GetPostsListRequest.fields:type_name -> UserFields
+	47,  // 62: GetPostsListResponse.posts:type_name -> Post
+	86,  // 63: GetPostsUserRequest.comments_fields:type_name -> UserFields
+	86,  // 64: GetPostsUserRequest.fields:type_name -> UserFields
+	47,  // 65: GetPostsUserResponse.posts:type_name -> Post
+	89,  // 66: SearchPostsRequest.from:type_name -> google.protobuf.Timestamp
+	89,  // 67: SearchPostsRequest.to:type_name -> google.protobuf.Timestamp
+	86,  // 68: SearchPostsRequest.comments_fields:type_name -> UserFields
+	86,  // 69: SearchPostsRequest.fields:type_name -> UserFields
+	47,  // 70: SearchPostsResponse.posts:type_name -> Post
+	86,  // 71: GetTrendingPostsRequest.comments_fields:type_name -> UserFields
+	86,  // 72: GetTrendingPostsRequest.fields:type_name -> UserFields
+	47,  // 73: GetTrendingPostsResponse.posts:type_name -> Post
+	86,  // 74: GetRankedFeedRequest.comments_fields:type_name -> UserFields
+	86,  // 75: GetRankedFeedRequest.fields:type_name -> UserFields
+	47,  // 76: GetRankedFeedResponse.posts:type_name -> Post
+	89,  // 77: StatsPoint.time:type_name -> google.protobuf.Timestamp
+	2,   // 78: GetPostStatsRequest.granularity:type_name -> StatsGranularity
+	89,  // 79: GetPostStatsRequest.from:type_name -> google.protobuf.Timestamp
+	89,  // 80: GetPostStatsRequest.to:type_name -> google.protobuf.Timestamp
+	76,  // 81: GetPostStatsResponse.points:type_name -> StatsPoint
+	75,  // 82: GetPostStatsResponse.views:type_name -> ViewsInfo
+	89,  // 83: GetAuthorStatsRequest.from:type_name -> google.protobuf.Timestamp
+	89,  // 84: GetAuthorStatsRequest.to:type_name -> google.protobuf.Timestamp
+	47,  // 85: GetAuthorStatsResponse.top_posts:type_name -> Post
+	80,  // 86: GetAuthorStatsResponse.hours:type_name -> HourStats
+	86,  // 87: SubscribeFeedRequest.fields:type_name -> UserFields
+	3,   // 88: SubscribeFeedResponse.type:type_name -> UpdateType
+	47,  // 89: SubscribeFeedResponse.post:type_name -> Post
+	86,  // 90: SubscribePostCommentsRequest.fields:type_name -> UserFields
+	3,   // 91: SubscribePostCommentsResponse.type:type_name -> UpdateType
+	40,  // 92: SubscribePostCommentsResponse.comment:type_name -> Comment
+	53,  // 93: Posts.NewPost:input_type -> NewPostRequest
+	55,  // 94: Posts.GetPostsList:input_type -> GetPostsListRequest
+	57,  // 95: Posts.GetPostsUser:input_type -> GetPostsUserRequest
+	59,  // 96: Posts.AddLike:input_type -> AddLikeRequest
+	61,  // 97: Posts.DeleteLike:input_type -> DeleteLikeRequest
+	43,  // 98: Posts.WriteComment:input_type -> WriteCommentRequest
+	38,  // 99: Posts.GetCommentsList:input_type -> GetCommentsListRequest
+	35,  // 100: Posts.UpdatePost:input_type -> UpdatePostRequest
+	33,  // 101: Posts.GetPostById:input_type -> GetPostByIdRequest
+	23,  // 102: Posts.SaveDraft:input_type -> SaveDraftRequest
+	25,  // 103: Posts.ListDrafts:input_type -> ListDraftsRequest
+	27,  // 104: Posts.GetDraft:input_type -> GetDraftRequest
+	29,  // 105: Posts.DeleteDraft:input_type -> DeleteDraftRequest
+	31,  // 106: Posts.PublishDraft:input_type -> PublishDraftRequest
+	16,  // 107: Posts.CreateAudience:input_type -> CreateAudienceRequest
+	18,  // 108: Posts.AddToAudience:input_type -> AddToAudienceRequest
+	20,  // 109: Posts.ListAudiences:input_type -> ListAudiencesRequest
+	11,  // 110: Posts.GetPostsByTag:input_type -> GetPostsByTagRequest
+	13,  // 111: Posts.GetTagsFeed:input_type -> GetTagsFeedRequest
+	8,   // 112: Posts.GetMentions:input_type -> GetMentionsRequest
+	4,   // 113: Posts.VotePoll:input_type -> VotePollRequest
+	6,   // 114: Posts.RetractVote:input_type -> RetractVoteRequest
+	63,  // 115: Posts.DeletePost:input_type -> DeletePostRequest
+	65,  // 116: Posts.SearchPosts:input_type -> SearchPostsRequest
+	67,  // 117: Posts.GetTrendingPosts:input_type -> GetTrendingPostsRequest
+	69,  // 118: Posts.GetRankedFeed:input_type -> GetRankedFeedRequest
+	71,  // 119: Posts.MarkSeen:input_type -> MarkSeenRequest
+	73,  // 120: Posts.GetNewPostsCount:input_type -> GetNewPostsCountRequest
+	77,  // 121: Posts.GetPostStats:input_type -> GetPostStatsRequest
+	79,  // 122: Posts.GetAuthorStats:input_type -> GetAuthorStatsRequest
+	82,  // 123: Posts.SubscribeFeed:input_type -> SubscribeFeedRequest
+	84,  // 124: Posts.SubscribePostComments:input_type -> SubscribePostCommentsRequest
+	54,  // 125: Posts.NewPost:output_type -> NewPostResponse
+	56,  // 126: Posts.GetPostsList:output_type -> GetPostsListResponse
+	58,  // 127: Posts.GetPostsUser:output_type -> GetPostsUserResponse
+	60,  // 128: Posts.AddLike:output_type -> AddLikeResponse
+	62,  // 129: Posts.DeleteLike:output_type -> DeleteLikeResponse
+	44,  // 130: Posts.WriteComment:output_type -> WriteCommentResponse
+	39,  // 131: Posts.GetCommentsList:output_type -> GetCommentsListResponse
+	37,  // 132: Posts.UpdatePost:output_type -> UpdatePostResponse
+	34,  // 133: Posts.GetPostById:output_type -> GetPostByIdResponse
+	24,  // 134: Posts.SaveDraft:output_type -> SaveDraftResponse
+	26,  // 135: Posts.ListDrafts:output_type -> ListDraftsResponse
+	28,  // 136: Posts.GetDraft:output_type -> GetDraftResponse
+	30,  // 137: Posts.DeleteDraft:output_type -> DeleteDraftResponse
+	32,  // 138: Posts.PublishDraft:output_type -> PublishDraftResponse
+	17,  // 139: Posts.CreateAudience:output_type -> CreateAudienceResponse
+	19,  // 140: Posts.AddToAudience:output_type -> AddToAudienceResponse
+	21,  // 141: Posts.ListAudiences:output_type -> ListAudiencesResponse
+	12,  // 142: Posts.GetPostsByTag:output_type -> GetPostsByTagResponse
+	14,  // 143: Posts.GetTagsFeed:output_type -> GetTagsFeedResponse
+	10,  // 144: Posts.GetMentions:output_type -> GetMentionsResponse
+	5,   // 145: Posts.VotePoll:output_type -> VotePollResponse
+	7,   // 146: Posts.RetractVote:output_type -> RetractVoteResponse
+	64,  // 147: Posts.DeletePost:output_type -> DeletePostResponse
+	66,  // 148: Posts.SearchPosts:output_type -> SearchPostsResponse
+	68,  // 149: Posts.GetTrendingPosts:output_type -> GetTrendingPostsResponse
+	70,  // 150: Posts.GetRankedFeed:output_type -> GetRankedFeedResponse
+	72,  // 151: Posts.MarkSeen:output_type -> MarkSeenResponse
+	74,  // 152: Posts.GetNewPostsCount:output_type -> GetNewPostsCountResponse
+	78,  // 153: Posts.GetPostStats:output_type -> GetPostStatsResponse
+	81,  // 154: Posts.GetAuthorStats:output_type -> GetAuthorStatsResponse
+	83,  // 155: Posts.SubscribeFeed:output_type -> SubscribeFeedResponse
+	85,  // 156: Posts.SubscribePostComments:output_type -> SubscribePostCommentsResponse
+	125, // [125:157] is the sub-list for method output_type
+	93,  // [93:125] is the sub-list for method input_type
+	93,  // [93:93] is the sub-list for extension type_name
+	93,  // [93:93] is the sub-list for extension extendee
+	0,   // [0:93] is the sub-list for field type_name
+}
+
+func init() { file_posts_proto_init() }
+func file_posts_proto_init() {
+	if File_posts_proto != nil {
+		return
+	}
+	file_storage_proto_init()
+	file_users_proto_init()
+	file_linkedacc_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_posts_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VotePollRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_posts_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VotePollResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_posts_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RetractVoteRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_posts_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RetractVoteResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_posts_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMentionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_posts_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MentionItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_posts_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMentionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_posts_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPostsByTagRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_posts_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPostsByTagResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_posts_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTagsFeedRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_posts_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTagsFeedResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_posts_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Audience); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_posts_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateAudienceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_posts_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateAudienceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_posts_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddToAudienceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_posts_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddToAudienceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_posts_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAudiencesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_posts_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAudiencesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_posts_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Draft); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_posts_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SaveDraftRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_posts_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SaveDraftResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_posts_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListDraftsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_posts_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListDraftsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_posts_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDraftRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_posts_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDraftResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_posts_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteDraftRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_posts_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteDraftResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_posts_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PublishDraftRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_posts_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PublishDraftResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_posts_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPostByIdRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_posts_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPostByIdResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_posts_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdatePostRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_posts_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AttachmentIds); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_posts_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdatePostResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_posts_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCommentsListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_posts_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCommentsListResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_posts_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Comment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_posts_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TextEntity); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_posts_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Mention); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_posts_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WriteCommentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_posts_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WriteCommentResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_posts_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LikesInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_posts_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommentsInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_posts_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Post); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_posts_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PollOption); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_posts_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Poll); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_posts_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NewPoll); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_posts_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LinkPreview); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_posts_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Hashtag); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_posts_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NewPostRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_posts_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NewPostResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_posts_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPostsListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_posts_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPostsListResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_posts_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPostsUserRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_posts_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPostsUserResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_posts_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddLikeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_posts_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddLikeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_posts_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteLikeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_posts_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteLikeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use DeleteLikeRequest.ProtoReflect.Descriptor instead.
-func (*DeleteLikeRequest) Descriptor() ([]byte, []int) {
-	return file_posts_proto_rawDescGZIP(), []int{20}
-}
-
-func (x *DeleteLikeRequest) GetPostId() uint64 {
-	if x != nil {
-		return x.PostId
-	}
-	return 0
-}
-
-type DeleteLikeResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-}
-
-func (x *DeleteLikeResponse) Reset() {
-	*x = DeleteLikeResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_posts_proto_msgTypes[21]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *DeleteLikeResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*DeleteLikeResponse) ProtoMessage() {}
-
-func (x *DeleteLikeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_posts_proto_msgTypes[21]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
+		file_posts_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeletePostRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use DeleteLikeResponse.ProtoReflect.Descriptor instead.
-func (*DeleteLikeResponse) Descriptor() ([]byte, []int) {
-	return file_posts_proto_rawDescGZIP(), []int{21}
-}
-
-var File_posts_proto protoreflect.FileDescriptor
-
-var file_posts_proto_rawDesc = []byte{
-	0x0a, 0x0b, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0d, 0x73,
-	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0b, 0x75, 0x73,
-	0x65, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0f, 0x6c, 0x69, 0x6e, 0x6b, 0x65,
-	0x64, 0x61, 0x63, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x9b, 0x02, 0x0a, 0x12, 0x47, 0x65,
-	0x74, 0x50, 0x6f, 0x73, 0x74, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64,
-	0x12, 0x1a, 0x0a, 0x08, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x08, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x12, 0x2b, 0x0a, 0x11,
-	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65,
-	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
-	0x73, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x6d,
-	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x0d, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74,
-	0x12, 0x34, 0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x66, 0x69, 0x65,
-	0x6c, 0x64, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x0b, 0x2e, 0x55, 0x73, 0x65, 0x72,
-	0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x52, 0x0e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73,
-	0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
-	0x74, 0x73, 0x5f, 0x73, 0x6f, 0x72, 0x74, 0x5f, 0x64, 0x69, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x0f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x53, 0x6f, 0x72, 0x74, 0x44,
-	0x69, 0x72, 0x12, 0x23, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x08, 0x20, 0x03,
-	0x28, 0x0e, 0x32, 0x0b, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x52,
-	0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x22, 0x30, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x50, 0x6f,
-	0x73, 0x74, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x19,
-	0x0a, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x50,
-	0x6f, 0x73, 0x74, 0x52, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x22, 0x2c, 0x0a, 0x11, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17,
-	0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x22, 0x2f, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x19, 0x0a,
-	0x04, 0x70, 0x6f, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x50, 0x6f,
-	0x73, 0x74, 0x52, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x22, 0xbc, 0x01, 0x0a, 0x16, 0x47, 0x65, 0x74,
-	0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05,
-	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x69, 0x6d,
-	0x69, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x06, 0x6c, 0x61, 0x73, 0x74, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x65,
-	0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x65,
-	0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x6f, 0x72, 0x74, 0x5f,
-	0x64, 0x69, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x6f, 0x72, 0x74, 0x44,
-	0x69, 0x72, 0x12, 0x23, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x06, 0x20, 0x03,
-	0x28, 0x0e, 0x32, 0x0b, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x52,
-	0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x22, 0x3f, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x43, 0x6f,
-	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x24, 0x0a, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x08,
-	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0xe3, 0x01, 0x0a, 0x07, 0x43, 0x6f, 0x6d,
-	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x19, 0x0a,
-	0x08, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x07, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x12, 0x2d, 0x0a, 0x0b, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74,
-	0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68,
-	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74,
-	0x73, 0x12, 0x2e, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x74, 0x69, 0x6d,
-	0x65, 0x12, 0x1b, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x05, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x22, 0x81,
-	0x01, 0x0a, 0x13, 0x57, 0x72, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12,
-	0x1a, 0x0a, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x61, 0x67, 0x65, 0x12, 0x35, 0x0a, 0x0e, 0x61,
-	0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x49, 0x64, 0x73, 0x18, 0x03, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74,
-	0x49, 0x64, 0x52, 0x0e, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x49,
-	0x64, 0x73, 0x22, 0x3a, 0x0a, 0x14, 0x57, 0x72, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
-	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x07, 0x63, 0x6f,
-	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x43, 0x6f,
-	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x55,
-	0x0a, 0x09, 0x4c, 0x69, 0x6b, 0x65, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x19, 0x0a, 0x05, 0x6c,
-	0x69, 0x6b, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x05, 0x6c, 0x69,
-	0x6b, 0x65, 0x64, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x03, 0x48, 0x01, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x88, 0x01,
-	0x01, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x6c, 0x69, 0x6b, 0x65, 0x64, 0x42, 0x08, 0x0a, 0x06, 0x5f,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x53, 0x0a, 0x0c, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
-	0x73, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x19, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x88, 0x01, 0x01,
-	0x12, 0x1e, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x08, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73,
-	0x42, 0x08, 0x0a, 0x06, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x94, 0x02, 0x0a, 0x04, 0x50,
-	0x6f, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x02, 0x69, 0x64, 0x12, 0x2e, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x74,
-	0x69, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x12, 0x18,
-	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x2d, 0x0a, 0x0b, 0x61, 0x74, 0x74, 0x61,
-	0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e,
-	0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x61, 0x74, 0x74, 0x61,
-	0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x20, 0x0a, 0x05, 0x6c, 0x69, 0x6b, 0x65, 0x73,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x4c, 0x69, 0x6b, 0x65, 0x73, 0x49, 0x6e,
-	0x66, 0x6f, 0x52, 0x05, 0x6c, 0x69, 0x6b, 0x65, 0x73, 0x12, 0x29, 0x0a, 0x08, 0x63, 0x6f, 0x6d,
-	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x43, 0x6f,
-	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x63, 0x6f, 0x6d, 0x6d,
-	0x65, 0x6e, 0x74, 0x73, 0x12, 0x1b, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x08, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65,
-	0x72, 0x22, 0x99, 0x01, 0x0a, 0x0e, 0x4e, 0x65, 0x77, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x35, 0x0a, 0x0e, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65,
-	0x6e, 0x74, 0x73, 0x49, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x41,
-	0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x52, 0x0e, 0x61, 0x74, 0x74,
-	0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x49, 0x64, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x36, 0x0a, 0x0d, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x61,
-	0x63, 0x63, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x4c,
-	0x69, 0x6e, 0x6b, 0x65, 0x64, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x70, 0x52,
-	0x0c, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x61, 0x63, 0x63, 0x49, 0x64, 0x73, 0x22, 0x2c, 0x0a,
-	0x0f, 0x4e, 0x65, 0x77, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x19, 0x0a, 0x04, 0x50, 0x6f, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x05,
-	0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x04, 0x50, 0x6f, 0x73, 0x74, 0x22, 0xbb, 0x02, 0x0a, 0x13,
-	0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x6c, 0x61, 0x73,
-	0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6c, 0x61, 0x73, 0x74,
-	0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x12, 0x2b,
-	0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x65, 0x78, 0x74, 0x65, 0x6e,
-	0x64, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
-	0x6e, 0x74, 0x73, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x63,
-	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x0d, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x4c, 0x69, 0x6d,
-	0x69, 0x74, 0x12, 0x34, 0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x66,
-	0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x0b, 0x2e, 0x55, 0x73,
-	0x65, 0x72, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x52, 0x0e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
-	0x74, 0x73, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x6d,
-	0x65, 0x6e, 0x74, 0x73, 0x5f, 0x73, 0x6f, 0x72, 0x74, 0x5f, 0x64, 0x69, 0x72, 0x18, 0x07, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x0f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x53, 0x6f, 0x72,
-	0x74, 0x44, 0x69, 0x72, 0x12, 0x23, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x08,
-	0x20, 0x03, 0x28, 0x0e, 0x32, 0x0b, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x46, 0x69, 0x65, 0x6c, 0x64,
-	0x73, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x22, 0x33, 0x0a, 0x14, 0x47, 0x65, 0x74,
-	0x50, 0x6f, 0x73, 0x74, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x1b, 0x0a, 0x05, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x05, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x05, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x22, 0xd4,
-	0x02, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x55, 0x73, 0x65, 0x72, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x17, 0x0a, 0x07,
-	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75,
-	0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x69, 0x64,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6c, 0x61, 0x73, 0x74, 0x49, 0x64, 0x12, 0x1a,
-	0x0a, 0x08, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x08, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x12, 0x2b, 0x0a, 0x11, 0x63, 0x6f,
-	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x45,
-	0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
-	0x6e, 0x74, 0x73, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x0d, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x34,
-	0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64,
-	0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x0b, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x46, 0x69,
-	0x65, 0x6c, 0x64, 0x73, 0x52, 0x0e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x46, 0x69,
-	0x65, 0x6c, 0x64, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73,
-	0x5f, 0x73, 0x6f, 0x72, 0x74, 0x5f, 0x64, 0x69, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x0f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x53, 0x6f, 0x72, 0x74, 0x44, 0x69, 0x72,
-	0x12, 0x23, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0e,
-	0x32, 0x0b, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x52, 0x06, 0x66,
-	0x69, 0x65, 0x6c, 0x64, 0x73, 0x22, 0x33, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74,
-	0x73, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a,
-	0x05, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x50,
-	0x6f, 0x73, 0x74, 0x52, 0x05, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x22, 0x29, 0x0a, 0x0e, 0x41, 0x64,
-	0x64, 0x4c, 0x69, 0x6b, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07,
-	0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x70,
-	0x6f, 0x73, 0x74, 0x49, 0x64, 0x22, 0x11, 0x0a, 0x0f, 0x41, 0x64, 0x64, 0x4c, 0x69, 0x6b, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2c, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x4c, 0x69, 0x6b, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a,
-	0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06,
-	0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x22, 0x14, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x4c, 0x69, 0x6b, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x90, 0x06, 0x0a,
-	0x05, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x47, 0x0a, 0x07, 0x4e, 0x65, 0x77, 0x50, 0x6f, 0x73,
-	0x74, 0x12, 0x0f, 0x2e, 0x4e, 0x65, 0x77, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x10, 0x2e, 0x4e, 0x65, 0x77, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x3a, 0x01, 0x2a, 0x22,
-	0x0e, 0x2f, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x2f, 0x4e, 0x65, 0x77, 0x50, 0x6f, 0x73, 0x74, 0x12,
-	0x58, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x12,
-	0x14, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x73,
-	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1b, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x15, 0x12, 0x13, 0x2f, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x2f, 0x47, 0x65, 0x74,
-	0x50, 0x6f, 0x73, 0x74, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x58, 0x0a, 0x0c, 0x47, 0x65, 0x74,
-	0x50, 0x6f, 0x73, 0x74, 0x73, 0x55, 0x73, 0x65, 0x72, 0x12, 0x14, 0x2e, 0x47, 0x65, 0x74, 0x50,
-	0x6f, 0x73, 0x74, 0x73, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x15, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x12, 0x13,
-	0x2f, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x2f, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x55,
-	0x73, 0x65, 0x72, 0x12, 0x47, 0x0a, 0x07, 0x41, 0x64, 0x64, 0x4c, 0x69, 0x6b, 0x65, 0x12, 0x0f,
-	0x2e, 0x41, 0x64, 0x64, 0x4c, 0x69, 0x6b, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x10, 0x2e, 0x41, 0x64, 0x64, 0x4c, 0x69, 0x6b, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x3a, 0x01, 0x2a, 0x22, 0x0e, 0x2f, 0x50,
-	0x6f, 0x73, 0x74, 0x73, 0x2f, 0x41, 0x64, 0x64, 0x4c, 0x69, 0x6b, 0x65, 0x12, 0x53, 0x0a, 0x0a,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4c, 0x69, 0x6b, 0x65, 0x12, 0x12, 0x2e, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x4c, 0x69, 0x6b, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13,
-	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4c, 0x69, 0x6b, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x3a, 0x01, 0x2a, 0x22, 0x11,
-	0x2f, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x2f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4c, 0x69, 0x6b,
-	0x65, 0x12, 0x5b, 0x0a, 0x0c, 0x57, 0x72, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
-	0x74, 0x12, 0x14, 0x2e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x43,
-	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1e,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x3a, 0x01, 0x2a, 0x22, 0x13, 0x2f, 0x50, 0x6f, 0x73, 0x74,
-	0x73, 0x2f, 0x57, 0x72, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x64,
-	0x0a, 0x0f, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x4c, 0x69, 0x73,
-	0x74, 0x12, 0x17, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x4c,
-	0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x47, 0x65, 0x74,
-	0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x12, 0x16, 0x2f, 0x50,
-	0x6f, 0x73, 0x74, 0x73, 0x2f, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73,
-	0x4c, 0x69, 0x73, 0x74, 0x12, 0x53, 0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6f,
-	0x73, 0x74, 0x12, 0x12, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50,
-	0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1c, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x16, 0x3a, 0x01, 0x2a, 0x22, 0x11, 0x2f, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x2f, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x54, 0x0a, 0x0b, 0x47, 0x65, 0x74,
-	0x50, 0x6f, 0x73, 0x74, 0x42, 0x79, 0x49, 0x64, 0x12, 0x13, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f,
-	0x73, 0x74, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e,
-	0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x12, 0x12, 0x2f, 0x50, 0x6f,
-	0x73, 0x74, 0x73, 0x2f, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x42, 0x79, 0x49, 0x64, 0x42,
-	0x06, 0x5a, 0x04, 0x2e, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
-}
-
-var (
-	file_posts_proto_rawDescOnce sync.Once
-	file_posts_proto_rawDescData = file_posts_proto_rawDesc
-)
-
-func file_posts_proto_rawDescGZIP() []byte {
-	file_posts_proto_rawDescOnce.Do(func() {
-		file_posts_proto_rawDescData = protoimpl.X.CompressGZIP(file_posts_proto_rawDescData)
-	})
-	return file_posts_proto_rawDescData
-}
-
-var file_posts_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
-var file_posts_proto_goTypes = []interface{}{
-	(*GetPostByIdRequest)(nil),      // 0: GetPostByIdRequest
-	(*GetPostByIdResponse)(nil),     // 1: GetPostByIdResponse
-	(*UpdatePostRequest)(nil),       // 2: UpdatePostRequest
-	(*UpdatePostResponse)(nil),      // 3: UpdatePostResponse
-	(*GetCommentsListRequest)(nil),  // 4: GetCommentsListRequest
-	(*GetCommentsListResponse)(nil), // 5: GetCommentsListResponse
-	(*Comment)(nil),                 // 6: Comment
-	(*WriteCommentRequest)(nil),     // 7: WriteCommentRequest
-	(*WriteCommentResponse)(nil),    // 8: WriteCommentResponse
-	(*LikesInfo)(nil),               // 9: LikesInfo
-	(*CommentsInfo)(nil),            // 10: CommentsInfo
-	(*Post)(nil),                    // 11: Post
-	(*NewPostRequest)(nil),          // 12: NewPostRequest
-	(*NewPostResponse)(nil),         // 13: NewPostResponse
-	(*GetPostsListRequest)(nil),     // 14: GetPostsListRequest
-	(*GetPostsListResponse)(nil),    // 15: GetPostsListResponse
-	(*GetPostsUserRequest)(nil),     // 16: GetPostsUserRequest
-	(*GetPostsUserResponse)(nil),    // 17: GetPostsUserResponse
-	(*AddLikeRequest)(nil),          // 18: AddLikeRequest
-	(*AddLikeResponse)(nil),         // 19: AddLikeResponse
-	(*DeleteLikeRequest)(nil),       // 20: DeleteLikeRequest
-	(*DeleteLikeResponse)(nil),      // 21: DeleteLikeResponse
-	(UserFields)(0),                 // 22: UserFields
-	(*Attachment)(nil),              // 23: Attachment
-	(*timestamppb.Timestamp)(nil),   // 24: google.protobuf.Timestamp
-	(*User)(nil),                    // 25: User
-	(*AttachmentId)(nil),            // 26: AttachmentId
-	(*LinkedAccountInp)(nil),        // 27: LinkedAccountInp
-}
-var file_posts_proto_depIdxs = []int32{
-	22, // 0: GetPostByIdRequest.comments_fields:type_name -> UserFields
-	22, // 1: GetPostByIdRequest.fields:type_name -> UserFields
-	11, // 2: GetPostByIdResponse.post:type_name -> Post
-	11, // 3: UpdatePostResponse.post:type_name -> Post
-	22, // 4: GetCommentsListRequest.fields:type_name -> UserFields
-	6,  // 5: GetCommentsListResponse.comments:type_name -> Comment
-	23, // 6: Comment.attachments:type_name -> Attachment
-	24, // 7: Comment.time:type_name -> google.protobuf.Timestamp
-	25, // 8: Comment.owner:type_name -> User
-	26, // 9: WriteCommentRequest.attachmentsIds:type_name -> AttachmentId
-	6,  // 10: WriteCommentResponse.comment:type_name -> Comment
-	6,  // 11: CommentsInfo.items:type_name -> Comment
-	24, // 12: Post.time:type_name -> google.protobuf.Timestamp
-	23, // 13: Post.attachments:type_name -> Attachment
-	9,  // 14: Post.likes:type_name -> LikesInfo
-	10, // 15: Post.comments:type_name -> CommentsInfo
-	25, // 16: Post.owner:type_name -> User
-	26, // 17: NewPostRequest.attachmentsIds:type_name -> AttachmentId
-	27, // 18: NewPostRequest.linkedacc_ids:type_name -> LinkedAccountInp
-	11, // 19: NewPostResponse.Post:type_name -> Post
-	22, // 20: GetPostsListRequest.comments_fields:type_name -> UserFields
-	22, // 21: GetPostsListRequest.fields:type_name -> UserFields
-	11, // 22: GetPostsListResponse.posts:type_name -> Post
-	22, // 23: GetPostsUserRequest.comments_fields:type_name -> UserFields
-	22, // 24: GetPostsUserRequest.fields:type_name -> UserFields
-	11, // 25: GetPostsUserResponse.posts:type_name -> Post
-	12, // 26: Posts.NewPost:input_type -> NewPostRequest
-	14, // 27: Posts.GetPostsList:input_type -> GetPostsListRequest
-	16, // 28: Posts.GetPostsUser:input_type -> GetPostsUserRequest
-	18, // 29: Posts.AddLike:input_type -> AddLikeRequest
-	20, // 30: Posts.DeleteLike:input_type -> DeleteLikeRequest
-	7,  // 31: Posts.WriteComment:input_type -> WriteCommentRequest
-	4,  // 32: Posts.GetCommentsList:input_type -> GetCommentsListRequest
-	2,  // 33: Posts.UpdatePost:input_type -> UpdatePostRequest
-	0,  // 34: Posts.GetPostById:input_type -> GetPostByIdRequest
-	13, // 35: Posts.NewPost:output_type -> NewPostResponse
-	15, // 36: Posts.GetPostsList:output_type -> GetPostsListResponse
-	17, // 37: Posts.GetPostsUser:output_type -> GetPostsUserResponse
-	19, // 38: Posts.AddLike:output_type -> AddLikeResponse
-	21, // 39: Posts.DeleteLike:output_type -> DeleteLikeResponse
-	8,  // 40: Posts.WriteComment:output_type -> WriteCommentResponse
-	5,  // 41: Posts.GetCommentsList:output_type -> GetCommentsListResponse
-	3,  // 42: Posts.UpdatePost:output_type -> UpdatePostResponse
-	1,  // 43: Posts.GetPostById:output_type -> GetPostByIdResponse
-	35, // [35:44] is the sub-list for method output_type
-	26, // [26:35] is the sub-list for method input_type
-	26, // [26:26] is the sub-list for extension type_name
-	26, // [26:26] is the sub-list for extension extendee
-	0,  // [0:26] is the sub-list for field type_name
-}
-
-func init() { file_posts_proto_init() }
-func file_posts_proto_init() {
-	if File_posts_proto != nil {
-		return
-	}
-	file_storage_proto_init()
-	file_users_proto_init()
-	file_linkedacc_proto_init()
-	if !protoimpl.UnsafeEnabled {
-		file_posts_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPostByIdRequest); i {
+		file_posts_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeletePostResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1769,8 +7550,8 @@ func file_posts_proto_init() {
 				return nil
 			}
 		}
-		file_posts_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPostByIdResponse); i {
+		file_posts_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchPostsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1781,8 +7562,8 @@ func file_posts_proto_init() {
 				return nil
 			}
 		}
-		file_posts_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdatePostRequest); i {
+		file_posts_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchPostsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1793,8 +7574,8 @@ func file_posts_proto_init() {
 				return nil
 			}
 		}
-		file_posts_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdatePostResponse); i {
+		file_posts_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTrendingPostsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1805,8 +7586,8 @@ func file_posts_proto_init() {
 				return nil
 			}
 		}
-		file_posts_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetCommentsListRequest); i {
+		file_posts_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTrendingPostsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1817,8 +7598,8 @@ func file_posts_proto_init() {
 				return nil
 			}
 		}
-		file_posts_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetCommentsListResponse); i {
+		file_posts_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRankedFeedRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1829,8 +7610,8 @@ func file_posts_proto_init() {
 				return nil
 			}
 		}
-		file_posts_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Comment); i {
+		file_posts_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRankedFeedResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1841,8 +7622,8 @@ func file_posts_proto_init() {
 				return nil
 			}
 		}
-		file_posts_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WriteCommentRequest); i {
+		file_posts_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MarkSeenRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1853,8 +7634,8 @@ func file_posts_proto_init() {
 				return nil
 			}
 		}
-		file_posts_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WriteCommentResponse); i {
+		file_posts_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MarkSeenResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1865,8 +7646,8 @@ func file_posts_proto_init() {
 				return nil
 			}
 		}
-		file_posts_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LikesInfo); i {
+		file_posts_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetNewPostsCountRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1877,8 +7658,8 @@ func file_posts_proto_init() {
 				return nil
 			}
 		}
-		file_posts_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CommentsInfo); i {
+		file_posts_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetNewPostsCountResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1889,8 +7670,8 @@ func file_posts_proto_init() {
 				return nil
 			}
 		}
-		file_posts_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Post); i {
+		file_posts_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ViewsInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1901,8 +7682,8 @@ func file_posts_proto_init() {
 				return nil
 			}
 		}
-		file_posts_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NewPostRequest); i {
+		file_posts_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatsPoint); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1913,8 +7694,8 @@ func file_posts_proto_init() {
 				return nil
 			}
 		}
-		file_posts_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NewPostResponse); i {
+		file_posts_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPostStatsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1925,8 +7706,8 @@ func file_posts_proto_init() {
 				return nil
 			}
 		}
-		file_posts_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPostsListRequest); i {
+		file_posts_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPostStatsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1937,8 +7718,8 @@ func file_posts_proto_init() {
 				return nil
 			}
 		}
-		file_posts_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPostsListResponse); i {
+		file_posts_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAuthorStatsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1949,8 +7730,8 @@ func file_posts_proto_init() {
 				return nil
 			}
 		}
-		file_posts_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPostsUserRequest); i {
+		file_posts_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HourStats); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1961,8 +7742,8 @@ func file_posts_proto_init() {
 				return nil
 			}
 		}
-		file_posts_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPostsUserResponse); i {
+		file_posts_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAuthorStatsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1973,8 +7754,8 @@ func file_posts_proto_init() {
 				return nil
 			}
 		}
-		file_posts_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddLikeRequest); i {
+		file_posts_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeFeedRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1985,8 +7766,8 @@ func file_posts_proto_init() {
 				return nil
 			}
 		}
-		file_posts_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddLikeResponse); i {
+		file_posts_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeFeedResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1997,8 +7778,8 @@ func file_posts_proto_init() {
 				return nil
 			}
 		}
-		file_posts_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteLikeRequest); i {
+		file_posts_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribePostCommentsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2009,8 +7790,8 @@ func file_posts_proto_init() {
 				return nil
 			}
 		}
-		file_posts_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteLikeResponse); i {
+		file_posts_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribePostCommentsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2022,20 +7803,23 @@ func file_posts_proto_init() {
 			}
 		}
 	}
-	file_posts_proto_msgTypes[9].OneofWrappers = []interface{}{}
-	file_posts_proto_msgTypes[10].OneofWrappers = []interface{}{}
+	file_posts_proto_msgTypes[31].OneofWrappers = []interface{}{}
+	file_posts_proto_msgTypes[41].OneofWrappers = []interface{}{}
+	file_posts_proto_msgTypes[42].OneofWrappers = []interface{}{}
+	file_posts_proto_msgTypes[77].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_posts_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   22,
+			NumEnums:      4,
+			NumMessages:   82,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_posts_proto_goTypes,
 		DependencyIndexes: file_posts_proto_depIdxs,
+		EnumInfos:         file_posts_proto_enumTypes,
 		MessageInfos:      file_posts_proto_msgTypes,
 	}.Build()
 	File_posts_proto = out.File
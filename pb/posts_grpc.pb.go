@@ -19,15 +19,38 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Posts_NewPost_FullMethodName         = "/Posts/NewPost"
-	Posts_GetPostsList_FullMethodName    = "/Posts/GetPostsList"
-	Posts_GetPostsUser_FullMethodName    = "/Posts/GetPostsUser"
-	Posts_AddLike_FullMethodName         = "/Posts/AddLike"
-	Posts_DeleteLike_FullMethodName      = "/Posts/DeleteLike"
-	Posts_WriteComment_FullMethodName    = "/Posts/WriteComment"
-	Posts_GetCommentsList_FullMethodName = "/Posts/GetCommentsList"
-	Posts_UpdatePost_FullMethodName      = "/Posts/UpdatePost"
-	Posts_GetPostById_FullMethodName     = "/Posts/GetPostById"
+	Posts_NewPost_FullMethodName               = "/Posts/NewPost"
+	Posts_GetPostsList_FullMethodName          = "/Posts/GetPostsList"
+	Posts_GetPostsUser_FullMethodName          = "/Posts/GetPostsUser"
+	Posts_AddLike_FullMethodName               = "/Posts/AddLike"
+	Posts_DeleteLike_FullMethodName            = "/Posts/DeleteLike"
+	Posts_WriteComment_FullMethodName          = "/Posts/WriteComment"
+	Posts_GetCommentsList_FullMethodName       = "/Posts/GetCommentsList"
+	Posts_UpdatePost_FullMethodName            = "/Posts/UpdatePost"
+	Posts_GetPostById_FullMethodName           = "/Posts/GetPostById"
+	Posts_SaveDraft_FullMethodName             = "/Posts/SaveDraft"
+	Posts_ListDrafts_FullMethodName            = "/Posts/ListDrafts"
+	Posts_GetDraft_FullMethodName              = "/Posts/GetDraft"
+	Posts_DeleteDraft_FullMethodName           = "/Posts/DeleteDraft"
+	Posts_PublishDraft_FullMethodName          = "/Posts/PublishDraft"
+	Posts_CreateAudience_FullMethodName        = "/Posts/CreateAudience"
+	Posts_AddToAudience_FullMethodName         = "/Posts/AddToAudience"
+	Posts_ListAudiences_FullMethodName         = "/Posts/ListAudiences"
+	Posts_GetPostsByTag_FullMethodName         = "/Posts/GetPostsByTag"
+	Posts_GetTagsFeed_FullMethodName           = "/Posts/GetTagsFeed"
+	Posts_GetMentions_FullMethodName           = "/Posts/GetMentions"
+	Posts_VotePoll_FullMethodName              = "/Posts/VotePoll"
+	Posts_RetractVote_FullMethodName           = "/Posts/RetractVote"
+	Posts_DeletePost_FullMethodName            = "/Posts/DeletePost"
+	Posts_SearchPosts_FullMethodName           = "/Posts/SearchPosts"
+	Posts_GetTrendingPosts_FullMethodName      = "/Posts/GetTrendingPosts"
+	Posts_GetRankedFeed_FullMethodName         = "/Posts/GetRankedFeed"
+	Posts_MarkSeen_FullMethodName              = "/Posts/MarkSeen"
+	Posts_GetNewPostsCount_FullMethodName      = "/Posts/GetNewPostsCount"
+	Posts_GetPostStats_FullMethodName          = "/Posts/GetPostStats"
+	Posts_GetAuthorStats_FullMethodName        = "/Posts/GetAuthorStats"
+	Posts_SubscribeFeed_FullMethodName         = "/Posts/SubscribeFeed"
+	Posts_SubscribePostComments_FullMethodName = "/Posts/SubscribePostComments"
 )
 
 // PostsClient is the client API for Posts service.
@@ -45,6 +68,7 @@ type PostsClient interface {
 	// GetPostsUser
 	//
 	// Возвращает список постов пользователя. Отсортирован по дате. Сначала новые.
+	// Доступно без авторизации, гостю возвращаются только публичные посты, user_id обязателен.
 	GetPostsUser(ctx context.Context, in *GetPostsUserRequest, opts ...grpc.CallOption) (*GetPostsUserResponse, error)
 	// AddLike
 	//
@@ -61,15 +85,116 @@ type PostsClient interface {
 	// GetCommentsList
 	//
 	// Возвращает список комментариев под постом. Отсортирован по дате. Направление сортировки зависит от параметра sort_dir. false - сначала новые (по умолчанию), true - сначала старые.
+	// Доступно без авторизации для публичных постов.
 	GetCommentsList(ctx context.Context, in *GetCommentsListRequest, opts ...grpc.CallOption) (*GetCommentsListResponse, error)
 	// UpdatePost
 	//
-	// Изменяет пост. (Не реализовано).
+	// Изменяет сообщение и вложения поста. Изменять пост может только его владелец.
 	UpdatePost(ctx context.Context, in *UpdatePostRequest, opts ...grpc.CallOption) (*UpdatePostResponse, error)
 	// GetPostById
 	//
-	// Получить пост по id
+	// Получить пост по id. Доступно без авторизации, гостю возвращаются только публичные посты.
 	GetPostById(ctx context.Context, in *GetPostByIdRequest, opts ...grpc.CallOption) (*GetPostByIdResponse, error)
+	// SaveDraft
+	//
+	// Сохраняет черновик поста. Если id не задан, создает новый черновик, иначе перезаписывает существующий.
+	SaveDraft(ctx context.Context, in *SaveDraftRequest, opts ...grpc.CallOption) (*SaveDraftResponse, error)
+	// ListDrafts
+	//
+	// Возвращает список черновиков текущего пользователя. Сначала новые.
+	ListDrafts(ctx context.Context, in *ListDraftsRequest, opts ...grpc.CallOption) (*ListDraftsResponse, error)
+	// GetDraft
+	//
+	// Возвращает черновик по id.
+	GetDraft(ctx context.Context, in *GetDraftRequest, opts ...grpc.CallOption) (*GetDraftResponse, error)
+	// DeleteDraft
+	//
+	// Удаляет черновик.
+	DeleteDraft(ctx context.Context, in *DeleteDraftRequest, opts ...grpc.CallOption) (*DeleteDraftResponse, error)
+	// PublishDraft
+	//
+	// Публикует черновик как новый пост. Проверки такие же, как в NewPost. После публикации черновик удаляется.
+	PublishDraft(ctx context.Context, in *PublishDraftRequest, opts ...grpc.CallOption) (*PublishDraftResponse, error)
+	// CreateAudience
+	//
+	// Создает список пользователей (например, близкие друзья), которым можно адресовать посты.
+	CreateAudience(ctx context.Context, in *CreateAudienceRequest, opts ...grpc.CallOption) (*CreateAudienceResponse, error)
+	// AddToAudience
+	//
+	// Добавляет пользователей в список.
+	AddToAudience(ctx context.Context, in *AddToAudienceRequest, opts ...grpc.CallOption) (*AddToAudienceResponse, error)
+	// ListAudiences
+	//
+	// Возвращает списки текущего пользователя.
+	ListAudiences(ctx context.Context, in *ListAudiencesRequest, opts ...grpc.CallOption) (*ListAudiencesResponse, error)
+	// GetPostsByTag
+	//
+	// Возвращает список постов с хештегом. Отсортирован по дате. Сначала новые.
+	GetPostsByTag(ctx context.Context, in *GetPostsByTagRequest, opts ...grpc.CallOption) (*GetPostsByTagResponse, error)
+	// GetTagsFeed
+	//
+	// Возвращает список постов с хештегами, совпадающими с тегами профиля текущего пользователя. Отсортирован по дате. Сначала новые.
+	GetTagsFeed(ctx context.Context, in *GetTagsFeedRequest, opts ...grpc.CallOption) (*GetTagsFeedResponse, error)
+	// GetMentions
+	//
+	// Возвращает посты и комментарии, в которых упомянут текущий пользователь. Отсортирован по дате. Сначала новые.
+	GetMentions(ctx context.Context, in *GetMentionsRequest, opts ...grpc.CallOption) (*GetMentionsResponse, error)
+	// VotePoll
+	//
+	// Голосует в опросе поста. Проголосовать можно один раз, чтобы изменить голос, его нужно отменить.
+	VotePoll(ctx context.Context, in *VotePollRequest, opts ...grpc.CallOption) (*VotePollResponse, error)
+	// RetractVote
+	//
+	// Отменяет голос в опросе поста.
+	RetractVote(ctx context.Context, in *RetractVoteRequest, opts ...grpc.CallOption) (*RetractVoteResponse, error)
+	// DeletePost
+	//
+	// Удаляет пост вместе с комментариями, лайками и опросом. Удалить пост может только его владелец.
+	DeletePost(ctx context.Context, in *DeletePostRequest, opts ...grpc.CallOption) (*DeletePostResponse, error)
+	// SearchPosts
+	//
+	// Полнотекстовый поиск по постам и комментариям. Возвращает посты, в тексте которых или в тексте комментариев к которым
+	// встречаются все слова запроса. Отсортирован по дате найденного поста или комментария. Сначала новые.
+	SearchPosts(ctx context.Context, in *SearchPostsRequest, opts ...grpc.CallOption) (*SearchPostsResponse, error)
+	// GetTrendingPosts
+	//
+	// Возвращает популярные публичные посты за последнее время. Отсортирован по убыванию рейтинга, который складывается
+	// из лайков и комментариев и уменьшается с возрастом поста. Рейтинг периодически пересчитывается.
+	GetTrendingPosts(ctx context.Context, in *GetTrendingPostsRequest, opts ...grpc.CallOption) (*GetTrendingPostsResponse, error)
+	// GetRankedFeed
+	//
+	// Возвращает ленту постов за последние дни, отсортированную по интересу для текущего пользователя: учитываются
+	// свежесть поста, лайки и комментарии, а также то, как часто пользователь лайкает и комментирует автора и хештеги поста.
+	GetRankedFeed(ctx context.Context, in *GetRankedFeedRequest, opts ...grpc.CallOption) (*GetRankedFeedResponse, error)
+	// MarkSeen
+	//
+	// Отмечает посты как просмотренные текущим пользователем. Отметки хранятся 30 дней.
+	MarkSeen(ctx context.Context, in *MarkSeenRequest, opts ...grpc.CallOption) (*MarkSeenResponse, error)
+	// GetNewPostsCount
+	//
+	// Возвращает количество новых постов в ленте GetPostsList, которые появились после самого нового просмотренного поста.
+	// Собственные посты пользователя не учитываются.
+	GetNewPostsCount(ctx context.Context, in *GetNewPostsCountRequest, opts ...grpc.CallOption) (*GetNewPostsCountResponse, error)
+	// GetPostStats
+	//
+	// Возвращает статистику просмотров, лайков и комментариев поста по часам или по дням. Доступно только владельцу поста.
+	GetPostStats(ctx context.Context, in *GetPostStatsRequest, opts ...grpc.CallOption) (*GetPostStatsResponse, error)
+	// GetAuthorStats
+	//
+	// Возвращает статистику постов текущего пользователя за промежуток времени: количество постов, вовлеченность,
+	// лучшие посты и часы публикации, а также связь вовлеченности с ростом числа подписчиков.
+	GetAuthorStats(ctx context.Context, in *GetAuthorStatsRequest, opts ...grpc.CallOption) (*GetAuthorStatsResponse, error)
+	// SubscribeFeed
+	//
+	// Подписывается на обновления ленты: новые посты, видимые пользователю, и изменения количества лайков этих постов.
+	// Приходят только события после подписки, пропущенные посты загружаются через GetPostsList.
+	// Лайки приходят только для постов, уже отправленных в этом потоке, лайки остальных постов отслеживаются через SubscribePostComments.
+	SubscribeFeed(ctx context.Context, in *SubscribeFeedRequest, opts ...grpc.CallOption) (Posts_SubscribeFeedClient, error)
+	// SubscribePostComments
+	//
+	// Подписывается на новые комментарии поста и изменения количества лайков. Поток завершается с ошибкой,
+	// если пост удален.
+	SubscribePostComments(ctx context.Context, in *SubscribePostCommentsRequest, opts ...grpc.CallOption) (Posts_SubscribePostCommentsClient, error)
 }
 
 type postsClient struct {
@@ -161,6 +286,259 @@ func (c *postsClient) GetPostById(ctx context.Context, in *GetPostByIdRequest, o
 	return out, nil
 }
 
+func (c *postsClient) SaveDraft(ctx context.Context, in *SaveDraftRequest, opts ...grpc.CallOption) (*SaveDraftResponse, error) {
+	out := new(SaveDraftResponse)
+	err := c.cc.Invoke(ctx, Posts_SaveDraft_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postsClient) ListDrafts(ctx context.Context, in *ListDraftsRequest, opts ...grpc.CallOption) (*ListDraftsResponse, error) {
+	out := new(ListDraftsResponse)
+	err := c.cc.Invoke(ctx, Posts_ListDrafts_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postsClient) GetDraft(ctx context.Context, in *GetDraftRequest, opts ...grpc.CallOption) (*GetDraftResponse, error) {
+	out := new(GetDraftResponse)
+	err := c.cc.Invoke(ctx, Posts_GetDraft_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postsClient) DeleteDraft(ctx context.Context, in *DeleteDraftRequest, opts ...grpc.CallOption) (*DeleteDraftResponse, error) {
+	out := new(DeleteDraftResponse)
+	err := c.cc.Invoke(ctx, Posts_DeleteDraft_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postsClient) PublishDraft(ctx context.Context, in *PublishDraftRequest, opts ...grpc.CallOption) (*PublishDraftResponse, error) {
+	out := new(PublishDraftResponse)
+	err := c.cc.Invoke(ctx, Posts_PublishDraft_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postsClient) CreateAudience(ctx context.Context, in *CreateAudienceRequest, opts ...grpc.CallOption) (*CreateAudienceResponse, error) {
+	out := new(CreateAudienceResponse)
+	err := c.cc.Invoke(ctx, Posts_CreateAudience_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postsClient) AddToAudience(ctx context.Context, in *AddToAudienceRequest, opts ...grpc.CallOption) (*AddToAudienceResponse, error) {
+	out := new(AddToAudienceResponse)
+	err := c.cc.Invoke(ctx, Posts_AddToAudience_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postsClient) ListAudiences(ctx context.Context, in *ListAudiencesRequest, opts ...grpc.CallOption) (*ListAudiencesResponse, error) {
+	out := new(ListAudiencesResponse)
+	err := c.cc.Invoke(ctx, Posts_ListAudiences_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postsClient) GetPostsByTag(ctx context.Context, in *GetPostsByTagRequest, opts ...grpc.CallOption) (*GetPostsByTagResponse, error) {
+	out := new(GetPostsByTagResponse)
+	err := c.cc.Invoke(ctx, Posts_GetPostsByTag_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postsClient) GetTagsFeed(ctx context.Context, in *GetTagsFeedRequest, opts ...grpc.CallOption) (*GetTagsFeedResponse, error) {
+	out := new(GetTagsFeedResponse)
+	err := c.cc.Invoke(ctx, Posts_GetTagsFeed_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postsClient) GetMentions(ctx context.Context, in *GetMentionsRequest, opts ...grpc.CallOption) (*GetMentionsResponse, error) {
+	out := new(GetMentionsResponse)
+	err := c.cc.Invoke(ctx, Posts_GetMentions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postsClient) VotePoll(ctx context.Context, in *VotePollRequest, opts ...grpc.CallOption) (*VotePollResponse, error) {
+	out := new(VotePollResponse)
+	err := c.cc.Invoke(ctx, Posts_VotePoll_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postsClient) RetractVote(ctx context.Context, in *RetractVoteRequest, opts ...grpc.CallOption) (*RetractVoteResponse, error) {
+	out := new(RetractVoteResponse)
+	err := c.cc.Invoke(ctx, Posts_RetractVote_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postsClient) DeletePost(ctx context.Context, in *DeletePostRequest, opts ...grpc.CallOption) (*DeletePostResponse, error) {
+	out := new(DeletePostResponse)
+	err := c.cc.Invoke(ctx, Posts_DeletePost_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postsClient) SearchPosts(ctx context.Context, in *SearchPostsRequest, opts ...grpc.CallOption) (*SearchPostsResponse, error) {
+	out := new(SearchPostsResponse)
+	err := c.cc.Invoke(ctx, Posts_SearchPosts_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postsClient) GetTrendingPosts(ctx context.Context, in *GetTrendingPostsRequest, opts ...grpc.CallOption) (*GetTrendingPostsResponse, error) {
+	out := new(GetTrendingPostsResponse)
+	err := c.cc.Invoke(ctx, Posts_GetTrendingPosts_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postsClient) GetRankedFeed(ctx context.Context, in *GetRankedFeedRequest, opts ...grpc.CallOption) (*GetRankedFeedResponse, error) {
+	out := new(GetRankedFeedResponse)
+	err := c.cc.Invoke(ctx, Posts_GetRankedFeed_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postsClient) MarkSeen(ctx context.Context, in *MarkSeenRequest, opts ...grpc.CallOption) (*MarkSeenResponse, error) {
+	out := new(MarkSeenResponse)
+	err := c.cc.Invoke(ctx, Posts_MarkSeen_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postsClient) GetNewPostsCount(ctx context.Context, in *GetNewPostsCountRequest, opts ...grpc.CallOption) (*GetNewPostsCountResponse, error) {
+	out := new(GetNewPostsCountResponse)
+	err := c.cc.Invoke(ctx, Posts_GetNewPostsCount_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postsClient) GetPostStats(ctx context.Context, in *GetPostStatsRequest, opts ...grpc.CallOption) (*GetPostStatsResponse, error) {
+	out := new(GetPostStatsResponse)
+	err := c.cc.Invoke(ctx, Posts_GetPostStats_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postsClient) GetAuthorStats(ctx context.Context, in *GetAuthorStatsRequest, opts ...grpc.CallOption) (*GetAuthorStatsResponse, error) {
+	out := new(GetAuthorStatsResponse)
+	err := c.cc.Invoke(ctx, Posts_GetAuthorStats_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postsClient) SubscribeFeed(ctx context.Context, in *SubscribeFeedRequest, opts ...grpc.CallOption) (Posts_SubscribeFeedClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Posts_ServiceDesc.Streams[0], Posts_SubscribeFeed_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &postsSubscribeFeedClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Posts_SubscribeFeedClient interface {
+	Recv() (*SubscribeFeedResponse, error)
+	grpc.ClientStream
+}
+
+type postsSubscribeFeedClient struct {
+	grpc.ClientStream
+}
+
+func (x *postsSubscribeFeedClient) Recv() (*SubscribeFeedResponse, error) {
+	m := new(SubscribeFeedResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *postsClient) SubscribePostComments(ctx context.Context, in *SubscribePostCommentsRequest, opts ...grpc.CallOption) (Posts_SubscribePostCommentsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Posts_ServiceDesc.Streams[1], Posts_SubscribePostComments_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &postsSubscribePostCommentsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Posts_SubscribePostCommentsClient interface {
+	Recv() (*SubscribePostCommentsResponse, error)
+	grpc.ClientStream
+}
+
+type postsSubscribePostCommentsClient struct {
+	grpc.ClientStream
+}
+
+func (x *postsSubscribePostCommentsClient) Recv() (*SubscribePostCommentsResponse, error) {
+	m := new(SubscribePostCommentsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // PostsServer is the server API for Posts service.
 // All implementations should embed UnimplementedPostsServer
 // for forward compatibility
@@ -176,6 +554,7 @@ type PostsServer interface {
 	// GetPostsUser
 	//
 	// Возвращает список постов пользователя. Отсортирован по дате. Сначала новые.
+	// Доступно без авторизации, гостю возвращаются только публичные посты, user_id обязателен.
 	GetPostsUser(context.Context, *GetPostsUserRequest) (*GetPostsUserResponse, error)
 	// AddLike
 	//
@@ -192,15 +571,116 @@ type PostsServer interface {
 	// GetCommentsList
 	//
 	// Возвращает список комментариев под постом. Отсортирован по дате. Направление сортировки зависит от параметра sort_dir. false - сначала новые (по умолчанию), true - сначала старые.
+	// Доступно без авторизации для публичных постов.
 	GetCommentsList(context.Context, *GetCommentsListRequest) (*GetCommentsListResponse, error)
 	// UpdatePost
 	//
-	// Изменяет пост. (Не реализовано).
+	// Изменяет сообщение и вложения поста. Изменять пост может только его владелец.
 	UpdatePost(context.Context, *UpdatePostRequest) (*UpdatePostResponse, error)
 	// GetPostById
 	//
-	// Получить пост по id
+	// Получить пост по id. Доступно без авторизации, гостю возвращаются только публичные посты.
 	GetPostById(context.Context, *GetPostByIdRequest) (*GetPostByIdResponse, error)
+	// SaveDraft
+	//
+	// Сохраняет черновик поста. Если id не задан, создает новый черновик, иначе перезаписывает существующий.
+	SaveDraft(context.Context, *SaveDraftRequest) (*SaveDraftResponse, error)
+	// ListDrafts
+	//
+	// Возвращает список черновиков текущего пользователя. Сначала новые.
+	ListDrafts(context.Context, *ListDraftsRequest) (*ListDraftsResponse, error)
+	// GetDraft
+	//
+	// Возвращает черновик по id.
+	GetDraft(context.Context, *GetDraftRequest) (*GetDraftResponse, error)
+	// DeleteDraft
+	//
+	// Удаляет черновик.
+	DeleteDraft(context.Context, *DeleteDraftRequest) (*DeleteDraftResponse, error)
+	// PublishDraft
+	//
+	// Публикует черновик как новый пост. Проверки такие же, как в NewPost. После публикации черновик удаляется.
+	PublishDraft(context.Context, *PublishDraftRequest) (*PublishDraftResponse, error)
+	// CreateAudience
+	//
+	// Создает список пользователей (например, близкие друзья), которым можно адресовать посты.
+	CreateAudience(context.Context, *CreateAudienceRequest) (*CreateAudienceResponse, error)
+	// AddToAudience
+	//
+	// Добавляет пользователей в список.
+	AddToAudience(context.Context, *AddToAudienceRequest) (*AddToAudienceResponse, error)
+	// ListAudiences
+	//
+	// Возвращает списки текущего пользователя.
+	ListAudiences(context.Context, *ListAudiencesRequest) (*ListAudiencesResponse, error)
+	// GetPostsByTag
+	//
+	// Возвращает список постов с хештегом. Отсортирован по дате. Сначала новые.
+	GetPostsByTag(context.Context, *GetPostsByTagRequest) (*GetPostsByTagResponse, error)
+	// GetTagsFeed
+	//
+	// Возвращает список постов с хештегами, совпадающими с тегами профиля текущего пользователя. Отсортирован по дате. Сначала новые.
+	GetTagsFeed(context.Context, *GetTagsFeedRequest) (*GetTagsFeedResponse, error)
+	// GetMentions
+	//
+	// Возвращает посты и комментарии, в которых упомянут текущий пользователь. Отсортирован по дате. Сначала новые.
+	GetMentions(context.Context, *GetMentionsRequest) (*GetMentionsResponse, error)
+	// VotePoll
+	//
+	// Голосует в опросе поста. Проголосовать можно один раз, чтобы изменить голос, его нужно отменить.
+	VotePoll(context.Context, *VotePollRequest) (*VotePollResponse, error)
+	// RetractVote
+	//
+	// Отменяет голос в опросе поста.
+	RetractVote(context.Context, *RetractVoteRequest) (*RetractVoteResponse, error)
+	// DeletePost
+	//
+	// Удаляет пост вместе с комментариями, лайками и опросом. Удалить пост может только его владелец.
+	DeletePost(context.Context, *DeletePostRequest) (*DeletePostResponse, error)
+	// SearchPosts
+	//
+	// Полнотекстовый поиск по постам и комментариям. Возвращает посты, в тексте которых или в тексте комментариев к которым
+	// встречаются все слова запроса. Отсортирован по дате найденного поста или комментария. Сначала новые.
+	SearchPosts(context.Context, *SearchPostsRequest) (*SearchPostsResponse, error)
+	// GetTrendingPosts
+	//
+	// Возвращает популярные публичные посты за последнее время. Отсортирован по убыванию рейтинга, который складывается
+	// из лайков и комментариев и уменьшается с возрастом поста. Рейтинг периодически пересчитывается.
+	GetTrendingPosts(context.Context, *GetTrendingPostsRequest) (*GetTrendingPostsResponse, error)
+	// GetRankedFeed
+	//
+	// Возвращает ленту постов за последние дни, отсортированную по интересу для текущего пользователя: учитываются
+	// свежесть поста, лайки и комментарии, а также то, как часто пользователь лайкает и комментирует автора и хештеги поста.
+	GetRankedFeed(context.Context, *GetRankedFeedRequest) (*GetRankedFeedResponse, error)
+	// MarkSeen
+	//
+	// Отмечает посты как просмотренные текущим пользователем. Отметки хранятся 30 дней.
+	MarkSeen(context.Context, *MarkSeenRequest) (*MarkSeenResponse, error)
+	// GetNewPostsCount
+	//
+	// Возвращает количество новых постов в ленте GetPostsList, которые появились после самого нового просмотренного поста.
+	// Собственные посты пользователя не учитываются.
+	GetNewPostsCount(context.Context, *GetNewPostsCountRequest) (*GetNewPostsCountResponse, error)
+	// GetPostStats
+	//
+	// Возвращает статистику просмотров, лайков и комментариев поста по часам или по дням. Доступно только владельцу поста.
+	GetPostStats(context.Context, *GetPostStatsRequest) (*GetPostStatsResponse, error)
+	// GetAuthorStats
+	//
+	// Возвращает статистику постов текущего пользователя за промежуток времени: количество постов, вовлеченность,
+	// лучшие посты и часы публикации, а также связь вовлеченности с ростом числа подписчиков.
+	GetAuthorStats(context.Context, *GetAuthorStatsRequest) (*GetAuthorStatsResponse, error)
+	// SubscribeFeed
+	//
+	// Подписывается на обновления ленты: новые посты, видимые пользователю, и изменения количества лайков этих постов.
+	// Приходят только события после подписки, пропущенные посты загружаются через GetPostsList.
+	// Лайки приходят только для постов, уже отправленных в этом потоке, лайки остальных постов отслеживаются через SubscribePostComments.
+	SubscribeFeed(*SubscribeFeedRequest, Posts_SubscribeFeedServer) error
+	// SubscribePostComments
+	//
+	// Подписывается на новые комментарии поста и изменения количества лайков. Поток завершается с ошибкой,
+	// если пост удален.
+	SubscribePostComments(*SubscribePostCommentsRequest, Posts_SubscribePostCommentsServer) error
 }
 
 // UnimplementedPostsServer should be embedded to have forward compatible implementations.
@@ -234,6 +714,75 @@ func (UnimplementedPostsServer) UpdatePost(context.Context, *UpdatePostRequest)
 func (UnimplementedPostsServer) GetPostById(context.Context, *GetPostByIdRequest) (*GetPostByIdResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPostById not implemented")
 }
+func (UnimplementedPostsServer) SaveDraft(context.Context, *SaveDraftRequest) (*SaveDraftResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveDraft not implemented")
+}
+func (UnimplementedPostsServer) ListDrafts(context.Context, *ListDraftsRequest) (*ListDraftsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDrafts not implemented")
+}
+func (UnimplementedPostsServer) GetDraft(context.Context, *GetDraftRequest) (*GetDraftResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDraft not implemented")
+}
+func (UnimplementedPostsServer) DeleteDraft(context.Context, *DeleteDraftRequest) (*DeleteDraftResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteDraft not implemented")
+}
+func (UnimplementedPostsServer) PublishDraft(context.Context, *PublishDraftRequest) (*PublishDraftResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishDraft not implemented")
+}
+func (UnimplementedPostsServer) CreateAudience(context.Context, *CreateAudienceRequest) (*CreateAudienceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAudience not implemented")
+}
+func (UnimplementedPostsServer) AddToAudience(context.Context, *AddToAudienceRequest) (*AddToAudienceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddToAudience not implemented")
+}
+func (UnimplementedPostsServer) ListAudiences(context.Context, *ListAudiencesRequest) (*ListAudiencesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAudiences not implemented")
+}
+func (UnimplementedPostsServer) GetPostsByTag(context.Context, *GetPostsByTagRequest) (*GetPostsByTagResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPostsByTag not implemented")
+}
+func (UnimplementedPostsServer) GetTagsFeed(context.Context, *GetTagsFeedRequest) (*GetTagsFeedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTagsFeed not implemented")
+}
+func (UnimplementedPostsServer) GetMentions(context.Context, *GetMentionsRequest) (*GetMentionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMentions not implemented")
+}
+func (UnimplementedPostsServer) VotePoll(context.Context, *VotePollRequest) (*VotePollResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VotePoll not implemented")
+}
+func (UnimplementedPostsServer) RetractVote(context.Context, *RetractVoteRequest) (*RetractVoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetractVote not implemented")
+}
+func (UnimplementedPostsServer) DeletePost(context.Context, *DeletePostRequest) (*DeletePostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePost not implemented")
+}
+func (UnimplementedPostsServer) SearchPosts(context.Context, *SearchPostsRequest) (*SearchPostsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchPosts not implemented")
+}
+func (UnimplementedPostsServer) GetTrendingPosts(context.Context, *GetTrendingPostsRequest) (*GetTrendingPostsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTrendingPosts not implemented")
+}
+func (UnimplementedPostsServer) GetRankedFeed(context.Context, *GetRankedFeedRequest) (*GetRankedFeedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRankedFeed not implemented")
+}
+func (UnimplementedPostsServer) MarkSeen(context.Context, *MarkSeenRequest) (*MarkSeenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkSeen not implemented")
+}
+func (UnimplementedPostsServer) GetNewPostsCount(context.Context, *GetNewPostsCountRequest) (*GetNewPostsCountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNewPostsCount not implemented")
+}
+func (UnimplementedPostsServer) GetPostStats(context.Context, *GetPostStatsRequest) (*GetPostStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPostStats not implemented")
+}
+func (UnimplementedPostsServer) GetAuthorStats(context.Context, *GetAuthorStatsRequest) (*GetAuthorStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAuthorStats not implemented")
+}
+func (UnimplementedPostsServer) SubscribeFeed(*SubscribeFeedRequest, Posts_SubscribeFeedServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeFeed not implemented")
+}
+func (UnimplementedPostsServer) SubscribePostComments(*SubscribePostCommentsRequest, Posts_SubscribePostCommentsServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribePostComments not implemented")
+}
 
 // UnsafePostsServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to PostsServer will
@@ -408,33 +957,453 @@ func _Posts_GetPostById_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
-// Posts_ServiceDesc is the grpc.ServiceDesc for Posts service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var Posts_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "Posts",
-	HandlerType: (*PostsServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "NewPost",
-			Handler:    _Posts_NewPost_Handler,
-		},
-		{
-			MethodName: "GetPostsList",
-			Handler:    _Posts_GetPostsList_Handler,
-		},
-		{
-			MethodName: "GetPostsUser",
-			Handler:    _Posts_GetPostsUser_Handler,
-		},
-		{
-			MethodName: "AddLike",
-			Handler:    _Posts_AddLike_Handler,
-		},
-		{
-			MethodName: "DeleteLike",
-			Handler:    _Posts_DeleteLike_Handler,
-		},
+func _Posts_SaveDraft_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveDraftRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostsServer).SaveDraft(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Posts_SaveDraft_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostsServer).SaveDraft(ctx, req.(*SaveDraftRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Posts_ListDrafts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDraftsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostsServer).ListDrafts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Posts_ListDrafts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostsServer).ListDrafts(ctx, req.(*ListDraftsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Posts_GetDraft_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDraftRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostsServer).GetDraft(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Posts_GetDraft_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostsServer).GetDraft(ctx, req.(*GetDraftRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Posts_DeleteDraft_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteDraftRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostsServer).DeleteDraft(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Posts_DeleteDraft_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostsServer).DeleteDraft(ctx, req.(*DeleteDraftRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Posts_PublishDraft_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishDraftRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostsServer).PublishDraft(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Posts_PublishDraft_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostsServer).PublishDraft(ctx, req.(*PublishDraftRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Posts_CreateAudience_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAudienceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostsServer).CreateAudience(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Posts_CreateAudience_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostsServer).CreateAudience(ctx, req.(*CreateAudienceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Posts_AddToAudience_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddToAudienceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostsServer).AddToAudience(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Posts_AddToAudience_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostsServer).AddToAudience(ctx, req.(*AddToAudienceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Posts_ListAudiences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAudiencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostsServer).ListAudiences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Posts_ListAudiences_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostsServer).ListAudiences(ctx, req.(*ListAudiencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Posts_GetPostsByTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPostsByTagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostsServer).GetPostsByTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Posts_GetPostsByTag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostsServer).GetPostsByTag(ctx, req.(*GetPostsByTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Posts_GetTagsFeed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTagsFeedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostsServer).GetTagsFeed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Posts_GetTagsFeed_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostsServer).GetTagsFeed(ctx, req.(*GetTagsFeedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Posts_GetMentions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMentionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostsServer).GetMentions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Posts_GetMentions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostsServer).GetMentions(ctx, req.(*GetMentionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Posts_VotePoll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VotePollRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostsServer).VotePoll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Posts_VotePoll_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostsServer).VotePoll(ctx, req.(*VotePollRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Posts_RetractVote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetractVoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostsServer).RetractVote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Posts_RetractVote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostsServer).RetractVote(ctx, req.(*RetractVoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Posts_DeletePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostsServer).DeletePost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Posts_DeletePost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostsServer).DeletePost(ctx, req.(*DeletePostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Posts_SearchPosts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchPostsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostsServer).SearchPosts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Posts_SearchPosts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostsServer).SearchPosts(ctx, req.(*SearchPostsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Posts_GetTrendingPosts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTrendingPostsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostsServer).GetTrendingPosts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Posts_GetTrendingPosts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostsServer).GetTrendingPosts(ctx, req.(*GetTrendingPostsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Posts_GetRankedFeed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRankedFeedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostsServer).GetRankedFeed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Posts_GetRankedFeed_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostsServer).GetRankedFeed(ctx, req.(*GetRankedFeedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Posts_MarkSeen_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkSeenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostsServer).MarkSeen(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Posts_MarkSeen_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostsServer).MarkSeen(ctx, req.(*MarkSeenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Posts_GetNewPostsCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNewPostsCountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostsServer).GetNewPostsCount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Posts_GetNewPostsCount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostsServer).GetNewPostsCount(ctx, req.(*GetNewPostsCountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Posts_GetPostStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPostStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostsServer).GetPostStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Posts_GetPostStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostsServer).GetPostStats(ctx, req.(*GetPostStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Posts_GetAuthorStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAuthorStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostsServer).GetAuthorStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Posts_GetAuthorStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostsServer).GetAuthorStats(ctx, req.(*GetAuthorStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Posts_SubscribeFeed_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeFeedRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PostsServer).SubscribeFeed(m, &postsSubscribeFeedServer{stream})
+}
+
+type Posts_SubscribeFeedServer interface {
+	Send(*SubscribeFeedResponse) error
+	grpc.ServerStream
+}
+
+type postsSubscribeFeedServer struct {
+	grpc.ServerStream
+}
+
+func (x *postsSubscribeFeedServer) Send(m *SubscribeFeedResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Posts_SubscribePostComments_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribePostCommentsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PostsServer).SubscribePostComments(m, &postsSubscribePostCommentsServer{stream})
+}
+
+type Posts_SubscribePostCommentsServer interface {
+	Send(*SubscribePostCommentsResponse) error
+	grpc.ServerStream
+}
+
+type postsSubscribePostCommentsServer struct {
+	grpc.ServerStream
+}
+
+func (x *postsSubscribePostCommentsServer) Send(m *SubscribePostCommentsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// Posts_ServiceDesc is the grpc.ServiceDesc for Posts service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Posts_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "Posts",
+	HandlerType: (*PostsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "NewPost",
+			Handler:    _Posts_NewPost_Handler,
+		},
+		{
+			MethodName: "GetPostsList",
+			Handler:    _Posts_GetPostsList_Handler,
+		},
+		{
+			MethodName: "GetPostsUser",
+			Handler:    _Posts_GetPostsUser_Handler,
+		},
+		{
+			MethodName: "AddLike",
+			Handler:    _Posts_AddLike_Handler,
+		},
+		{
+			MethodName: "DeleteLike",
+			Handler:    _Posts_DeleteLike_Handler,
+		},
 		{
 			MethodName: "WriteComment",
 			Handler:    _Posts_WriteComment_Handler,
@@ -451,7 +1420,102 @@ var Posts_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetPostById",
 			Handler:    _Posts_GetPostById_Handler,
 		},
+		{
+			MethodName: "SaveDraft",
+			Handler:    _Posts_SaveDraft_Handler,
+		},
+		{
+			MethodName: "ListDrafts",
+			Handler:    _Posts_ListDrafts_Handler,
+		},
+		{
+			MethodName: "GetDraft",
+			Handler:    _Posts_GetDraft_Handler,
+		},
+		{
+			MethodName: "DeleteDraft",
+			Handler:    _Posts_DeleteDraft_Handler,
+		},
+		{
+			MethodName: "PublishDraft",
+			Handler:    _Posts_PublishDraft_Handler,
+		},
+		{
+			MethodName: "CreateAudience",
+			Handler:    _Posts_CreateAudience_Handler,
+		},
+		{
+			MethodName: "AddToAudience",
+			Handler:    _Posts_AddToAudience_Handler,
+		},
+		{
+			MethodName: "ListAudiences",
+			Handler:    _Posts_ListAudiences_Handler,
+		},
+		{
+			MethodName: "GetPostsByTag",
+			Handler:    _Posts_GetPostsByTag_Handler,
+		},
+		{
+			MethodName: "GetTagsFeed",
+			Handler:    _Posts_GetTagsFeed_Handler,
+		},
+		{
+			MethodName: "GetMentions",
+			Handler:    _Posts_GetMentions_Handler,
+		},
+		{
+			MethodName: "VotePoll",
+			Handler:    _Posts_VotePoll_Handler,
+		},
+		{
+			MethodName: "RetractVote",
+			Handler:    _Posts_RetractVote_Handler,
+		},
+		{
+			MethodName: "DeletePost",
+			Handler:    _Posts_DeletePost_Handler,
+		},
+		{
+			MethodName: "SearchPosts",
+			Handler:    _Posts_SearchPosts_Handler,
+		},
+		{
+			MethodName: "GetTrendingPosts",
+			Handler:    _Posts_GetTrendingPosts_Handler,
+		},
+		{
+			MethodName: "GetRankedFeed",
+			Handler:    _Posts_GetRankedFeed_Handler,
+		},
+		{
+			MethodName: "MarkSeen",
+			Handler:    _Posts_MarkSeen_Handler,
+		},
+		{
+			MethodName: "GetNewPostsCount",
+			Handler:    _Posts_GetNewPostsCount_Handler,
+		},
+		{
+			MethodName: "GetPostStats",
+			Handler:    _Posts_GetPostStats_Handler,
+		},
+		{
+			MethodName: "GetAuthorStats",
+			Handler:    _Posts_GetAuthorStats_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SubscribeFeed",
+			Handler:       _Posts_SubscribeFeed_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SubscribePostComments",
+			Handler:       _Posts_SubscribePostComments_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "posts.proto",
 }
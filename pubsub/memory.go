@@ -0,0 +1,99 @@
+package pubsub
+
+import (
+	"context"
+	"sync"
+)
+
+// MemoryBroker is a Broker for a single replica of the service. Publish
+// never blocks, a subscriber whose buffer is full is dropped with
+// ErrSlowSubscriber.
+type MemoryBroker struct {
+	buffer int
+
+	mu     sync.Mutex
+	topics map[string]map[*memorySubscription]bool
+}
+
+func NewMemoryBroker(buffer int) *MemoryBroker {
+	return &MemoryBroker{
+		buffer: buffer,
+		topics: make(map[string]map[*memorySubscription]bool),
+	}
+}
+
+func (b *MemoryBroker) Publish(ctx context.Context, topic string, event Event) error {
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for sub := range b.topics[topic] {
+		select {
+		case sub.events <- event:
+		default:
+			b.remove(sub, ErrSlowSubscriber)
+		}
+	}
+
+	return nil
+}
+
+func (b *MemoryBroker) Subscribe(ctx context.Context, topic string) (Subscription, error) {
+
+	sub := &memorySubscription{
+		broker: b,
+		topic:  topic,
+		events: make(chan Event, b.buffer),
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.topics[topic] == nil {
+		b.topics[topic] = make(map[*memorySubscription]bool)
+	}
+	b.topics[topic][sub] = true
+
+	return sub, nil
+}
+
+// remove ends the subscription, b.mu must be held.
+func (b *MemoryBroker) remove(sub *memorySubscription, err error) {
+
+	subs := b.topics[sub.topic]
+	if !subs[sub] {
+		return
+	}
+
+	delete(subs, sub)
+	if len(subs) == 0 {
+		delete(b.topics, sub.topic)
+	}
+
+	sub.err = err
+	close(sub.events)
+}
+
+type memorySubscription struct {
+	broker *MemoryBroker
+	topic  string
+	events chan Event
+	// err is guarded by broker.mu.
+	err error
+}
+
+func (s *memorySubscription) Events() <-chan Event {
+	return s.events
+}
+
+func (s *memorySubscription) Err() error {
+	s.broker.mu.Lock()
+	defer s.broker.mu.Unlock()
+	return s.err
+}
+
+func (s *memorySubscription) Close() {
+	s.broker.mu.Lock()
+	defer s.broker.mu.Unlock()
+	s.broker.remove(s, nil)
+}
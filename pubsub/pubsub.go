@@ -0,0 +1,51 @@
+// Package pubsub delivers changes of posts to the subscribers of live
+// updates. Broker is implemented in process by MemoryBroker, a
+// distributed implementation is needed when the service has more than
+// one replica.
+package pubsub
+
+import (
+	"context"
+	"errors"
+)
+
+type EventType int
+
+const (
+	PostCreated EventType = iota
+	PostDeleted
+	CommentCreated
+	LikesChanged
+)
+
+// Event is a change of a post. It holds ids only, the subscribers load
+// what their user is allowed to see, so events can be sent over the
+// network as is.
+type Event struct {
+	Type      EventType `json:"type"`
+	PostId    uint64    `json:"post_id"`
+	OwnerId   int64     `json:"owner_id"`
+	CommentId uint64    `json:"comment_id,omitempty"`
+	Likes     int64     `json:"likes,omitempty"`
+}
+
+// ErrSlowSubscriber ends a subscription that does not keep up with the
+// events of its topic.
+var ErrSlowSubscriber = errors.New("pubsub: subscriber is too slow")
+
+type Subscription interface {
+	// Events is closed when the subscription ends.
+	Events() <-chan Event
+	// Err returns the reason the subscription ended by the broker, nil
+	// if it was closed by the subscriber.
+	Err() error
+	Close()
+}
+
+type Broker interface {
+	// Publish delivers the event to the current subscribers of the topic.
+	Publish(ctx context.Context, topic string, event Event) error
+	// Subscribe returns a subscription to the events published after
+	// the call. It must be closed by the caller.
+	Subscribe(ctx context.Context, topic string) (Subscription, error)
+}
@@ -0,0 +1,122 @@
+package ranking
+
+import (
+	"sort"
+	"time"
+)
+
+// Candidate is a post that may get into the feed.
+type Candidate struct {
+	PostId   uint64
+	OwnerId  int64
+	Time     time.Time
+	Tags     []string
+	Likes    int64
+	Comments int64
+	// Seen is set if the viewer has already seen the post.
+	Seen bool
+}
+
+// Viewer holds what is known about the interests of the user the feed
+// is built for. Affinities are in the range [0;1].
+type Viewer struct {
+	Id             int64
+	AuthorAffinity map[int64]float64
+	TagAffinity    map[string]float64
+}
+
+// Scorer scores a candidate for the viewer. Scorers must be pure: the
+// same arguments always give the same score.
+type Scorer interface {
+	Score(now time.Time, viewer *Viewer, c *Candidate) float64
+}
+
+// Weighted is a scorer with its weight in the total score.
+type Weighted struct {
+	Scorer Scorer
+	Weight float64
+}
+
+// Clock returns the current time, tests use a fixed one.
+type Clock interface {
+	Now() time.Time
+}
+
+// ClockFunc adapts a function to Clock.
+type ClockFunc func() time.Time
+
+func (f ClockFunc) Now() time.Time {
+	return f()
+}
+
+// SystemClock is the real time.
+var SystemClock = ClockFunc(time.Now)
+
+// Scored is a ranked candidate.
+type Scored struct {
+	Candidate *Candidate
+	Score     float64
+}
+
+// Ranker orders candidates by the weighted sum of the scorers.
+type Ranker struct {
+	clock   Clock
+	scorers []Weighted
+}
+
+func NewRanker(clock Clock, scorers ...Weighted) *Ranker {
+	return &Ranker{clock: clock, scorers: scorers}
+}
+
+// Now returns the time of the clock of the ranker, the candidates should
+// be selected by it too.
+func (r *Ranker) Now() time.Time {
+	return r.clock.Now()
+}
+
+// Config holds the parameters of the default scorers.
+type Config struct {
+	RecencyHalfLife  time.Duration
+	RecencyWeight    float64
+	LikeWeight       float64
+	CommentWeight    float64
+	EngagementWeight float64
+	AuthorWeight     float64
+	TagWeight        float64
+}
+
+// Scorers returns the default scorers with the weights of the config.
+func (cfg Config) Scorers() []Weighted {
+	return []Weighted{
+		{Scorer: Recency{HalfLife: cfg.RecencyHalfLife}, Weight: cfg.RecencyWeight},
+		{Scorer: Engagement{LikeWeight: cfg.LikeWeight, CommentWeight: cfg.CommentWeight}, Weight: cfg.EngagementWeight},
+		{Scorer: AuthorAffinity{}, Weight: cfg.AuthorWeight},
+		{Scorer: TagAffinity{}, Weight: cfg.TagWeight},
+	}
+}
+
+// Rank returns the candidates sorted by score, best first. Equal scores
+// are ordered by post id, newest first, so the result only depends on
+// the arguments and the clock.
+func (r *Ranker) Rank(viewer *Viewer, candidates []*Candidate) []Scored {
+
+	now := r.clock.Now()
+
+	res := make([]Scored, 0, len(candidates))
+	for _, c := range candidates {
+		score := 0.0
+		for _, s := range r.scorers {
+			score += s.Weight * s.Scorer.Score(now, viewer, c)
+		}
+		res = append(res, Scored{Candidate: c, Score: score})
+	}
+
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].Score != res[j].Score {
+			return res[i].Score > res[j].Score
+		}
+		return res[i].Candidate.PostId > res[j].Candidate.PostId
+	})
+
+	return res
+}
@@ -0,0 +1,96 @@
+package ranking
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRanker(t *testing.T) {
+
+	clock := ClockFunc(func() time.Time { return testNow })
+
+	candidates := func() []*Candidate {
+		return []*Candidate{
+			{PostId: 1, OwnerId: 10, Time: testNow.Add(-time.Hour * 24)},
+			{PostId: 2, OwnerId: 20, Time: testNow.Add(-time.Hour * 12), Likes: 5},
+			{PostId: 3, OwnerId: 30, Time: testNow, Tags: []string{"go"}},
+			{PostId: 4, OwnerId: 10, Time: testNow.Add(-time.Hour * 24)},
+		}
+	}
+	viewer := &Viewer{
+		AuthorAffinity: map[int64]float64{20: 1},
+		TagAffinity:    map[string]float64{"go": 0.5},
+	}
+
+	for _, tt := range []struct {
+		name    string
+		scorers []Weighted
+		want    []uint64
+	}{
+		{
+			name:    "recency, ties newest first",
+			scorers: []Weighted{{Scorer: Recency{HalfLife: time.Hour * 12}, Weight: 1}},
+			want:    []uint64{3, 2, 4, 1},
+		},
+		{
+			name: "affinity outweighs recency",
+			scorers: []Weighted{
+				{Scorer: Recency{HalfLife: time.Hour * 12}, Weight: 1},
+				{Scorer: AuthorAffinity{}, Weight: 1},
+			},
+			want: []uint64{2, 3, 4, 1},
+		},
+		{
+			name: "default config",
+			scorers: Config{
+				RecencyHalfLife:  time.Hour * 12,
+				RecencyWeight:    1,
+				LikeWeight:       1,
+				CommentWeight:    2,
+				EngagementWeight: 0.2,
+				AuthorWeight:     1,
+				TagWeight:        0.5,
+			}.Scorers(),
+			want: []uint64{2, 3, 4, 1},
+		},
+		{
+			name: "no scorers",
+			want: []uint64{4, 3, 2, 1},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			r := NewRanker(clock, tt.scorers...)
+
+			// the result only depends on the arguments and the clock.
+			for i := 0; i < 2; i++ {
+				got := r.Rank(viewer, candidates())
+				if len(got) != len(tt.want) {
+					t.Fatalf("got %d posts, want %d", len(got), len(tt.want))
+				}
+				for j, s := range got {
+					if s.Candidate.PostId != tt.want[j] {
+						t.Fatalf("got post %d at %d, want %d", s.Candidate.PostId, j, tt.want[j])
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestRankerScores(t *testing.T) {
+
+	clock := ClockFunc(func() time.Time { return testNow })
+	r := NewRanker(clock,
+		Weighted{Scorer: Recency{HalfLife: time.Hour}, Weight: 2},
+		Weighted{Scorer: Engagement{LikeWeight: 1}, Weight: 0},
+	)
+
+	got := r.Rank(&Viewer{}, []*Candidate{{PostId: 1, Time: testNow.Add(-time.Hour), Likes: 100}})
+	if len(got) != 1 || !almostEqual(got[0].Score, 1) {
+		t.Errorf("got %+v, want the score 1", got)
+	}
+
+	if !r.Now().Equal(testNow) {
+		t.Errorf("got now %v, want %v", r.Now(), testNow)
+	}
+}
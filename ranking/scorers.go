@@ -0,0 +1,48 @@
+package ranking
+
+import (
+	"math"
+	"time"
+)
+
+// Recency halves the score of a post every HalfLife, a new post scores 1.
+type Recency struct {
+	HalfLife time.Duration
+}
+
+func (s Recency) Score(now time.Time, viewer *Viewer, c *Candidate) float64 {
+	age := now.Sub(c.Time)
+	if age < 0 {
+		age = 0
+	}
+	return math.Exp2(-age.Seconds() / s.HalfLife.Seconds())
+}
+
+// Engagement scores the weighted likes and comments of a post on a log
+// scale, so a few popular posts do not push everything else out.
+type Engagement struct {
+	LikeWeight    float64
+	CommentWeight float64
+}
+
+func (s Engagement) Score(now time.Time, viewer *Viewer, c *Candidate) float64 {
+	return math.Log1p(s.LikeWeight*float64(c.Likes) + s.CommentWeight*float64(c.Comments))
+}
+
+// AuthorAffinity scores how much the viewer interacts with the author.
+type AuthorAffinity struct{}
+
+func (AuthorAffinity) Score(now time.Time, viewer *Viewer, c *Candidate) float64 {
+	return viewer.AuthorAffinity[c.OwnerId]
+}
+
+// TagAffinity scores the hashtag of the post the viewer likes most.
+type TagAffinity struct{}
+
+func (TagAffinity) Score(now time.Time, viewer *Viewer, c *Candidate) float64 {
+	res := 0.0
+	for _, t := range c.Tags {
+		res = math.Max(res, viewer.TagAffinity[t])
+	}
+	return res
+}
@@ -0,0 +1,104 @@
+package ranking
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+var testNow = time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+
+func almostEqual(a, b float64) bool {
+	return math.Abs(a-b) < 1e-9
+}
+
+func TestRecency(t *testing.T) {
+
+	s := Recency{HalfLife: time.Hour * 12}
+
+	for _, tt := range []struct {
+		name string
+		age  time.Duration
+		want float64
+	}{
+		{name: "new", age: 0, want: 1},
+		{name: "half life", age: time.Hour * 12, want: 0.5},
+		{name: "two half lives", age: time.Hour * 24, want: 0.25},
+		{name: "future", age: -time.Hour, want: 1},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			got := s.Score(testNow, &Viewer{}, &Candidate{Time: testNow.Add(-tt.age)})
+			if !almostEqual(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEngagement(t *testing.T) {
+
+	s := Engagement{LikeWeight: 1, CommentWeight: 2}
+
+	for _, tt := range []struct {
+		name     string
+		likes    int64
+		comments int64
+		want     float64
+	}{
+		{name: "none", want: 0},
+		{name: "likes", likes: 3, want: math.Log1p(3)},
+		{name: "comments", comments: 3, want: math.Log1p(6)},
+		{name: "both", likes: 2, comments: 1, want: math.Log1p(4)},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			got := s.Score(testNow, &Viewer{}, &Candidate{Likes: tt.likes, Comments: tt.comments})
+			if !almostEqual(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAuthorAffinity(t *testing.T) {
+
+	viewer := &Viewer{AuthorAffinity: map[int64]float64{1: 0.75}}
+
+	for _, tt := range []struct {
+		name  string
+		owner int64
+		want  float64
+	}{
+		{name: "known author", owner: 1, want: 0.75},
+		{name: "unknown author", owner: 2, want: 0},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			got := AuthorAffinity{}.Score(testNow, viewer, &Candidate{OwnerId: tt.owner})
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTagAffinity(t *testing.T) {
+
+	viewer := &Viewer{TagAffinity: map[string]float64{"go": 1, "music": 0.25}}
+
+	for _, tt := range []struct {
+		name string
+		tags []string
+		want float64
+	}{
+		{name: "no tags", want: 0},
+		{name: "unknown tag", tags: []string{"cats"}, want: 0},
+		{name: "best tag", tags: []string{"music", "go"}, want: 1},
+		{name: "one tag", tags: []string{"music", "cats"}, want: 0.25},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			got := TagAffinity{}.Score(testNow, viewer, &Candidate{Tags: tt.tags})
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
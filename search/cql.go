@@ -0,0 +1,169 @@
+package search
+
+import (
+	"context"
+
+	"github.com/gocql/gocql"
+)
+
+const (
+	pageSize = 100
+	maxPages = 20
+)
+
+// CQLIndex is an inverted index in the search_index table: a partition
+// per term with the ids of the documents, newest first.
+//
+// A search pages through the partition of the longest term, which is
+// usually the rarest one, and checks the other terms for each page
+// with a single IN query per term. At most maxPages pages are read, so
+// queries with very common terms may return fewer results than asked
+// with the cursor to go on from.
+type CQLIndex struct {
+	cses *gocql.Session
+}
+
+func NewCQLIndex(cses *gocql.Session) *CQLIndex {
+	return &CQLIndex{cses: cses}
+}
+
+func (idx *CQLIndex) Index(ctx context.Context, doc *Document) error {
+
+	terms := Terms(doc.Text, doc.Tags)
+	if len(terms) == 0 {
+		return nil
+	}
+
+	batch := idx.cses.NewBatch(gocql.UnloggedBatch).WithContext(ctx)
+	for _, t := range terms {
+		batch.Query("INSERT INTO search_index (term, id, post_id, owner_id) VALUES (?, ?, ?, ?)", t, doc.Id, doc.PostId, doc.OwnerId)
+	}
+
+	return idx.cses.ExecuteBatch(batch)
+}
+
+func (idx *CQLIndex) Delete(ctx context.Context, doc *Document) error {
+
+	terms := Terms(doc.Text, doc.Tags)
+	if len(terms) == 0 {
+		return nil
+	}
+
+	batch := idx.cses.NewBatch(gocql.UnloggedBatch).WithContext(ctx)
+	for _, t := range terms {
+		batch.Query("DELETE FROM search_index WHERE term = ? AND id = ?", t, doc.Id)
+	}
+
+	return idx.cses.ExecuteBatch(batch)
+}
+
+func (idx *CQLIndex) Search(ctx context.Context, q *Query) (*Result, error) {
+
+	terms := queryTerms(q)
+	if len(terms) == 0 {
+		return nil, ErrEmptyQuery
+	}
+
+	driver := 0
+	for i, t := range terms {
+		if len(t) > len(terms[driver]) {
+			driver = i
+		}
+	}
+	others := append(append([]string{}, terms[:driver]...), terms[driver+1:]...)
+
+	res := &Result{Hits: make([]Hit, 0, q.Limit)}
+
+	// the partition is read until it is exhausted, the limit is reached
+	// or maxPages pages are read, then the search goes on from max_id.
+	max_id := q.MaxId
+	exhausted := false
+	for page := 0; page < maxPages && len(res.Hits) < q.Limit; page++ {
+
+		params := make([]any, 0)
+		params = append(params, terms[driver])
+
+		condition := ""
+
+		if max_id > 0 {
+			condition += " AND id < ?"
+			params = append(params, max_id)
+		}
+		if q.MinId > 0 {
+			condition += " AND id >= ?"
+			params = append(params, q.MinId)
+		}
+
+		params = append(params, pageSize)
+
+		candidates := make([]Hit, 0, pageSize)
+		ids := make([]uint64, 0, pageSize)
+
+		rows := 0
+		var hit Hit
+		var owner_id int64
+		iter := idx.cses.Query("SELECT id, post_id, owner_id FROM search_index WHERE term = ?"+condition+" LIMIT ?", params...).WithContext(ctx).Iter()
+		for iter.Scan(&hit.Id, &hit.PostId, &owner_id) {
+			rows++
+			max_id = hit.Id
+			if q.match(hit.Id, hit.PostId, owner_id) {
+				candidates = append(candidates, hit)
+				ids = append(ids, hit.Id)
+			}
+		}
+		err := iter.Close()
+		if err != nil {
+			return nil, err
+		}
+
+		for _, t := range others {
+			if len(ids) == 0 {
+				break
+			}
+
+			found := make(map[uint64]bool)
+			var id uint64
+			iter := idx.cses.Query("SELECT id FROM search_index WHERE term = ? AND id IN ?", t, ids).WithContext(ctx).Iter()
+			for iter.Scan(&id) {
+				found[id] = true
+			}
+			err := iter.Close()
+			if err != nil {
+				return nil, err
+			}
+
+			filtered := candidates[:0]
+			ids = ids[:0]
+			for _, c := range candidates {
+				if found[c.Id] {
+					filtered = append(filtered, c)
+					ids = append(ids, c.Id)
+				}
+			}
+			candidates = filtered
+		}
+
+		// hits past the limit are left for the next search.
+		for _, c := range candidates {
+			if len(res.Hits) == q.Limit {
+				break
+			}
+			res.Hits = append(res.Hits, c)
+		}
+		if len(res.Hits) == q.Limit && len(res.Hits) > 0 {
+			res.Next = res.Hits[len(res.Hits)-1].Id
+			return res, nil
+		}
+
+		if rows < pageSize {
+			exhausted = true
+			break
+		}
+	}
+
+	if !exhausted {
+		res.Next = max_id
+	}
+
+	return res, nil
+}
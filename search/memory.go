@@ -0,0 +1,96 @@
+package search
+
+import (
+	"context"
+	"sort"
+	"sync"
+)
+
+// MemoryIndex keeps the index in memory. It is meant for development
+// and tests, the index is lost on restart.
+type MemoryIndex struct {
+	mu    sync.RWMutex
+	docs  map[uint64]memoryDoc
+	terms map[string]map[uint64]bool
+}
+
+type memoryDoc struct {
+	postId  uint64
+	ownerId int64
+}
+
+func NewMemoryIndex() *MemoryIndex {
+	return &MemoryIndex{
+		docs:  make(map[uint64]memoryDoc),
+		terms: make(map[string]map[uint64]bool),
+	}
+}
+
+func (idx *MemoryIndex) Index(ctx context.Context, doc *Document) error {
+
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	idx.docs[doc.Id] = memoryDoc{postId: doc.PostId, ownerId: doc.OwnerId}
+	for _, t := range Terms(doc.Text, doc.Tags) {
+		if idx.terms[t] == nil {
+			idx.terms[t] = make(map[uint64]bool)
+		}
+		idx.terms[t][doc.Id] = true
+	}
+
+	return nil
+}
+
+func (idx *MemoryIndex) Delete(ctx context.Context, doc *Document) error {
+
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	delete(idx.docs, doc.Id)
+	for _, t := range Terms(doc.Text, doc.Tags) {
+		delete(idx.terms[t], doc.Id)
+		if len(idx.terms[t]) == 0 {
+			delete(idx.terms, t)
+		}
+	}
+
+	return nil
+}
+
+func (idx *MemoryIndex) Search(ctx context.Context, q *Query) (*Result, error) {
+
+	terms := queryTerms(q)
+	if len(terms) == 0 {
+		return nil, ErrEmptyQuery
+	}
+
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+
+	ids := make([]uint64, 0)
+	for id := range idx.terms[terms[0]] {
+		ok := q.match(id, idx.docs[id].postId, idx.docs[id].ownerId)
+		for _, t := range terms[1:] {
+			ok = ok && idx.terms[t][id]
+		}
+		if ok {
+			ids = append(ids, id)
+		}
+	}
+
+	sort.Slice(ids, func(i, j int) bool { return ids[i] > ids[j] })
+
+	res := &Result{}
+	if len(ids) > q.Limit {
+		ids = ids[:q.Limit]
+		res.Next = ids[len(ids)-1]
+	}
+
+	res.Hits = make([]Hit, 0, len(ids))
+	for _, id := range ids {
+		res.Hits = append(res.Hits, Hit{Id: id, PostId: idx.docs[id].postId})
+	}
+
+	return res, nil
+}
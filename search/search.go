@@ -0,0 +1,121 @@
+package search
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"unicode"
+)
+
+const (
+	minTermLength = 2
+	maxTermLength = 64
+)
+
+// ErrEmptyQuery is returned if the query has no terms to search for.
+var ErrEmptyQuery = errors.New("search: empty query")
+
+// Document is a post or a comment. For a post Id equals PostId.
+type Document struct {
+	Id      uint64
+	PostId  uint64
+	OwnerId int64
+	Text    string
+	Tags    []string
+}
+
+// Query matches the documents that contain every term of Text and,
+// if set, the tag. OwnerId, MinId and MaxId narrow the results down,
+// ids are snowflake ids, so they work as a date range.
+type Query struct {
+	Text    string
+	Tag     string
+	OwnerId int64
+	// MinId is inclusive, MaxId is exclusive. Zero means no bound.
+	MinId uint64
+	MaxId uint64
+	// PostsOnly skips comments.
+	PostsOnly bool
+	// PostIds, if set, narrows the results down to the documents of
+	// these posts.
+	PostIds map[uint64]bool
+	Limit   int
+}
+
+// Hit is a matching document. Hits are returned newest first.
+type Hit struct {
+	Id     uint64
+	PostId uint64
+}
+
+// Result is a page of hits. Next is the MaxId to continue the search
+// from, it is zero when there are no more documents to look at. A page
+// may have fewer hits than the limit and still go on.
+type Result struct {
+	Hits []Hit
+	Next uint64
+}
+
+// Index is a full-text index of posts and comments.
+type Index interface {
+	Index(ctx context.Context, doc *Document) error
+	// Delete removes the document, doc must be the indexed version of it.
+	Delete(ctx context.Context, doc *Document) error
+	Search(ctx context.Context, q *Query) (*Result, error)
+}
+
+// Terms returns the distinct lowercased words of the text and the tags
+// prefixed with #, so tags can not be confused with words.
+func Terms(text string, tags []string) []string {
+
+	res := make([]string, 0)
+	seen := make(map[string]bool)
+
+	add := func(term string) {
+		if n := len([]rune(term)); n < minTermLength || n > maxTermLength || seen[term] {
+			return
+		}
+		seen[term] = true
+		res = append(res, term)
+	}
+
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	for _, w := range words {
+		add(w)
+	}
+
+	for _, t := range tags {
+		add("#" + strings.ToLower(t))
+	}
+
+	return res
+}
+
+func queryTerms(q *Query) []string {
+	var tags []string
+	if q.Tag != "" {
+		tags = []string{q.Tag}
+	}
+	return Terms(q.Text, tags)
+}
+
+func (q *Query) match(id uint64, post_id uint64, owner_id int64) bool {
+	if q.PostsOnly && id != post_id {
+		return false
+	}
+	if q.PostIds != nil && !q.PostIds[post_id] {
+		return false
+	}
+	if q.OwnerId != 0 && owner_id != q.OwnerId {
+		return false
+	}
+	if q.MinId != 0 && id < q.MinId {
+		return false
+	}
+	if q.MaxId != 0 && id >= q.MaxId {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,167 @@
+package service
+
+import (
+	"context"
+
+	"github.com/NexusIT-Dev/nexusmicro_publications/pb"
+	"github.com/godruoyi/go-snowflake"
+)
+
+// maxAudienceMembers is the number of members added with one request.
+const maxAudienceMembers = 100
+
+func (s service) CreateAudience(ctx context.Context, req *pb.CreateAudienceRequest) (*pb.CreateAudienceResponse, error) {
+
+	user_id, err := userId(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Name == "" {
+		return nil, ErrEmptyAudienceName
+	}
+
+	id := snowflake.ID()
+
+	err = s.cses.Query("INSERT INTO audiences (owner_id, id, name) VALUES (?, ?, ?)", user_id, id, req.Name).Exec()
+	if err != nil {
+		return nil, ErrInternal(err)
+	}
+
+	added, err := s.addToAudience(user_id, id, req.UserIds)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CreateAudienceResponse{Audience: &pb.Audience{
+		Id:           id,
+		OwnerId:      user_id,
+		Name:         req.Name,
+		MembersCount: added,
+	}}, nil
+}
+
+func (s service) AddToAudience(ctx context.Context, req *pb.AddToAudienceRequest) (*pb.AddToAudienceResponse, error) {
+
+	user_id, err := userId(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.checkAudienceOwner(user_id, req.AudienceId)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = s.addToAudience(user_id, req.AudienceId, req.UserIds)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.AddToAudienceResponse{}, nil
+}
+
+func (s service) ListAudiences(ctx context.Context, req *pb.ListAudiencesRequest) (*pb.ListAudiencesResponse, error) {
+
+	user_id, err := userId(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &pb.ListAudiencesResponse{}
+
+	iter := s.cses.Query("SELECT id, name FROM audiences WHERE owner_id = ?", user_id).Iter()
+
+	tmpaudience := &pb.Audience{OwnerId: user_id}
+	for iter.Scan(&tmpaudience.Id, &tmpaudience.Name) {
+		res.Audiences = append(res.Audiences, tmpaudience)
+		tmpaudience = &pb.Audience{OwnerId: user_id}
+	}
+
+	err = iter.Close()
+	if err != nil {
+		return nil, ErrInternal(err)
+	}
+
+	counts := make(map[uint64]int64)
+
+	var id uint64
+	var members int64
+	iter = s.cses.Query("SELECT audience_id, members FROM audience_counts WHERE owner_id = ?", user_id).Iter()
+	for iter.Scan(&id, &members) {
+		counts[id] = members
+	}
+
+	err = iter.Close()
+	if err != nil {
+		return nil, ErrInternal(err)
+	}
+
+	for _, audience := range res.Audiences {
+		audience.MembersCount = counts[audience.Id]
+	}
+
+	return res, nil
+}
+
+// checkAudienceOwner returns ErrAudienceNotFound if the audience does not
+// belong to the user.
+func (s service) checkAudienceOwner(user_id int64, audience_id uint64) error {
+
+	var cntaudiences int
+	err := s.cses.Query("SELECT Count(*) FROM audiences WHERE owner_id = ? AND id = ?", user_id, audience_id).Scan(&cntaudiences)
+	if err != nil {
+		return ErrInternal(err)
+	}
+	if cntaudiences != 1 {
+		return ErrAudienceNotFound
+	}
+
+	return nil
+}
+
+// addToAudience writes the members to both audience_members and
+// audiences_by_member, the latter is used by the read paths, and counts
+// the new members in audience_counts. A member is counted only when its
+// conditional insert is applied, so duplicates, existing members and
+// concurrent adds of the same member are not counted twice.
+func (s service) addToAudience(owner_id int64, audience_id uint64, user_ids []int64) (int64, error) {
+
+	if len(user_ids) > maxAudienceMembers {
+		return 0, ErrTooManyAudienceMembers
+	}
+
+	var added int64
+	unique := make(map[int64]bool, len(user_ids))
+	for _, id := range user_ids {
+		if unique[id] {
+			continue
+		}
+		unique[id] = true
+
+		applied, err := s.cses.Query("INSERT INTO audience_members (audience_id, user_id) VALUES (?, ?) IF NOT EXISTS", audience_id, id).MapScanCAS(make(map[string]interface{}))
+		if err != nil {
+			return 0, ErrInternal(err)
+		}
+		if !applied {
+			continue
+		}
+
+		err = s.cses.Query("INSERT INTO audiences_by_member (user_id, audience_id) VALUES (?, ?)", id, audience_id).Exec()
+		if err != nil {
+			return 0, ErrInternal(err)
+		}
+		added++
+	}
+
+	if added == 0 {
+		return 0, nil
+	}
+
+	err := s.cses.Query("UPDATE audience_counts SET members = members + ? WHERE owner_id = ? AND audience_id = ?", added, owner_id, audience_id).Exec()
+	if err != nil {
+		return 0, ErrInternal(err)
+	}
+
+	return added, nil
+}
@@ -0,0 +1,327 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/NexusIT-Dev/nexusmicro_publications/jwks"
+	"github.com/go-kit/log"
+	"github.com/golang-jwt/jwt/v5"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// PrincipalKind is the kind of the authenticated caller.
+type PrincipalKind int
+
+const (
+	// PrincipalUser is a user, the subject of the token is "user".
+	PrincipalUser PrincipalKind = iota
+	// PrincipalGuest is a caller without a token, allowed only for the
+	// anonymous methods of AuthConfig. Its UserId is 0.
+	PrincipalGuest
+	// PrincipalService is an internal service with a service account
+	// token, the subject of the token is "service". With the ScopeActAs
+	// scope it may act on behalf of the user in the actAsHeader, UserId
+	// is 0 otherwise.
+	PrincipalService
+)
+
+// defaultUserScopes are the scopes of a user token without a scope
+// claim. The stats RPCs only return the statistics of the own posts.
+var defaultUserScopes = []string{ScopeRead, ScopeWrite, ScopeStats}
+
+// ScopeActAs allows a service account to act on behalf of a user.
+const ScopeActAs = "posts.act_as"
+
+// actAsHeader is the metadata key with the id of the user a service
+// account acts on behalf of.
+const actAsHeader = "x-act-as-user"
+
+// Principal is the authenticated caller of an RPC.
+type Principal struct {
+	UserId int64
+	Kind   PrincipalKind
+	// Service is the name of the service account.
+	Service string
+	Scopes  []string
+	Roles   []string
+}
+
+func (p *Principal) HasScope(scope string) bool {
+	for _, s := range p.Scopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *Principal) HasRole(role string) bool {
+	for _, r := range p.Roles {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
+
+type principalKey struct{}
+
+// WithPrincipal returns a copy of the context that carries the principal.
+func WithPrincipal(ctx context.Context, p *Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, p)
+}
+
+// PrincipalFromContext returns the principal set by the interceptor.
+func PrincipalFromContext(ctx context.Context) (*Principal, bool) {
+	p, ok := ctx.Value(principalKey{}).(*Principal)
+	return p, ok && p != nil
+}
+
+// userId returns the id of the user calling the RPC.
+func userId(ctx context.Context) (int64, error) {
+	p, ok := PrincipalFromContext(ctx)
+	if !ok {
+		return 0, ErrInvalidAccessToken
+	}
+	switch {
+	case p.Kind == PrincipalUser:
+		return p.UserId, nil
+	case p.Kind == PrincipalService && p.UserId != 0:
+		return p.UserId, nil
+	case p.Kind == PrincipalService:
+		return 0, ErrNoActingUser
+	default:
+		return 0, ErrUnknownSubject
+	}
+}
+
+// viewerId returns the id of the user calling a read RPC that guests are
+// allowed to call, 0 for a guest.
+func viewerId(ctx context.Context) (int64, error) {
+	p, ok := PrincipalFromContext(ctx)
+	if ok && p.Kind == PrincipalGuest {
+		return 0, nil
+	}
+	return userId(ctx)
+}
+
+// tokenClaims are the claims of an access token. The id of the user or
+// the name of the service account is stored in jti, scopes are space
+// separated as in OAuth 2.0.
+type tokenClaims struct {
+	jwt.RegisteredClaims
+	Scope string   `json:"scope,omitempty"`
+	Roles []string `json:"roles,omitempty"`
+}
+
+func (c *tokenClaims) principal() (*Principal, error) {
+
+	p := &Principal{
+		Scopes: strings.Fields(c.Scope),
+		Roles:  c.Roles,
+	}
+
+	switch c.Subject {
+	case "user":
+		user_id, err := strconv.ParseInt(c.ID, 10, 64)
+		if err != nil {
+			return nil, ErrInvalidAccessToken
+		}
+		p.Kind = PrincipalUser
+		p.UserId = user_id
+		// user tokens issued before the policies have no scope claim.
+		if c.Scope == "" {
+			p.Scopes = defaultUserScopes
+		}
+	case "service":
+		if c.ID == "" {
+			return nil, ErrInvalidAccessToken
+		}
+		p.Kind = PrincipalService
+		p.Service = c.ID
+	default:
+		return nil, ErrUnknownSubject
+	}
+
+	return p, nil
+}
+
+// AuthConfig configures the verification of access tokens. If Keys is set,
+// tokens must be signed with RS256, ES256 or EdDSA by the key of the set
+// named in the kid header, otherwise they are signed with HMAC by
+// SigningKey. Tokens must expire. Issuer and Audience are checked if set.
+//
+// A service account acting on behalf of a user calls the other services
+// with its token and actAsHeader, they must resolve the user from it.
+//
+// Requests without a token are allowed only to the Anonymous methods,
+// which must support guests, and get a guest principal. The metadata of
+// guests is not forwarded, their calls to the other services carry the
+// service account token instead.
+type AuthConfig struct {
+	SigningKey []byte
+	Keys       *jwks.KeySet
+	Issuer     string
+	Audience   string
+	Anonymous  map[string]bool
+}
+
+func GetUnaryInterceptor(cfg AuthConfig, logger log.Logger) grpc.UnaryServerInterceptor {
+
+	options := parserOptions(cfg)
+
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+
+		ctx, err = authenticate(ctx, cfg, options, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// GetStreamInterceptor authenticates streaming RPCs like
+// GetUnaryInterceptor, the principal is set on the context of the stream.
+func GetStreamInterceptor(cfg AuthConfig, logger log.Logger) grpc.StreamServerInterceptor {
+
+	options := parserOptions(cfg)
+
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+
+		ctx, err := authenticate(ss.Context(), cfg, options, info.FullMethod)
+		if err != nil {
+			return err
+		}
+
+		return handler(srv, &serverStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+// serverStream is a grpc.ServerStream with a replaced context.
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
+
+func parserOptions(cfg AuthConfig) []jwt.ParserOption {
+
+	options := []jwt.ParserOption{jwt.WithExpirationRequired()}
+	if cfg.Keys != nil {
+		options = append(options, jwt.WithValidMethods([]string{"RS256", "ES256", "EdDSA"}))
+	}
+	if cfg.Issuer != "" {
+		options = append(options, jwt.WithIssuer(cfg.Issuer))
+	}
+	if cfg.Audience != "" {
+		options = append(options, jwt.WithAudience(cfg.Audience))
+	}
+
+	return options
+}
+
+// authenticate validates the access token of the call and returns the
+// context with the principal. The incoming metadata is forwarded to the
+// other services as outgoing.
+func authenticate(ctx context.Context, cfg AuthConfig, options []jwt.ParserOption, fullMethod string) (context.Context, error) {
+
+	token := ""
+
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, ErrInternal(fmt.Errorf("failed to get metadata"))
+	}
+	headertoken := md.Get("authorization")
+	if headertoken != nil && len(headertoken) == 1 {
+		stringsheader := strings.Split(headertoken[0], " ")
+
+		if stringsheader[0] != "Bearer" {
+			return nil, ErrInvalidAccessToken
+		}
+		if len(stringsheader) != 2 {
+			return nil, ErrInvalidAccessToken
+		}
+		token = stringsheader[1]
+	} else if headertoken == nil && cfg.Anonymous[path.Base(fullMethod)] {
+		ctx = WithPrincipal(ctx, &Principal{Kind: PrincipalGuest})
+		ctx = metadata.NewOutgoingContext(ctx, metadata.MD{})
+		return ctx, nil
+	} else {
+		return nil, ErrInvalidAccessToken
+	}
+
+	jwttoken, err := jwt.ParseWithClaims(token, &tokenClaims{}, func(token *jwt.Token) (interface{}, error) {
+		if cfg.Keys != nil {
+			return verificationKey(ctx, cfg.Keys, token)
+		}
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("Unexpected signing method: %v", token.Header["alg"])
+		}
+		return cfg.SigningKey, nil
+	}, options...)
+
+	if err != nil {
+		return nil, ErrInvalidAccessToken
+	}
+
+	claims, ok := jwttoken.Claims.(*tokenClaims)
+	if !ok || !jwttoken.Valid {
+		return nil, ErrInvalidAccessToken
+	}
+
+	principal, err := claims.principal()
+	if err != nil {
+		return nil, err
+	}
+
+	if actas := md.Get(actAsHeader); len(actas) != 0 {
+		if principal.Kind != PrincipalService || !principal.HasScope(ScopeActAs) || len(actas) != 1 {
+			return nil, ErrPermissionDenied
+		}
+		principal.UserId, err = strconv.ParseInt(actas[0], 10, 64)
+		if err != nil || principal.UserId <= 0 {
+			return nil, ErrInvalidMetadata
+		}
+	}
+
+	ctx = WithPrincipal(ctx, principal)
+	if principal.Kind == PrincipalService && principal.UserId != 0 {
+		// the other services take the user of the call from actAsHeader
+		// next to the service token, it is set from the principal and
+		// nothing else of the caller is forwarded.
+		ctx = metadata.NewOutgoingContext(ctx, metadata.Pairs("authorization", headertoken[0], actAsHeader, strconv.FormatInt(principal.UserId, 10)))
+	} else {
+		ctx = metadata.NewOutgoingContext(ctx, md)
+	}
+
+	return ctx, nil
+}
+
+// verificationKey returns the key of the set that verifies the token.
+func verificationKey(ctx context.Context, keys *jwks.KeySet, token *jwt.Token) (interface{}, error) {
+
+	kid, _ := token.Header["kid"].(string)
+	if kid == "" {
+		return nil, fmt.Errorf("missing kid")
+	}
+
+	key, err := keys.Key(ctx, kid)
+	if err != nil {
+		return nil, err
+	}
+
+	if key.Alg != "" && key.Alg != token.Method.Alg() {
+		return nil, fmt.Errorf("Unexpected signing method: %v", token.Header["alg"])
+	}
+
+	return key.PublicKey, nil
+}
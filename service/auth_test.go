@@ -0,0 +1,86 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-kit/log"
+	"github.com/golang-jwt/jwt/v5"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// outgoing runs the auth interceptor and returns the metadata the
+// handler would send to the other services.
+func outgoing(md metadata.MD) (metadata.MD, *Principal, error) {
+
+	auth := GetUnaryInterceptor(AuthConfig{SigningKey: testSigningKey}, log.NewNopLogger())
+	info := &grpc.UnaryServerInfo{FullMethod: "/Posts/GetTagsFeed"}
+
+	var out metadata.MD
+	var principal *Principal
+	ctx := metadata.NewIncomingContext(context.Background(), md)
+	_, err := auth(ctx, nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		out, _ = metadata.FromOutgoingContext(ctx)
+		principal, _ = PrincipalFromContext(ctx)
+		return nil, nil
+	})
+
+	return out, principal, err
+}
+
+func TestAuthActAsForwardsUser(t *testing.T) {
+
+	token := testToken(t, &tokenClaims{RegisteredClaims: jwt.RegisteredClaims{Subject: "service", ID: "linkedacc"}, Scope: ScopeActAs})
+
+	md := metadata.Pairs("authorization", "Bearer "+token, actAsHeader, "42", "x-other", "value")
+	out, p, err := outgoing(md)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if p.Kind != PrincipalService || p.UserId != 42 {
+		t.Errorf("got principal %+v", p)
+	}
+	if got := out.Get(actAsHeader); len(got) != 1 || got[0] != "42" {
+		t.Errorf("%s: got %v, want [42]", actAsHeader, got)
+	}
+	if got := out.Get("authorization"); len(got) != 1 || got[0] != "Bearer "+token {
+		t.Errorf("authorization: got %v", got)
+	}
+	if got := out.Get("x-other"); len(got) != 0 {
+		t.Errorf("x-other is forwarded: %v", got)
+	}
+}
+
+func TestAuthActAsRequiresScope(t *testing.T) {
+
+	for _, claims := range []*tokenClaims{
+		{RegisteredClaims: jwt.RegisteredClaims{Subject: "service", ID: "linkedacc"}},
+		{RegisteredClaims: jwt.RegisteredClaims{Subject: "user", ID: "5"}, Scope: ScopeActAs},
+	} {
+		token := testToken(t, claims)
+
+		_, _, err := outgoing(metadata.Pairs("authorization", "Bearer "+token, actAsHeader, "42"))
+		if status.Code(err) != status.Code(ErrPermissionDenied) {
+			t.Errorf("%s %s: got %v, want %v", claims.Subject, claims.ID, err, ErrPermissionDenied)
+		}
+	}
+}
+
+func TestAuthUserForwardsToken(t *testing.T) {
+
+	token := testToken(t, &tokenClaims{RegisteredClaims: jwt.RegisteredClaims{Subject: "user", ID: "5"}})
+
+	out, _, err := outgoing(metadata.Pairs("authorization", "Bearer "+token))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := out.Get("authorization"); len(got) != 1 || got[0] != "Bearer "+token {
+		t.Errorf("authorization: got %v", got)
+	}
+	if got := out.Get(actAsHeader); len(got) != 0 {
+		t.Errorf("%s: got %v", actAsHeader, got)
+	}
+}
@@ -0,0 +1,382 @@
+package service
+
+import (
+	"context"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/NexusIT-Dev/nexusmicro_publications/pb"
+	"github.com/go-kit/log"
+	"github.com/go-kit/log/level"
+	"github.com/gocql/gocql"
+	"github.com/godruoyi/go-snowflake"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultAuthorStatsWindow = time.Hour * 24 * 30
+	maxAuthorStatsWindow     = time.Hour * 24 * 366
+	// maxAuthorStatsPosts bounds the posts aggregated by GetAuthorStats,
+	// the newest ones are taken.
+	maxAuthorStatsPosts = 1000
+	maxTopPosts         = 10
+	bestHoursCount      = 3
+	// minCorrelationDays is the number of days with a known follower
+	// change needed to compute the correlation.
+	minCorrelationDays = 3
+	// followerSnapshotBatch is the number of users requested at once by
+	// FollowerSnapshots.
+	followerSnapshotBatch = 100
+)
+
+type authorPost struct {
+	id         uint64
+	time       time.Time
+	engagement int64
+}
+
+// snapshotFollowers stores the current number of subscribers of the user
+// for today and registers the user in follower_authors, so that
+// FollowerSnapshots takes the next snapshots. The users service keeps no
+// history, so follower growth is only known from the first request of
+// the statistics on.
+func (s service) snapshotFollowers(ctx context.Context, user_id int64) error {
+
+	userres, err := s.userscli.GetAuthUser(ctx, &pb.GetAuthUserRequest{Fields: []pb.UserFields{pb.UserFields_subscribersCount}})
+	if err != nil {
+		if status.Code(err) == codes.Unavailable {
+			return ErrServiceUsersUnvaliable
+		}
+		return err
+	}
+
+	err = s.cses.Query("INSERT INTO follower_authors (owner_id) VALUES (?)", user_id).Exec()
+	if err != nil {
+		return ErrInternal(err)
+	}
+
+	return s.saveFollowers(user_id, int64(userres.GetUser().GetSubscribersCount()))
+}
+
+func (s store) saveFollowers(user_id int64, subscribers int64) error {
+
+	day := truncateStatsTime(time.Now(), pb.StatsGranularity_day)
+
+	err := s.cses.Query("INSERT INTO follower_snapshots (owner_id, day, subscribers) VALUES (?, ?, ?)", user_id, day, subscribers).Exec()
+	if err != nil {
+		return ErrInternal(err)
+	}
+
+	return nil
+}
+
+// FollowerSnapshots periodically stores the number of subscribers of the
+// users in follower_authors, so follower growth does not depend on the
+// days the users open their statistics. A snapshot only overwrites the
+// one of the same day, so every replica may run it.
+type FollowerSnapshots struct {
+	s        store
+	userscli pb.UsersClient
+	interval time.Duration
+}
+
+func NewFollowerSnapshots(cses *gocql.Session, userscli pb.UsersClient, interval time.Duration, logger log.Logger) *FollowerSnapshots {
+	return &FollowerSnapshots{
+		s: store{
+			cses:   cses,
+			logger: logger,
+		},
+		userscli: userscli,
+		interval: interval,
+	}
+}
+
+// Run takes the snapshots every interval until the context is done.
+func (f *FollowerSnapshots) Run(ctx context.Context) {
+
+	ticker := time.NewTicker(f.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		err := f.snapshot(ctx)
+		if err != nil {
+			level.Error(f.s.logger).Log("msg", "failed to snapshot followers", "err", err)
+		}
+	}
+}
+
+func (f *FollowerSnapshots) snapshot(ctx context.Context) error {
+
+	ids := make([]int64, 0, followerSnapshotBatch)
+
+	var id int64
+	iter := f.s.cses.Query("SELECT owner_id FROM follower_authors").WithContext(ctx).Iter()
+	for iter.Scan(&id) {
+		ids = append(ids, id)
+		if len(ids) == followerSnapshotBatch {
+			err := f.save(ctx, ids)
+			if err != nil {
+				iter.Close()
+				return err
+			}
+			ids = ids[:0]
+		}
+	}
+
+	err := iter.Close()
+	if err != nil {
+		return err
+	}
+
+	if len(ids) == 0 {
+		return nil
+	}
+
+	return f.save(ctx, ids)
+}
+
+// save stores the number of subscribers of the users. The call carries
+// the service account token, as there is no user to act for.
+func (f *FollowerSnapshots) save(ctx context.Context, ids []int64) error {
+
+	usersres, err := f.userscli.GetUsersByIds(ctx, &pb.GetUsersByIdsRequest{Ids: ids, Fields: []pb.UserFields{pb.UserFields_subscribersCount}})
+	if err != nil {
+		return err
+	}
+
+	for _, user := range usersres.Users {
+		err = f.s.saveFollowers(user.Id, int64(user.GetSubscribersCount()))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// followerGrowth returns the change of the number of subscribers per day,
+// for the days that have a snapshot of the previous day too.
+func (s service) followerGrowth(user_id int64, from time.Time, to time.Time) (map[time.Time]int64, error) {
+
+	res := make(map[time.Time]int64)
+	snapshots := make(map[time.Time]int64)
+
+	var day time.Time
+	var subscribers int64
+
+	iter := s.cses.Query("SELECT day, subscribers FROM follower_snapshots WHERE owner_id = ? AND day >= ? AND day < ?", user_id, from.Add(-time.Hour*24), to).Iter()
+	for iter.Scan(&day, &subscribers) {
+		snapshots[day.UTC()] = subscribers
+	}
+
+	err := iter.Close()
+	if err != nil {
+		return nil, ErrInternal(err)
+	}
+
+	for day, subscribers := range snapshots {
+		if prev, ok := snapshots[day.Add(-time.Hour*24)]; ok {
+			res[day] = subscribers - prev
+		}
+	}
+
+	return res, nil
+}
+
+// correlation returns the Pearson correlation coefficient of x and y, or
+// false if it is undefined.
+func correlation(x []float64, y []float64) (float64, bool) {
+
+	n := float64(len(x))
+	if len(x) < 2 || len(x) != len(y) {
+		return 0, false
+	}
+
+	var mx, my float64
+	for i := range x {
+		mx += x[i]
+		my += y[i]
+	}
+	mx /= n
+	my /= n
+
+	var cov, vx, vy float64
+	for i := range x {
+		cov += (x[i] - mx) * (y[i] - my)
+		vx += (x[i] - mx) * (x[i] - mx)
+		vy += (y[i] - my) * (y[i] - my)
+	}
+
+	if vx == 0 || vy == 0 {
+		return 0, false
+	}
+
+	return cov / math.Sqrt(vx*vy), true
+}
+
+func (s service) GetAuthorStats(ctx context.Context, req *pb.GetAuthorStatsRequest) (*pb.GetAuthorStatsResponse, error) {
+
+	user_id, err := userId(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.TopLimit < 0 || req.TopLimit > maxTopPosts {
+		return nil, ErrLimitError
+	}
+
+	loc := time.UTC
+	if req.TimeZone != "" {
+		loc, err = time.LoadLocation(req.TimeZone)
+		if err != nil {
+			return nil, ErrInvalidTimeZone
+		}
+	}
+
+	to := time.Now()
+	if req.To != nil {
+		to = req.To.AsTime()
+	}
+	from := to.Add(-defaultAuthorStatsWindow)
+	if req.From != nil {
+		from = req.From.AsTime()
+	}
+	if !to.After(from) || to.Sub(from) > maxAuthorStatsWindow {
+		return nil, ErrInvalidStatsRange
+	}
+
+	err = s.snapshotFollowers(ctx, user_id)
+	if err != nil {
+		return nil, err
+	}
+
+	posts := make([]*authorPost, 0)
+
+	var id uint64
+	iter := s.cses.Query("SELECT id FROM posts_by_owner_id WHERE owner_id = ? AND id >= ? AND id < ? LIMIT ?", user_id, idFromTime(from), idFromTime(to), maxAuthorStatsPosts+1).Iter()
+	for iter.Scan(&id) {
+		sid := snowflake.ParseID(id)
+		posts = append(posts, &authorPost{id: id, time: sid.GenerateTime()})
+	}
+
+	err = iter.Close()
+	if err != nil {
+		return nil, ErrInternal(err)
+	}
+
+	res := &pb.GetAuthorStatsResponse{}
+
+	if len(posts) > maxAuthorStatsPosts {
+		posts = posts[:maxAuthorStatsPosts]
+		res.Truncated = true
+	}
+
+	res.TotalPosts = int64(len(posts))
+
+	hours := make([]*pb.HourStats, 24)
+	for i := range hours {
+		hours[i] = &pb.HourStats{Hour: int32(i)}
+	}
+
+	engagement := make(map[time.Time]int64)
+
+	ids := make([]uint64, 0, len(posts))
+	for _, p := range posts {
+		ids = append(ids, p.id)
+	}
+
+	counts, err := s.engagementCounts(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, p := range posts {
+
+		c := counts[p.id]
+
+		res.TotalLikes += c.likes
+		res.TotalComments += c.comments
+		res.TotalImpressions += c.views
+
+		p.engagement = c.likes + c.comments
+
+		h := hours[p.time.In(loc).Hour()]
+		h.Posts++
+		h.Engagement += p.engagement
+
+		engagement[truncateStatsTime(p.time, pb.StatsGranularity_day)] += p.engagement
+	}
+
+	if res.TotalImpressions > 0 {
+		res.EngagementRate = float64(res.TotalLikes+res.TotalComments) / float64(res.TotalImpressions)
+	}
+
+	for _, h := range hours {
+		if h.Posts > 0 {
+			h.AvgEngagement = float64(h.Engagement) / float64(h.Posts)
+		}
+	}
+	res.Hours = hours
+
+	best := make([]*pb.HourStats, 0, len(hours))
+	for _, h := range hours {
+		if h.Posts > 0 {
+			best = append(best, h)
+		}
+	}
+	sort.SliceStable(best, func(i, j int) bool { return best[i].AvgEngagement > best[j].AvgEngagement })
+	for i := 0; i < len(best) && i < bestHoursCount; i++ {
+		res.BestHours = append(res.BestHours, best[i].Hour)
+	}
+
+	growth, err := s.followerGrowth(user_id, truncateStatsTime(from, pb.StatsGranularity_day), to)
+	if err != nil {
+		return nil, err
+	}
+	if len(growth) >= minCorrelationDays {
+		x := make([]float64, 0, len(growth))
+		y := make([]float64, 0, len(growth))
+		for day, delta := range growth {
+			x = append(x, float64(engagement[day]))
+			y = append(y, float64(delta))
+		}
+		if c, ok := correlation(x, y); ok {
+			res.FollowerGrowthCorrelation = &c
+		}
+	}
+
+	if req.TopLimit > 0 && len(posts) > 0 {
+		sort.SliceStable(posts, func(i, j int) bool { return posts[i].engagement > posts[j].engagement })
+
+		for _, p := range posts {
+			if len(res.TopPosts) == int(req.TopLimit) {
+				break
+			}
+
+			post, row, err := s.getPost(p.id)
+			if err != nil {
+				if err == ErrPostNotFound {
+					continue
+				}
+				return nil, err
+			}
+
+			err = s.fillPost(ctx, user_id, post, row, nil)
+			if err != nil {
+				return nil, err
+			}
+
+			res.TopPosts = append(res.TopPosts, post)
+		}
+	}
+
+	return res, nil
+}
@@ -0,0 +1,177 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/go-kit/log"
+	"github.com/go-kit/log/level"
+	"github.com/gocql/gocql"
+	"github.com/godruoyi/go-snowflake"
+)
+
+const (
+	statsBackfillLease = "stats_backfill"
+	// statsBackfillLeaseTTL is renewed every statsBackfillBatch posts.
+	statsBackfillLeaseTTL = time.Minute * 5
+	statsBackfillBatch    = 100
+	// statsBackfillRetry is how often a replica checks whether the
+	// backfill is done or its holder has stopped.
+	statsBackfillRetry = time.Minute
+)
+
+// errLeaseLost stops a job whose lease was taken over by another replica.
+var errLeaseLost = errors.New("lease lost")
+
+// statsEpoch is the post_stats hour that holds the likes and the
+// comments made before the counters existed.
+var statsEpoch = time.Unix(0, 0).UTC()
+
+// StatsBackfill adds the likes and the comments saved before post_stats
+// existed to its counters. Until it is done, the readers of the totals
+// count the likes and comments tables instead, see statsBackfilled.
+//
+// Only the holder of the stats_backfill lease runs it. The difference
+// between the rows and the counters of a post is added, so a run that
+// stops is repeated from the start and posts that are already filled
+// get nothing. A like made while its post is filled may be counted off
+// by one.
+type StatsBackfill struct {
+	s     store
+	owner string
+}
+
+func NewStatsBackfill(cses *gocql.Session, logger log.Logger) *StatsBackfill {
+	return &StatsBackfill{
+		s: store{
+			cses:   cses,
+			logger: logger,
+		},
+		owner: strconv.FormatUint(snowflake.ID(), 10),
+	}
+}
+
+// Run fills the counters until they are filled on some replica or the
+// context is done.
+func (b *StatsBackfill) Run(ctx context.Context) {
+
+	ticker := time.NewTicker(statsBackfillRetry)
+	defer ticker.Stop()
+
+	for {
+		done, err := b.s.statsBackfilled(ctx)
+		if err != nil {
+			level.Error(b.s.logger).Log("msg", "failed to read stats backfill state", "err", err)
+		}
+		if done {
+			return
+		}
+
+		ok, err := b.s.lease(ctx, statsBackfillLease, b.owner, statsBackfillLeaseTTL)
+		if err != nil {
+			level.Error(b.s.logger).Log("msg", "failed to take stats backfill lease", "err", err)
+		}
+
+		if ok {
+			err = b.backfill(ctx)
+			if err != nil {
+				level.Error(b.s.logger).Log("msg", "failed to backfill post stats", "err", err)
+			} else {
+				level.Info(b.s.logger).Log("msg", "post stats backfilled")
+				return
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (b *StatsBackfill) backfill(ctx context.Context) error {
+
+	filled := 0
+
+	// a post with both likes and comments is filled twice, the second
+	// time adds nothing.
+	for _, table := range []string{"likes", "comments"} {
+
+		var id uint64
+		iter := b.s.cses.Query("SELECT DISTINCT post_id FROM " + table).WithContext(ctx).Iter()
+		for iter.Scan(&id) {
+			err := b.fill(ctx, id)
+			if err != nil {
+				iter.Close()
+				return err
+			}
+
+			filled++
+			if filled%statsBackfillBatch == 0 {
+				ok, err := b.s.lease(ctx, statsBackfillLease, b.owner, statsBackfillLeaseTTL)
+				if err != nil {
+					iter.Close()
+					return err
+				}
+				if !ok {
+					iter.Close()
+					return errLeaseLost
+				}
+			}
+		}
+
+		err := iter.Close()
+		if err != nil {
+			return err
+		}
+	}
+
+	return b.s.cses.Query("INSERT INTO stats_state (id, backfilled) VALUES (0, true)").WithContext(ctx).Exec()
+}
+
+// fill adds the difference between the likes and comments rows of the
+// post and its counters to the counters of statsEpoch.
+func (b *StatsBackfill) fill(ctx context.Context, post_id uint64) error {
+
+	var likes, comments, countedlikes, countedcomments int64
+
+	err := b.s.cses.Query("SELECT Count(*) FROM likes WHERE post_id = ?", post_id).WithContext(ctx).Scan(&likes)
+	if err != nil {
+		return err
+	}
+
+	err = b.s.cses.Query("SELECT Count(*) FROM comments WHERE post_id = ?", post_id).WithContext(ctx).Scan(&comments)
+	if err != nil {
+		return err
+	}
+
+	err = b.s.cses.Query("SELECT SUM(likes), SUM(comments) FROM post_stats WHERE post_id = ?", post_id).WithContext(ctx).Scan(&countedlikes, &countedcomments)
+	if err != nil {
+		return err
+	}
+
+	if likes == countedlikes && comments == countedcomments {
+		return nil
+	}
+
+	return b.s.cses.Query("UPDATE post_stats SET likes = likes + ?, comments = comments + ? WHERE post_id = ? AND hour = ?", likes-countedlikes, comments-countedcomments, post_id, statsEpoch).WithContext(ctx).Exec()
+}
+
+// statsBackfilled reports whether StatsBackfill is done, so the likes
+// and comments counters of post_stats hold the totals.
+func (s store) statsBackfilled(ctx context.Context) (bool, error) {
+
+	var backfilled bool
+	err := s.cses.Query("SELECT backfilled FROM stats_state WHERE id = 0").WithContext(ctx).Scan(&backfilled)
+	if err != nil {
+		if err == gocql.ErrNotFound {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return backfilled, nil
+}
@@ -0,0 +1,101 @@
+package service
+
+import (
+	"context"
+
+	"github.com/NexusIT-Dev/nexusmicro_publications/pb"
+	"github.com/NexusIT-Dev/nexusmicro_publications/pubsub"
+	"github.com/gocql/gocql"
+)
+
+// DeletePost removes the post with everything that refers to it: the
+// comments, likes, poll, counters, hashtags, mentions and the documents
+// of the search index.
+func (s service) DeletePost(ctx context.Context, req *pb.DeletePostRequest) (*pb.DeletePostResponse, error) {
+
+	user_id, err := userId(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	post, row, err := s.getPost(req.PostId)
+	if err != nil {
+		return nil, err
+	}
+	if post.OwnerId != user_id {
+		return nil, ErrPostNotFound
+	}
+
+	// the comments are removed from the mentions and the search index
+	// first, their texts are lost once the partition is deleted.
+	iter := s.cses.Query("SELECT id, owner_id, message, mentions FROM comments WHERE post_id = ?", post.Id).Iter()
+
+	tmpcomment := &pb.Comment{PostId: post.Id}
+	mentions := []mention{}
+	for iter.Scan(&tmpcomment.Id, &tmpcomment.OwnerId, &tmpcomment.Message, &mentions) {
+		err = s.saveMentions(tmpcomment.Id, post.Id, tmpcomment.OwnerId, fromMentions(mentions), nil)
+		if err != nil {
+			iter.Close()
+			return nil, err
+		}
+
+		err = s.deleteDocument(ctx, commentDocument(tmpcomment))
+		if err != nil {
+			iter.Close()
+			return nil, err
+		}
+
+		tmpcomment = &pb.Comment{PostId: post.Id}
+		mentions = []mention{}
+	}
+
+	err = iter.Close()
+	if err != nil {
+		return nil, ErrInternal(err)
+	}
+
+	err = s.saveTags(post.Id, user_id, post.Message, "")
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.saveMentions(post.Id, post.Id, user_id, fromMentions(row.mentions), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.deleteDocument(ctx, postDocument(post))
+	if err != nil {
+		return nil, err
+	}
+
+	if row.has_poll {
+		err = s.deletePoll(post.Id)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = s.deleteStats(post.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	batch := s.cses.NewBatch(gocql.LoggedBatch)
+	batch.Query("DELETE FROM comments WHERE post_id = ?", post.Id)
+	batch.Query("DELETE FROM likes WHERE post_id = ?", post.Id)
+	batch.Query("DELETE FROM posts WHERE bucket = ? AND id = ?", s.bucket(post.Id), post.Id)
+
+	err = s.cses.ExecuteBatch(batch)
+	if err != nil {
+		return nil, ErrInternal(err)
+	}
+
+	s.publish(ctx, postTopic(post.Id), pubsub.Event{Type: pubsub.PostDeleted, PostId: post.Id, OwnerId: post.OwnerId})
+
+	if s.federation != nil {
+		s.federation.PostDeleted(post)
+	}
+
+	return &pb.DeletePostResponse{}, nil
+}
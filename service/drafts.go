@@ -0,0 +1,246 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/NexusIT-Dev/nexusmicro_publications/pb"
+	"github.com/gocql/gocql"
+	"github.com/godruoyi/go-snowflake"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// linkedAccount is the cql representation of pb.LinkedAccountInp.
+type linkedAccount struct {
+	Id      int64 `cql:"id"`
+	Service int32 `cql:"service"`
+}
+
+func toLinkedAccounts(ids []*pb.LinkedAccountInp) []linkedAccount {
+	res := make([]linkedAccount, 0, len(ids))
+	for _, v := range ids {
+		res = append(res, linkedAccount{Id: v.Id, Service: int32(v.Service)})
+	}
+	return res
+}
+
+func fromLinkedAccounts(acc []linkedAccount) []*pb.LinkedAccountInp {
+	res := make([]*pb.LinkedAccountInp, 0, len(acc))
+	for _, v := range acc {
+		res = append(res, &pb.LinkedAccountInp{Id: v.Id, Service: pb.ExternalService(v.Service)})
+	}
+	return res
+}
+
+func (s service) SaveDraft(ctx context.Context, req *pb.SaveDraftRequest) (*pb.SaveDraftResponse, error) {
+
+	user_id, err := userId(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(req.AttachmentsIds) == 0 && req.Message == "" {
+		return nil, ErrEmptyContent
+	}
+
+	id := req.Id
+	if id == 0 {
+		id = snowflake.ID()
+	} else {
+		var cntdrafts int
+		err = s.cses.Query("SELECT Count(*) FROM drafts WHERE owner_id = ? AND id = ?", user_id, id).Scan(&cntdrafts)
+		if err != nil {
+			return nil, ErrInternal(err)
+		}
+		if cntdrafts != 1 {
+			return nil, ErrDraftNotFound
+		}
+	}
+
+	now := time.Now()
+
+	res := &pb.SaveDraftResponse{Draft: &pb.Draft{
+		Id:           id,
+		OwnerId:      user_id,
+		Message:      req.Message,
+		LinkedaccIds: req.LinkedaccIds,
+		Time:         timestamppb.New(now),
+	}}
+
+	if len(req.AttachmentsIds) != 0 {
+		att, err := s.storagecli.GetAttachments(ctx, &pb.GetAttachmentsRequest{Ids: req.AttachmentsIds})
+		if err != nil {
+			if status.Code(err) == codes.Unavailable {
+				return nil, ErrServiceStorageUnvaliable
+			} else if status.Code(err) == codes.Internal {
+				return nil, err
+			}
+			return nil, ErrInvalidAttachments
+		}
+		res.Draft.Attachments = att.Attachments
+	}
+
+	err = s.cses.Query("INSERT INTO drafts (owner_id, id, message, attachments, linkedacc_ids, updated) VALUES (?, ?, ?, ?, ?, ?)", user_id, id, req.Message, req.AttachmentsIds, toLinkedAccounts(req.LinkedaccIds), now).Exec()
+	if err != nil {
+		return nil, ErrInternal(err)
+	}
+
+	return res, nil
+}
+
+func (s service) ListDrafts(ctx context.Context, req *pb.ListDraftsRequest) (*pb.ListDraftsResponse, error) {
+
+	user_id, err := userId(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Limit < 0 || req.Limit > 100 {
+		return nil, ErrLimitError
+	}
+
+	res := &pb.ListDraftsResponse{}
+	res.Drafts = make([]*pb.Draft, 0, req.Limit)
+
+	params := make([]any, 0)
+	params = append(params, user_id)
+
+	condition := ""
+
+	if req.LastId > 0 {
+		condition += "AND id < ?"
+		params = append(params, req.LastId)
+	}
+
+	params = append(params, req.Limit)
+
+	iter := s.cses.Query("SELECT id, message, attachments, linkedacc_ids, updated FROM drafts WHERE owner_id = ? "+condition+" LIMIT ?", params...).Iter()
+
+	att := []*pb.AttachmentId{}
+	acc := []linkedAccount{}
+	var updated time.Time
+	tmpdraft := &pb.Draft{OwnerId: user_id}
+	for iter.Scan(&tmpdraft.Id, &tmpdraft.Message, &att, &acc, &updated) {
+		if len(att) != 0 {
+			attach, err := s.storagecli.GetAttachments(ctx, &pb.GetAttachmentsRequest{Ids: att})
+			if err != nil {
+				if status.Code(err) == codes.Unavailable {
+					return nil, ErrServiceStorageUnvaliable
+				}
+				return nil, err
+			}
+			tmpdraft.Attachments = attach.Attachments
+		}
+
+		tmpdraft.LinkedaccIds = fromLinkedAccounts(acc)
+		tmpdraft.Time = timestamppb.New(updated.Local())
+
+		res.Drafts = append(res.Drafts, tmpdraft)
+
+		att = []*pb.AttachmentId{}
+		acc = []linkedAccount{}
+		tmpdraft = &pb.Draft{OwnerId: user_id}
+	}
+
+	err = iter.Close()
+	if err != nil {
+		return nil, ErrInternal(err)
+	}
+
+	return res, nil
+}
+
+func (s service) GetDraft(ctx context.Context, req *pb.GetDraftRequest) (*pb.GetDraftResponse, error) {
+
+	user_id, err := userId(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	draft, att, err := s.getDraft(user_id, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(att) != 0 {
+		attach, err := s.storagecli.GetAttachments(ctx, &pb.GetAttachmentsRequest{Ids: att})
+		if err != nil {
+			if status.Code(err) == codes.Unavailable {
+				return nil, ErrServiceStorageUnvaliable
+			}
+			return nil, err
+		}
+		draft.Attachments = attach.Attachments
+	}
+
+	return &pb.GetDraftResponse{Draft: draft}, nil
+}
+
+func (s service) DeleteDraft(ctx context.Context, req *pb.DeleteDraftRequest) (*pb.DeleteDraftResponse, error) {
+
+	user_id, err := userId(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.cses.Query("DELETE FROM drafts WHERE owner_id = ? AND id = ?", user_id, req.Id).Exec()
+	if err != nil {
+		return nil, ErrInternal(err)
+	}
+
+	return &pb.DeleteDraftResponse{}, nil
+}
+
+func (s service) PublishDraft(ctx context.Context, req *pb.PublishDraftRequest) (*pb.PublishDraftResponse, error) {
+
+	user_id, err := userId(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	draft, att, err := s.getDraft(user_id, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	postres, err := s.NewPost(ctx, &pb.NewPostRequest{
+		AttachmentsIds: att,
+		Message:        draft.Message,
+		LinkedaccIds:   draft.LinkedaccIds,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.cses.Query("DELETE FROM drafts WHERE owner_id = ? AND id = ?", user_id, req.Id).Exec()
+	if err != nil {
+		return nil, ErrInternal(err)
+	}
+
+	return &pb.PublishDraftResponse{Post: postres.Post}, nil
+}
+
+// getDraft loads a draft of the user without resolving its attachments,
+// the attachment ids are returned separately.
+func (s service) getDraft(user_id int64, id uint64) (*pb.Draft, []*pb.AttachmentId, error) {
+
+	draft := &pb.Draft{OwnerId: user_id}
+	att := []*pb.AttachmentId{}
+	acc := []linkedAccount{}
+	var updated time.Time
+
+	err := s.cses.Query("SELECT id, message, attachments, linkedacc_ids, updated FROM drafts WHERE owner_id = ? AND id = ?", user_id, id).Scan(&draft.Id, &draft.Message, &att, &acc, &updated)
+	if err != nil {
+		if err == gocql.ErrNotFound {
+			return nil, nil, ErrDraftNotFound
+		}
+		return nil, nil, ErrInternal(err)
+	}
+
+	draft.LinkedaccIds = fromLinkedAccounts(acc)
+	draft.Time = timestamppb.New(updated.Local())
+
+	return draft, att, nil
+}
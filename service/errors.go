@@ -8,6 +8,14 @@ import (
 var (
 	ErrLimitError = status.Error(codes.OutOfRange, "limit must be in the range [0;100]")
 
+	ErrInvalidOffset = status.Error(codes.OutOfRange, "offset must not be negative")
+
+	ErrTooManyPosts = status.Error(codes.OutOfRange, "too many posts, at most 100 are allowed")
+
+	ErrInvalidStatsRange = status.Error(codes.InvalidArgument, "invalid stats range")
+
+	ErrInvalidTimeZone = status.Error(codes.InvalidArgument, "invalid time zone")
+
 	ErrInvalidPhone = status.Error(codes.InvalidArgument, "invalid phone")
 
 	ErrInvalidMetadata = status.Error(codes.InvalidArgument, "invalid metadata")
@@ -16,16 +24,56 @@ var (
 
 	ErrInvalidAttachments = status.Error(codes.InvalidArgument, "invalid attachments")
 
+	ErrInvalidMarkup = status.Error(codes.InvalidArgument, "invalid markup")
+
+	ErrInvalidTag = status.Error(codes.InvalidArgument, "invalid tag")
+
+	ErrEmptyQuery = status.Error(codes.InvalidArgument, "search query is empty")
+
+	ErrInvalidPoll = status.Error(codes.InvalidArgument, "invalid poll")
+
+	ErrInvalidPollVote = status.Error(codes.InvalidArgument, "invalid poll vote")
+
+	ErrPollClosed = status.Error(codes.FailedPrecondition, "poll is closed")
+
+	ErrAlreadyVoted = status.Error(codes.AlreadyExists, "already voted")
+
+	ErrInvalidVisibility = status.Error(codes.InvalidArgument, "invalid visibility")
+
+	ErrLinkedaccNotPublic = status.Error(codes.InvalidArgument, "only public posts can be written to linked accounts")
+
 	ErrServiceStorageUnvaliable = status.Error(codes.Internal, "service storage unvaliable")
 
 	ErrServiceUsersUnvaliable = status.Error(codes.Internal, "service users unvaliable")
 
 	ErrPostNotFound = status.Error(codes.NotFound, "post not found")
 
+	ErrCommentNotFound = status.Error(codes.NotFound, "comment not found")
+
+	ErrPollNotFound = status.Error(codes.NotFound, "poll not found")
+
+	ErrPollVoteNotFound = status.Error(codes.NotFound, "poll vote not found")
+
+	ErrDraftNotFound = status.Error(codes.NotFound, "draft not found")
+
+	ErrAudienceNotFound = status.Error(codes.NotFound, "audience not found")
+
+	ErrEmptyAudienceName = status.Error(codes.InvalidArgument, "audience name is empty")
+
+	ErrTooManyAudienceMembers = status.Error(codes.InvalidArgument, "too many members, at most 100 are added at once")
+
+	ErrEmptyUserId = status.Error(codes.InvalidArgument, "user_id is required")
+
 	ErrInvalidAccessToken = status.Error(codes.Unauthenticated, "invalid access token")
 
 	ErrUnknownSubject = status.Error(codes.Unauthenticated, "unknown subject")
 
+	ErrPermissionDenied = status.Error(codes.PermissionDenied, "permission denied")
+
+	ErrNoActingUser = status.Error(codes.PermissionDenied, "service account must act on behalf of a user")
+
+	ErrSlowSubscriber = status.Error(codes.ResourceExhausted, "subscriber is too slow, subscribe again")
+
 	ErrServiceLinkedaccUnvaliable = status.Error(codes.Unavailable, "service linkedacc unvaliable")
 )
 
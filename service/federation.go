@@ -0,0 +1,105 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/NexusIT-Dev/nexusmicro_publications/pb"
+	"github.com/NexusIT-Dev/nexusmicro_publications/pubsub"
+	"github.com/NexusIT-Dev/nexusmicro_publications/search"
+	"github.com/go-kit/log"
+	"github.com/go-kit/log/level"
+	"github.com/gocql/gocql"
+	"github.com/godruoyi/go-snowflake"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Federation publishes the changes of posts outside of the service, like
+// to the ActivityPub followers of the users. It decides itself which
+// posts may be published, the methods must not block.
+type Federation interface {
+	PostCreated(post *pb.Post)
+	PostDeleted(post *pb.Post)
+	PostLiked(user_id int64, post *pb.Post)
+}
+
+// Replies stores the replies to public posts received from the
+// federation as comments. A remote comment has no owner, its author is
+// stored in remote_actor.
+type Replies struct {
+	s store
+}
+
+func NewReplies(cses *gocql.Session, bucketDuration time.Duration, index search.Index, broker pubsub.Broker, logger log.Logger) *Replies {
+	return &Replies{
+		s: store{
+			cses:           cses,
+			bucketDuration: bucketDuration,
+			index:          index,
+			broker:         broker,
+			logger:         logger,
+		},
+	}
+}
+
+// Add saves the reply with the id object_id as a comment of the post. A
+// reply that was already saved is skipped. Posts that are not public
+// are not found.
+func (r *Replies) Add(ctx context.Context, post_id uint64, object_id string, actor string, message string) error {
+
+	post, _, err := r.s.getPost(post_id)
+	if err != nil {
+		return err
+	}
+	if post.Visibility != pb.Visibility_public {
+		return ErrPostNotFound
+	}
+
+	if message == "" {
+		return ErrEmptyContent
+	}
+
+	id := snowflake.ID()
+	sid := snowflake.ParseID(id)
+
+	// remote servers retry deliveries, object_id makes them idempotent.
+	applied, err := r.s.cses.Query("INSERT INTO ap_replies (object_id, post_id, comment_id) VALUES (?, ?, ?) IF NOT EXISTS", object_id, post_id, id).MapScanCAS(make(map[string]interface{}))
+	if err != nil {
+		return ErrInternal(err)
+	}
+	if !applied {
+		return nil
+	}
+
+	err = r.s.cses.Query("INSERT INTO comments (id, post_id, owner_id, message, remote_actor) VALUES (?, ?, ?, ?, ?)", id, post_id, 0, message, actor).Exec()
+	if err != nil {
+		// the claim is released, so the retry of the delivery saves the reply.
+		derr := r.s.cses.Query("DELETE FROM ap_replies WHERE object_id = ? IF comment_id = ?", object_id, id).Exec()
+		if derr != nil {
+			level.Error(r.s.logger).Log("msg", "failed to release reply", "object_id", object_id, "err", derr)
+		}
+		return ErrInternal(err)
+	}
+
+	comment := &pb.Comment{
+		Id:          id,
+		PostId:      post_id,
+		Message:     message,
+		Time:        timestamppb.New(sid.GenerateTime().Local()),
+		RemoteActor: actor,
+	}
+
+	err = r.s.indexDocument(ctx, commentDocument(comment))
+	if err != nil {
+		return err
+	}
+
+	err = r.s.countStat(post_id, statComments, 1)
+	if err != nil {
+		return err
+	}
+
+	r.s.publish(ctx, postTopic(post_id), pubsub.Event{Type: pubsub.CommentCreated, PostId: post_id, CommentId: id})
+
+	return nil
+}
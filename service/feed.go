@@ -0,0 +1,381 @@
+package service
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/NexusIT-Dev/nexusmicro_publications/pb"
+	"github.com/NexusIT-Dev/nexusmicro_publications/ranking"
+	"github.com/gocql/gocql"
+	"github.com/godruoyi/go-snowflake"
+)
+
+const (
+	rankedFeedWindow    = time.Hour * 72
+	maxRankedCandidates = 300
+	maxAffinities       = 1000
+	// rankedFeedTTL is how long a version of the ranked feed is kept
+	// for its next pages.
+	rankedFeedTTL = time.Minute * 30
+)
+
+// addAffinity counts an interaction of the user with the post: with its
+// owner and with its hashtags. delta is -1 when a like is taken back.
+func (s service) addAffinity(user_id int64, post_id uint64, delta int64) error {
+
+	var owner_id int64
+	var message string
+
+	err := s.cses.Query("SELECT owner_id, message FROM posts WHERE bucket = ? AND id = ?", s.bucket(post_id), post_id).Scan(&owner_id, &message)
+	if err != nil {
+		if err == gocql.ErrNotFound {
+			return nil
+		}
+		return ErrInternal(err)
+	}
+
+	if owner_id == user_id {
+		return nil
+	}
+
+	batch := s.cses.NewBatch(gocql.CounterBatch)
+	batch.Query("UPDATE author_affinity SET interactions = interactions + ? WHERE user_id = ? AND author_id = ?", delta, user_id, owner_id)
+	for _, t := range uniqueTags(message) {
+		batch.Query("UPDATE tag_affinity SET interactions = interactions + ? WHERE user_id = ? AND tag = ?", delta, user_id, t)
+	}
+
+	err = s.cses.ExecuteBatch(batch)
+	if err != nil {
+		return ErrInternal(err)
+	}
+
+	return nil
+}
+
+// rankingViewer loads the affinities of the user, normalized by the
+// strongest one. Only the maxAffinities strongest are kept.
+func (s service) rankingViewer(user_id int64) (*ranking.Viewer, error) {
+
+	res := &ranking.Viewer{
+		Id:             user_id,
+		AuthorAffinity: make(map[int64]float64),
+		TagAffinity:    make(map[string]float64),
+	}
+
+	var author_id int64
+	var interactions int64
+	var max int64
+
+	// counters can not be ordered by, the strongest are picked here.
+	iter := s.cses.Query("SELECT author_id, interactions FROM author_affinity WHERE user_id = ?", user_id).Iter()
+	for iter.Scan(&author_id, &interactions) {
+		if interactions > 0 {
+			res.AuthorAffinity[author_id] = float64(interactions)
+			if interactions > max {
+				max = interactions
+			}
+		}
+	}
+	err := iter.Close()
+	if err != nil {
+		return nil, ErrInternal(err)
+	}
+	strongest(res.AuthorAffinity, maxAffinities)
+	for k := range res.AuthorAffinity {
+		res.AuthorAffinity[k] /= float64(max)
+	}
+
+	var tag string
+	max = 0
+
+	iter = s.cses.Query("SELECT tag, interactions FROM tag_affinity WHERE user_id = ?", user_id).Iter()
+	for iter.Scan(&tag, &interactions) {
+		if interactions > 0 {
+			res.TagAffinity[tag] = float64(interactions)
+			if interactions > max {
+				max = interactions
+			}
+		}
+	}
+	err = iter.Close()
+	if err != nil {
+		return nil, ErrInternal(err)
+	}
+	strongest(res.TagAffinity, maxAffinities)
+	for k := range res.TagAffinity {
+		res.TagAffinity[k] /= float64(max)
+	}
+
+	return res, nil
+}
+
+// strongest removes all but the n strongest affinities, ties with the
+// n-th one are kept.
+func strongest[K comparable](affinity map[K]float64, n int) {
+
+	if len(affinity) <= n {
+		return
+	}
+
+	values := make([]float64, 0, len(affinity))
+	for _, v := range affinity {
+		values = append(values, v)
+	}
+	sort.Float64s(values)
+
+	min := values[len(values)-n]
+	for k, v := range affinity {
+		if v < min {
+			delete(affinity, k)
+		}
+	}
+}
+
+// rankingCandidates returns up to maxRankedCandidates recent posts visible
+// to the user, newest first.
+func (s service) rankingCandidates(ctx context.Context, user_id int64) ([]*ranking.Candidate, error) {
+
+	candidates := make([]*ranking.Candidate, 0)
+
+	v := s.newViewer(user_id)
+
+	// the window follows the clock of the ranker, so the candidates and
+	// their scores are of the same time.
+	now := s.ranker.Now()
+	from := idFromTime(now.Add(-rankedFeedWindow))
+	first := s.bucket(from)
+	bucket := s.bucket(idFromTime(now))
+	last_id := uint64(0)
+
+	for len(candidates) < maxRankedCandidates {
+
+		params := make([]any, 0)
+		params = append(params, bucket, from)
+
+		condition := ""
+
+		if last_id > 0 {
+			condition += "AND id < ? "
+			params = append(params, last_id)
+		}
+
+		limit := maxRankedCandidates - len(candidates)
+		params = append(params, limit)
+
+		iter := s.cses.Query("SELECT "+postColumns+" FROM posts WHERE bucket = ? AND id >= ? "+condition+"ORDER BY id DESC LIMIT ?", params...).Iter()
+
+		rows := 0
+		row := &postRow{}
+		tmppost := &pb.Post{}
+		for iter.Scan(scanPost(tmppost, row)...) {
+			rows++
+			last_id = tmppost.Id
+
+			ok, err := v.canView(ctx, tmppost)
+			if err != nil {
+				iter.Close()
+				return nil, err
+			}
+
+			if ok {
+				sid := snowflake.ParseID(tmppost.Id)
+				candidates = append(candidates, &ranking.Candidate{
+					PostId:  tmppost.Id,
+					OwnerId: tmppost.OwnerId,
+					Time:    sid.GenerateTime(),
+					Tags:    uniqueTags(tmppost.Message),
+				})
+			}
+
+			row = &postRow{}
+			tmppost = &pb.Post{}
+		}
+
+		err := iter.Close()
+		if err != nil {
+			return nil, ErrInternal(err)
+		}
+
+		// the bucket is exhausted, continue with the previous one.
+		if rows < limit {
+			if bucket <= first {
+				break
+			}
+			bucket--
+		}
+	}
+
+	ids := make([]uint64, 0, len(candidates))
+	for _, c := range candidates {
+		ids = append(ids, c.PostId)
+	}
+
+	counts, err := s.engagementCounts(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, c := range candidates {
+		c.Likes = counts[c.PostId].likes
+		c.Comments = counts[c.PostId].comments
+	}
+
+	return candidates, nil
+}
+
+// rankFeed ranks the candidates of the user and saves the ranked post
+// ids as a new version of the feed of the user.
+func (s service) rankFeed(ctx context.Context, user_id int64, hide_seen bool, deprioritize_seen bool) (uint64, error) {
+
+	viewer, err := s.rankingViewer(user_id)
+	if err != nil {
+		return 0, err
+	}
+
+	candidates, err := s.rankingCandidates(ctx, user_id)
+	if err != nil {
+		return 0, err
+	}
+
+	if hide_seen || deprioritize_seen {
+		ids := make([]uint64, 0, len(candidates))
+		for _, c := range candidates {
+			ids = append(ids, c.PostId)
+		}
+
+		seen, err := s.seenPosts(user_id, ids)
+		if err != nil {
+			return 0, err
+		}
+
+		if hide_seen {
+			unseen := candidates[:0]
+			for _, c := range candidates {
+				if !seen[c.PostId] {
+					unseen = append(unseen, c)
+				}
+			}
+			candidates = unseen
+		}
+
+		for _, c := range candidates {
+			c.Seen = seen[c.PostId]
+		}
+	}
+
+	ranked := s.ranker.Rank(viewer, candidates)
+
+	// seen posts go after the unseen ones, keeping their order.
+	if deprioritize_seen {
+		sort.SliceStable(ranked, func(i, j int) bool {
+			return !ranked[i].Candidate.Seen && ranked[j].Candidate.Seen
+		})
+	}
+
+	version := snowflake.ID()
+	ttl := int64(rankedFeedTTL.Seconds())
+
+	// the rows of a version share a partition, so the batches are cheap.
+	for start := 0; start < len(ranked); start += 100 {
+		batch := s.cses.NewBatch(gocql.UnloggedBatch).WithContext(ctx)
+		for rank := start; rank < len(ranked) && rank < start+100; rank++ {
+			batch.Query("INSERT INTO ranked_feeds (user_id, version, rank, post_id) VALUES (?, ?, ?, ?) USING TTL ?", user_id, version, rank, ranked[rank].Candidate.PostId, ttl)
+		}
+
+		err = s.cses.ExecuteBatch(batch)
+		if err != nil {
+			return 0, ErrInternal(err)
+		}
+	}
+
+	return version, nil
+}
+
+func (s service) GetRankedFeed(ctx context.Context, req *pb.GetRankedFeedRequest) (*pb.GetRankedFeedResponse, error) {
+
+	user_id, err := userId(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Limit < 0 || req.Limit > 100 {
+		return nil, ErrLimitError
+	}
+
+	if req.Offset < 0 {
+		return nil, ErrInvalidOffset
+	}
+
+	// the feed is ranked for the first page, the next pages are read from
+	// the saved version.
+	version := req.Version
+	if version == 0 {
+		version, err = s.rankFeed(ctx, user_id, req.HideSeen, req.DeprioritizeSeen)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	res := &pb.GetRankedFeedResponse{Version: version}
+	res.Posts = make([]*pb.Post, 0, req.Limit)
+
+	ids := make([]uint64, 0, req.Limit)
+
+	var id uint64
+	iter := s.cses.Query("SELECT post_id FROM ranked_feeds WHERE user_id = ? AND version = ? AND rank >= ? LIMIT ?", user_id, version, req.Offset, req.Limit).Iter()
+	for iter.Scan(&id) {
+		ids = append(ids, id)
+	}
+
+	err = iter.Close()
+	if err != nil {
+		return nil, ErrInternal(err)
+	}
+
+	if req.Limit > 0 && int64(len(ids)) == req.Limit {
+		res.NextOffset = req.Offset + int32(req.Limit)
+	}
+
+	var comments *pb.GetCommentsListRequest
+	if req.Extended && req.CommentsLimit > 0 {
+		comments = &pb.GetCommentsListRequest{Limit: req.CommentsLimit, Extended: req.CommentsExtended, SortDir: req.CommentsSortDir, Fields: req.CommentsFields}
+	}
+
+	v := s.newViewer(user_id)
+
+	// posts may be deleted or hidden after the feed was ranked.
+	for _, id := range ids {
+		post, row, err := s.getPost(id)
+		if err != nil {
+			if err == ErrPostNotFound {
+				continue
+			}
+			return nil, err
+		}
+
+		ok, err := v.canView(ctx, post)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			continue
+		}
+
+		err = s.fillPost(ctx, user_id, post, row, comments)
+		if err != nil {
+			return nil, err
+		}
+
+		res.Posts = append(res.Posts, post)
+	}
+
+	if req.Extended {
+		err = s.fillOwners(ctx, res.Posts, req.Fields)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return res, nil
+}
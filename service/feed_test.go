@@ -0,0 +1,21 @@
+package service
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestStrongest(t *testing.T) {
+
+	affinity := map[int64]float64{1: 5, 2: 1, 3: 9, 4: 3}
+	strongest(affinity, 2)
+	if want := map[int64]float64{1: 5, 3: 9}; !reflect.DeepEqual(affinity, want) {
+		t.Errorf("got %v, want %v", affinity, want)
+	}
+
+	tags := map[string]float64{"a": 1, "b": 2}
+	strongest(tags, 3)
+	if len(tags) != 2 {
+		t.Errorf("got %v, want both tags kept", tags)
+	}
+}
@@ -0,0 +1,29 @@
+package service
+
+import (
+	"context"
+	"time"
+)
+
+// lease takes the lease with the name in the leases table if it is free
+// or renews it if it is held by the owner. Jobs that must run on one
+// replica only run while they hold their lease, it expires after ttl,
+// so another replica takes over when the holder stops.
+func (s store) lease(ctx context.Context, name string, owner string, ttl time.Duration) (bool, error) {
+
+	seconds := int64(ttl.Seconds())
+
+	prev := make(map[string]interface{})
+	applied, err := s.cses.Query("INSERT INTO leases (name, owner) VALUES (?, ?) IF NOT EXISTS USING TTL ?", name, owner, seconds).WithContext(ctx).MapScanCAS(prev)
+	if err != nil {
+		return false, err
+	}
+	if applied {
+		return true, nil
+	}
+	if prev["owner"] != owner {
+		return false, nil
+	}
+
+	return s.cses.Query("UPDATE leases USING TTL ? SET owner = ? WHERE name = ? IF owner = ?", seconds, owner, name, owner).WithContext(ctx).MapScanCAS(make(map[string]interface{}))
+}
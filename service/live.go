@@ -0,0 +1,252 @@
+package service
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/NexusIT-Dev/nexusmicro_publications/pb"
+	"github.com/NexusIT-Dev/nexusmicro_publications/pubsub"
+	"github.com/go-kit/log/level"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// feedTopic receives the new posts and the likes of all posts, like
+// GetPostsList returns all posts.
+const feedTopic = "feed"
+
+// postTopic receives the comments and the likes of the post.
+func postTopic(post_id uint64) string {
+	return "post." + strconv.FormatUint(post_id, 10)
+}
+
+// publish sends the event to the subscribers. The change is already
+// saved, so a failure is only logged and subscribers miss the event.
+func (s store) publish(ctx context.Context, topic string, event pubsub.Event) {
+	err := s.broker.Publish(ctx, topic, event)
+	if err != nil {
+		level.Error(s.logger).Log("msg", "failed to publish event", "topic", topic, "err", err)
+	}
+}
+
+// publishLikes sends the current number of likes of the post. The like
+// is already saved, so a failure is only logged like in publish.
+func (s service) publishLikes(ctx context.Context, post_id uint64) {
+
+	var likes int64
+	err := s.cses.Query("SELECT Count(*) FROM likes WHERE post_id = ?", post_id).Scan(&likes)
+	if err != nil {
+		level.Error(s.logger).Log("msg", "failed to count likes", "post_id", post_id, "err", err)
+		return
+	}
+
+	event := pubsub.Event{Type: pubsub.LikesChanged, PostId: post_id, Likes: likes}
+	s.publish(ctx, feedTopic, event)
+	s.publish(ctx, postTopic(post_id), event)
+}
+
+// maxFeedPosts is the number of the last posts sent on a feed stream
+// whose likes are followed.
+const maxFeedPosts = 1000
+
+// feedPosts is the set of the last posts sent on a feed stream.
+type feedPosts struct {
+	ids   map[uint64]bool
+	order []uint64
+}
+
+func newFeedPosts() *feedPosts {
+	return &feedPosts{ids: make(map[uint64]bool)}
+}
+
+func (f *feedPosts) add(id uint64) {
+	if f.ids[id] {
+		return
+	}
+	if len(f.order) == maxFeedPosts {
+		delete(f.ids, f.order[0])
+		f.order = f.order[1:]
+	}
+	f.ids[id] = true
+	f.order = append(f.order, id)
+}
+
+func (f *feedPosts) has(id uint64) bool {
+	return f.ids[id]
+}
+
+func (s service) SubscribeFeed(req *pb.SubscribeFeedRequest, stream pb.Posts_SubscribeFeedServer) error {
+
+	ctx := stream.Context()
+
+	user_id, err := userId(ctx)
+	if err != nil {
+		return err
+	}
+
+	sub, err := s.broker.Subscribe(ctx, feedTopic)
+	if err != nil {
+		return ErrInternal(err)
+	}
+	defer sub.Close()
+
+	// feedTopic receives the likes of all posts, only the likes of the
+	// posts sent on the stream are checked and sent.
+	sent := newFeedPosts()
+
+	for {
+		var event pubsub.Event
+		var ok bool
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok = <-sub.Events():
+			if !ok {
+				return subscriptionError(sub)
+			}
+		}
+
+		switch event.Type {
+		case pubsub.PostCreated:
+		case pubsub.LikesChanged:
+			if !sent.has(event.PostId) {
+				continue
+			}
+		default:
+			continue
+		}
+
+		post, row, err := s.getPost(event.PostId)
+		if err != nil {
+			if err == ErrPostNotFound {
+				continue
+			}
+			return err
+		}
+
+		// access is checked for every event, the subscriptions of the
+		// user may change while the stream is open.
+		ok, err = s.newViewer(user_id).canView(ctx, post)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			continue
+		}
+
+		res := &pb.SubscribeFeedResponse{PostId: event.PostId}
+
+		switch event.Type {
+		case pubsub.PostCreated:
+			res.Type = pb.UpdateType_post_created
+
+			err = s.fillPost(ctx, user_id, post, row, nil)
+			if err != nil {
+				return err
+			}
+
+			if req.Extended {
+				err = s.fillOwners(ctx, []*pb.Post{post}, req.Fields)
+				if err != nil {
+					return err
+				}
+			}
+
+			res.Post = post
+			sent.add(post.Id)
+		case pubsub.LikesChanged:
+			res.Type = pb.UpdateType_likes_changed
+			res.Likes = event.Likes
+		}
+
+		err = stream.Send(res)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func (s service) SubscribePostComments(req *pb.SubscribePostCommentsRequest, stream pb.Posts_SubscribePostCommentsServer) error {
+
+	ctx := stream.Context()
+
+	user_id, err := userId(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = s.checkPostAccess(ctx, user_id, req.PostId)
+	if err != nil {
+		return err
+	}
+
+	sub, err := s.broker.Subscribe(ctx, postTopic(req.PostId))
+	if err != nil {
+		return ErrInternal(err)
+	}
+	defer sub.Close()
+
+	for {
+		var event pubsub.Event
+		var ok bool
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok = <-sub.Events():
+			if !ok {
+				return subscriptionError(sub)
+			}
+		}
+
+		res := &pb.SubscribePostCommentsResponse{PostId: req.PostId}
+
+		switch event.Type {
+		case pubsub.PostDeleted:
+			return ErrPostNotFound
+		case pubsub.CommentCreated:
+			res.Type = pb.UpdateType_comment_created
+
+			res.Comment, err = s.getComment(ctx, req.PostId, event.CommentId)
+			if err != nil {
+				if err == ErrCommentNotFound {
+					continue
+				}
+				return err
+			}
+
+			if req.Extended && res.Comment.RemoteActor == "" {
+				usersres, err := s.userscli.GetUsersByIds(ctx, &pb.GetUsersByIdsRequest{Ids: []int64{res.Comment.OwnerId}, Fields: req.Fields})
+				if err != nil {
+					if status.Code(err) == codes.Unavailable {
+						return ErrServiceUsersUnvaliable
+					}
+					return err
+				}
+				if len(usersres.Users) == 1 {
+					res.Comment.Owner = usersres.Users[0]
+				}
+			}
+		case pubsub.LikesChanged:
+			res.Type = pb.UpdateType_likes_changed
+			res.Likes = event.Likes
+		default:
+			continue
+		}
+
+		err = stream.Send(res)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// subscriptionError returns the error of a subscription ended by the broker.
+func subscriptionError(sub pubsub.Subscription) error {
+	if sub.Err() == pubsub.ErrSlowSubscriber {
+		return ErrSlowSubscriber
+	}
+	if sub.Err() != nil {
+		return ErrInternal(sub.Err())
+	}
+	return nil
+}
@@ -0,0 +1,244 @@
+package service
+
+import (
+	"net/url"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/NexusIT-Dev/nexusmicro_publications/pb"
+)
+
+const maxTextEntities = 100
+
+// textEntity is the cql representation of pb.TextEntity.
+type textEntity struct {
+	Type   int32  `cql:"type"`
+	Offset int32  `cql:"offset"`
+	Length int32  `cql:"length"`
+	Url    string `cql:"url"`
+}
+
+func toTextEntities(entities []*pb.TextEntity) []textEntity {
+	res := make([]textEntity, 0, len(entities))
+	for _, v := range entities {
+		res = append(res, textEntity{Type: int32(v.Type), Offset: v.Offset, Length: v.Length, Url: v.Url})
+	}
+	return res
+}
+
+func fromTextEntities(entities []textEntity) []*pb.TextEntity {
+	res := make([]*pb.TextEntity, 0, len(entities))
+	for _, v := range entities {
+		res = append(res, &pb.TextEntity{Type: pb.TextEntityType(v.Type), Offset: v.Offset, Length: v.Length, Url: v.Url})
+	}
+	return res
+}
+
+// markupToken is a part of the message: either plain runes or a
+// delimiter of bold, italic or strikethrough text. Code spans and links
+// are resolved by the tokenizer, their entities are stored in the token.
+type markupToken struct {
+	text      []rune
+	delimiter string
+	entities  []*pb.TextEntity
+	// matched and opening are set for delimiters that have a pair.
+	matched bool
+	opening bool
+}
+
+var delimiterTypes = map[string]pb.TextEntityType{
+	"**": pb.TextEntityType_bold,
+	"*":  pb.TextEntityType_italic,
+	"~~": pb.TextEntityType_strikethrough,
+}
+
+// parseMarkup parses the markdown subset described in posts.proto and
+// returns the message without markup and its formatting entities.
+// Unpaired delimiters are kept as text.
+func parseMarkup(message string) (string, []*pb.TextEntity, error) {
+
+	tokens, err := tokenizeMarkup([]rune(message))
+	if err != nil {
+		return "", nil, err
+	}
+
+	matchDelimiters(tokens)
+
+	out := make([]rune, 0, len(message))
+	entities := make([]*pb.TextEntity, 0)
+	open := make([]int32, 0)
+
+	for _, t := range tokens {
+		switch {
+		case t.delimiter == "":
+			for _, e := range t.entities {
+				e.Offset += int32(len(out))
+				entities = append(entities, e)
+			}
+			out = append(out, t.text...)
+		case !t.matched:
+			out = append(out, []rune(t.delimiter)...)
+		case t.opening:
+			open = append(open, int32(len(out)))
+		default:
+			start := open[len(open)-1]
+			open = open[:len(open)-1]
+			if int32(len(out)) > start {
+				entities = append(entities, &pb.TextEntity{Type: delimiterTypes[t.delimiter], Offset: start, Length: int32(len(out)) - start})
+			}
+		}
+	}
+
+	if len(entities) > maxTextEntities {
+		return "", nil, ErrInvalidMarkup
+	}
+
+	sort.SliceStable(entities, func(i, j int) bool {
+		if entities[i].Offset != entities[j].Offset {
+			return entities[i].Offset < entities[j].Offset
+		}
+		return entities[i].Length > entities[j].Length
+	})
+
+	return string(out), entities, nil
+}
+
+func tokenizeMarkup(runes []rune) ([]*markupToken, error) {
+
+	tokens := make([]*markupToken, 0)
+	text := make([]rune, 0)
+
+	flush := func() {
+		if len(text) != 0 {
+			tokens = append(tokens, &markupToken{text: text})
+			text = make([]rune, 0)
+		}
+	}
+
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+
+		switch {
+		case r == '\\' && i+1 < len(runes) && strings.ContainsRune("\\*~`[]", runes[i+1]):
+			text = append(text, runes[i+1])
+			i++
+
+		case r == '`':
+			end := indexRune(runes, '`', i+1)
+			if end <= i+1 {
+				text = append(text, r)
+				continue
+			}
+			flush()
+			code := runes[i+1 : end]
+			tokens = append(tokens, &markupToken{
+				text:     code,
+				entities: []*pb.TextEntity{{Type: pb.TextEntityType_code, Length: int32(len(code))}},
+			})
+			i = end
+
+		case r == '[':
+			closing := indexRune(runes, ']', i+1)
+			if closing <= i+1 || closing+1 >= len(runes) || runes[closing+1] != '(' {
+				text = append(text, r)
+				continue
+			}
+			end := indexRune(runes, ')', closing+2)
+			if end < 0 {
+				text = append(text, r)
+				continue
+			}
+
+			link := string(runes[closing+2 : end])
+			if !validLink(link) {
+				return nil, ErrInvalidMarkup
+			}
+
+			inner, entities, err := parseMarkup(string(runes[i+1 : closing]))
+			if err != nil {
+				return nil, err
+			}
+			if inner == "" {
+				return nil, ErrInvalidMarkup
+			}
+
+			flush()
+			linktext := []rune(inner)
+			tokens = append(tokens, &markupToken{
+				text:     linktext,
+				entities: append([]*pb.TextEntity{{Type: pb.TextEntityType_link, Length: int32(len(linktext)), Url: link}}, entities...),
+			})
+			i = end
+
+		case r == '*' || (r == '~' && i+1 < len(runes) && runes[i+1] == '~'):
+			delimiter := string(r)
+			if i+1 < len(runes) && runes[i+1] == r {
+				delimiter += string(r)
+			}
+			flush()
+			tokens = append(tokens, &markupToken{delimiter: delimiter})
+			i += len(delimiter) - 1
+
+		default:
+			text = append(text, r)
+		}
+	}
+	flush()
+
+	return tokens, nil
+}
+
+// matchDelimiters pairs the delimiters like brackets. An opening delimiter
+// must be followed by a non-space and a closing one preceded by a
+// non-space, so "2 * 3 * 4" stays as is. Delimiters inside a pair that
+// were not closed before it remain unpaired, so entities never overlap
+// partially.
+func matchDelimiters(tokens []*markupToken) {
+
+	stack := make([]int, 0)
+
+	for i, t := range tokens {
+		if t.delimiter == "" {
+			continue
+		}
+
+		before := i > 0 && (tokens[i-1].delimiter != "" || !unicode.IsSpace(tokens[i-1].text[len(tokens[i-1].text)-1]))
+		after := i+1 < len(tokens) && (tokens[i+1].delimiter != "" || !unicode.IsSpace(tokens[i+1].text[0]))
+
+		closed := false
+		if before {
+			for j := len(stack) - 1; j >= 0; j-- {
+				if tokens[stack[j]].delimiter == t.delimiter && stack[j] != i-1 {
+					tokens[stack[j]].matched = true
+					tokens[stack[j]].opening = true
+					t.matched = true
+					stack = stack[:j]
+					closed = true
+					break
+				}
+			}
+		}
+
+		if !closed && after {
+			stack = append(stack, i)
+		}
+	}
+}
+
+func indexRune(runes []rune, r rune, from int) int {
+	for i := from; i < len(runes); i++ {
+		if runes[i] == r {
+			return i
+		}
+	}
+	return -1
+}
+
+func validLink(link string) bool {
+	u, err := url.Parse(link)
+	if err != nil {
+		return false
+	}
+	return (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+}
@@ -0,0 +1,283 @@
+package service
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/NexusIT-Dev/nexusmicro_publications/pb"
+	"github.com/gocql/gocql"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// mention is the cql representation of pb.Mention.
+type mention struct {
+	Offset int32 `cql:"offset"`
+	Length int32 `cql:"length"`
+	UserId int64 `cql:"user_id"`
+}
+
+func toMentions(mentions []*pb.Mention) []mention {
+	res := make([]mention, 0, len(mentions))
+	for _, v := range mentions {
+		res = append(res, mention{Offset: v.Offset, Length: v.Length, UserId: v.UserId})
+	}
+	return res
+}
+
+func fromMentions(mentions []mention) []*pb.Mention {
+	res := make([]*pb.Mention, 0, len(mentions))
+	for _, v := range mentions {
+		res = append(res, &pb.Mention{Offset: v.Offset, Length: v.Length, UserId: v.UserId})
+	}
+	return res
+}
+
+// parseMentions finds the mentions in the message. Users have no unique
+// names, so a mention is the user id in the form @id123. Like hashtags,
+// a mention must not be glued to a word, so e-mails are skipped.
+func parseMentions(message string) []*pb.Mention {
+
+	res := make([]*pb.Mention, 0)
+
+	runes := []rune(message)
+	for i := 0; i < len(runes); i++ {
+		if runes[i] != '@' {
+			continue
+		}
+		if i > 0 && (isTagRune(runes[i-1]) || runes[i-1] == '@') {
+			continue
+		}
+		if i+3 >= len(runes) || runes[i+1] != 'i' || runes[i+2] != 'd' {
+			continue
+		}
+
+		j := i + 3
+		for j < len(runes) && runes[j] >= '0' && runes[j] <= '9' {
+			j++
+		}
+		if j < len(runes) && isTagRune(runes[j]) {
+			i = j - 1
+			continue
+		}
+
+		user_id, err := strconv.ParseInt(string(runes[i+3:j]), 10, 64)
+		if err == nil && user_id > 0 {
+			res = append(res, &pb.Mention{Offset: int32(i), Length: int32(j - i), UserId: user_id})
+		}
+
+		i = j - 1
+	}
+
+	return res
+}
+
+// resolveMentions parses the mentions in the message and drops those
+// of users unknown to the users service.
+func (s service) resolveMentions(ctx context.Context, message string) ([]*pb.Mention, error) {
+
+	mentions := parseMentions(message)
+	if len(mentions) == 0 {
+		return mentions, nil
+	}
+
+	ids := make([]int64, 0, len(mentions))
+	seen := make(map[int64]bool)
+	for _, m := range mentions {
+		if !seen[m.UserId] {
+			seen[m.UserId] = true
+			ids = append(ids, m.UserId)
+		}
+	}
+
+	usersres, err := s.userscli.GetUsersByIds(ctx, &pb.GetUsersByIdsRequest{Ids: ids})
+	if err != nil {
+		if status.Code(err) == codes.Unavailable {
+			return nil, ErrServiceUsersUnvaliable
+		}
+		return nil, err
+	}
+
+	exists := make(map[int64]bool)
+	for _, u := range usersres.Users {
+		exists[u.Id] = true
+	}
+
+	res := make([]*pb.Mention, 0, len(mentions))
+	for _, m := range mentions {
+		if exists[m.UserId] {
+			res = append(res, m)
+		}
+	}
+
+	return res, nil
+}
+
+// saveMentions replaces the rows of the mentions table for a post or a
+// comment. For a post id equals post_id.
+func (s service) saveMentions(id uint64, post_id uint64, owner_id int64, old []*pb.Mention, mentions []*pb.Mention) error {
+
+	oldids := make(map[int64]bool)
+	for _, m := range old {
+		oldids[m.UserId] = true
+	}
+
+	newids := make(map[int64]bool)
+	for _, m := range mentions {
+		if m.UserId != owner_id {
+			newids[m.UserId] = true
+		}
+	}
+
+	batch := s.cses.NewBatch(gocql.LoggedBatch)
+	for user_id := range oldids {
+		if !newids[user_id] {
+			batch.Query("DELETE FROM mentions WHERE user_id = ? AND id = ?", user_id, id)
+		}
+	}
+	for user_id := range newids {
+		if !oldids[user_id] {
+			batch.Query("INSERT INTO mentions (user_id, id, post_id, owner_id) VALUES (?, ?, ?, ?)", user_id, id, post_id, owner_id)
+		}
+	}
+
+	if batch.Size() == 0 {
+		return nil
+	}
+
+	err := s.cses.ExecuteBatch(batch)
+	if err != nil {
+		return ErrInternal(err)
+	}
+
+	return nil
+}
+
+func (s service) GetMentions(ctx context.Context, req *pb.GetMentionsRequest) (*pb.GetMentionsResponse, error) {
+
+	user_id, err := userId(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Limit < 0 || req.Limit > 100 {
+		return nil, ErrLimitError
+	}
+
+	res := &pb.GetMentionsResponse{}
+	res.Items = make([]*pb.MentionItem, 0, req.Limit)
+
+	v := s.newViewer(user_id)
+
+	last_id := req.LastId
+
+	for len(res.Items) < int(req.Limit) {
+
+		params := make([]any, 0)
+		params = append(params, user_id)
+
+		condition := ""
+
+		if last_id > 0 {
+			condition += "AND id < ?"
+			params = append(params, last_id)
+		}
+
+		limit := req.Limit - int64(len(res.Items))
+		params = append(params, limit)
+
+		type mentionRow struct {
+			id      uint64
+			post_id uint64
+		}
+
+		rows := make([]mentionRow, 0, limit)
+
+		tmprow := mentionRow{}
+		iter := s.cses.Query("SELECT id, post_id FROM mentions WHERE user_id = ? "+condition+" LIMIT ?", params...).Iter()
+		for iter.Scan(&tmprow.id, &tmprow.post_id) {
+			rows = append(rows, tmprow)
+		}
+
+		err = iter.Close()
+		if err != nil {
+			return nil, ErrInternal(err)
+		}
+
+		for _, r := range rows {
+			last_id = r.id
+
+			post, row, err := s.getPost(r.post_id)
+			if err != nil {
+				if err == ErrPostNotFound {
+					continue
+				}
+				return nil, err
+			}
+
+			ok, err := v.canView(ctx, post)
+			if err != nil {
+				return nil, err
+			}
+			if !ok {
+				continue
+			}
+
+			if r.id == r.post_id {
+				err = s.fillPost(ctx, user_id, post, row, nil)
+				if err != nil {
+					return nil, err
+				}
+
+				res.Items = append(res.Items, &pb.MentionItem{Post: post})
+				continue
+			}
+
+			comment, err := s.getComment(ctx, r.post_id, r.id)
+			if err != nil {
+				if err == ErrCommentNotFound {
+					continue
+				}
+				return nil, err
+			}
+
+			res.Items = append(res.Items, &pb.MentionItem{Comment: comment})
+		}
+
+		if int64(len(rows)) < limit {
+			break
+		}
+	}
+
+	if req.Extended && len(res.Items) > 0 {
+
+		ids := make([]int64, 0, len(res.Items))
+		for _, item := range res.Items {
+			if item.Post != nil {
+				ids = append(ids, item.Post.OwnerId)
+			} else {
+				ids = append(ids, item.Comment.OwnerId)
+			}
+		}
+
+		usersres, err := s.userscli.GetUsersByIds(ctx, &pb.GetUsersByIdsRequest{Ids: ids, Fields: req.Fields})
+		if err != nil {
+			if status.Code(err) == codes.Unavailable {
+				return nil, ErrServiceUsersUnvaliable
+			}
+			return nil, err
+		}
+
+		if len(usersres.Users) == len(res.Items) {
+			for i, item := range res.Items {
+				if item.Post != nil {
+					item.Post.Owner = usersres.Users[i]
+				} else {
+					item.Comment.Owner = usersres.Users[i]
+				}
+			}
+		}
+	}
+
+	return res, nil
+}
@@ -0,0 +1,144 @@
+package service
+
+import (
+	"context"
+	"path"
+
+	"github.com/go-kit/log"
+	"github.com/go-kit/log/level"
+	"google.golang.org/grpc"
+)
+
+// Scopes and roles of the access tokens checked by DefaultPolicy.
+const (
+	ScopeRead  = "posts.read"
+	ScopeWrite = "posts.write"
+	ScopeStats = "posts.stats"
+
+	RoleAdmin = "admin"
+)
+
+// Requirement is what a caller needs to call an RPC: any of the scopes
+// or any of the roles. An empty requirement allows every caller.
+type Requirement struct {
+	Scopes []string
+	Roles  []string
+}
+
+func (r Requirement) allows(p *Principal) bool {
+
+	if len(r.Scopes) == 0 && len(r.Roles) == 0 {
+		return true
+	}
+
+	for _, s := range r.Scopes {
+		if p.HasScope(s) {
+			return true
+		}
+	}
+	for _, role := range r.Roles {
+		if p.HasRole(role) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Policy maps the names of the Posts RPCs to their requirements. RPCs
+// missing from the policy are denied.
+type Policy map[string]Requirement
+
+var (
+	readAccess  = Requirement{Scopes: []string{ScopeRead}, Roles: []string{RoleAdmin}}
+	writeAccess = Requirement{Scopes: []string{ScopeWrite}, Roles: []string{RoleAdmin}}
+	statsAccess = Requirement{Scopes: []string{ScopeStats}, Roles: []string{RoleAdmin}}
+)
+
+var DefaultPolicy = Policy{
+	"NewPost":               writeAccess,
+	"GetPostsList":          readAccess,
+	"GetPostsUser":          readAccess,
+	"AddLike":               writeAccess,
+	"DeleteLike":            writeAccess,
+	"WriteComment":          writeAccess,
+	"GetCommentsList":       readAccess,
+	"UpdatePost":            writeAccess,
+	"GetPostById":           readAccess,
+	"SaveDraft":             writeAccess,
+	"ListDrafts":            readAccess,
+	"GetDraft":              readAccess,
+	"DeleteDraft":           writeAccess,
+	"PublishDraft":          writeAccess,
+	"CreateAudience":        writeAccess,
+	"AddToAudience":         writeAccess,
+	"ListAudiences":         readAccess,
+	"GetPostsByTag":         readAccess,
+	"GetTagsFeed":           readAccess,
+	"GetMentions":           readAccess,
+	"VotePoll":              writeAccess,
+	"RetractVote":           writeAccess,
+	"DeletePost":            writeAccess,
+	"SearchPosts":           readAccess,
+	"GetTrendingPosts":      readAccess,
+	"GetRankedFeed":         readAccess,
+	"MarkSeen":              writeAccess,
+	"GetNewPostsCount":      readAccess,
+	"GetPostStats":          statsAccess,
+	"GetAuthorStats":        statsAccess,
+	"SubscribeFeed":         readAccess,
+	"SubscribePostComments": readAccess,
+}
+
+// GetPolicyInterceptor checks the principal set by GetUnaryInterceptor
+// against the policy. In dry run mode denials are only logged. Guests
+// have no scopes, the methods they may call are set by AuthConfig.
+func GetPolicyInterceptor(policy Policy, dryRun bool, logger log.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+
+		err = policy.check(ctx, info.FullMethod, dryRun, logger)
+		if err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// GetPolicyStreamInterceptor is GetPolicyInterceptor for streaming RPCs,
+// it must be chained after GetStreamInterceptor.
+func GetPolicyStreamInterceptor(policy Policy, dryRun bool, logger log.Logger) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+
+		err := policy.check(ss.Context(), info.FullMethod, dryRun, logger)
+		if err != nil {
+			return err
+		}
+
+		return handler(srv, ss)
+	}
+}
+
+func (policy Policy) check(ctx context.Context, fullMethod string, dryRun bool, logger log.Logger) error {
+
+	method := path.Base(fullMethod)
+
+	p, ok := PrincipalFromContext(ctx)
+	if !ok {
+		return ErrInvalidAccessToken
+	}
+
+	if p.Kind == PrincipalGuest {
+		return nil
+	}
+
+	requirement, ok := policy[method]
+	if !ok || !requirement.allows(p) {
+		level.Warn(logger).Log("msg", "permission denied", "method", method, "user_id", p.UserId, "service", p.Service, "dry_run", dryRun)
+		if !dryRun {
+			return ErrPermissionDenied
+		}
+	}
+
+	return nil
+}
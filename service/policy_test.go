@@ -0,0 +1,80 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-kit/log"
+	"github.com/golang-jwt/jwt/v5"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+var testSigningKey = []byte("test_key")
+
+func testToken(t *testing.T, claims *tokenClaims) string {
+	t.Helper()
+
+	claims.ExpiresAt = jwt.NewNumericDate(time.Now().Add(time.Hour))
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(testSigningKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return token
+}
+
+// call runs the auth and the enforced policy interceptors for the method
+// with the metadata and returns the principal the handler got.
+func call(method string, md metadata.MD) (*Principal, error) {
+
+	auth := GetUnaryInterceptor(AuthConfig{SigningKey: testSigningKey}, log.NewNopLogger())
+	policy := GetPolicyInterceptor(DefaultPolicy, false, log.NewNopLogger())
+	info := &grpc.UnaryServerInfo{FullMethod: "/Posts/" + method}
+
+	var principal *Principal
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		principal, _ = PrincipalFromContext(ctx)
+		return nil, nil
+	}
+
+	ctx := metadata.NewIncomingContext(context.Background(), md)
+	_, err := auth(ctx, nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return policy(ctx, req, info, handler)
+	})
+
+	return principal, err
+}
+
+func TestPolicyScopelessUserToken(t *testing.T) {
+
+	token := testToken(t, &tokenClaims{RegisteredClaims: jwt.RegisteredClaims{Subject: "user", ID: "5"}})
+
+	for _, method := range []string{"GetPostsList", "NewPost", "GetAuthorStats"} {
+		p, err := call(method, metadata.Pairs("authorization", "Bearer "+token))
+		if err != nil {
+			t.Errorf("%s: got %v", method, err)
+			continue
+		}
+		if p.Kind != PrincipalUser || p.UserId != 5 {
+			t.Errorf("%s: got principal %+v", method, p)
+		}
+	}
+}
+
+func TestPolicyScopedUserToken(t *testing.T) {
+
+	token := testToken(t, &tokenClaims{RegisteredClaims: jwt.RegisteredClaims{Subject: "user", ID: "5"}, Scope: ScopeRead})
+
+	_, err := call("GetPostsList", metadata.Pairs("authorization", "Bearer "+token))
+	if err != nil {
+		t.Errorf("read: got %v", err)
+	}
+
+	_, err = call("NewPost", metadata.Pairs("authorization", "Bearer "+token))
+	if status.Code(err) != status.Code(ErrPermissionDenied) {
+		t.Errorf("write: got %v, want %v", err, ErrPermissionDenied)
+	}
+}
@@ -0,0 +1,278 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/NexusIT-Dev/nexusmicro_publications/pb"
+	"github.com/gocql/gocql"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	minPollOptions        = 2
+	maxPollOptions        = 10
+	maxPollOptionLength   = 100
+	maxPollQuestionLength = 300
+)
+
+func validPoll(poll *pb.NewPoll) bool {
+
+	if len([]rune(poll.Question)) > maxPollQuestionLength {
+		return false
+	}
+
+	if len(poll.Options) < minPollOptions || len(poll.Options) > maxPollOptions {
+		return false
+	}
+
+	for _, o := range poll.Options {
+		if strings.TrimSpace(o) == "" || len([]rune(o)) > maxPollOptionLength {
+			return false
+		}
+	}
+
+	if poll.CloseTime != nil && (!poll.CloseTime.IsValid() || poll.CloseTime.AsTime().Before(time.Now())) {
+		return false
+	}
+
+	return true
+}
+
+// newPoll returns the poll of a new post, without votes.
+func newPoll(poll *pb.NewPoll) *pb.Poll {
+
+	res := &pb.Poll{
+		Question:  poll.Question,
+		Multiple:  poll.Multiple,
+		CloseTime: poll.CloseTime,
+		Options:   make([]*pb.PollOption, 0, len(poll.Options)),
+	}
+
+	for i, o := range poll.Options {
+		res.Options = append(res.Options, &pb.PollOption{Id: int32(i), Text: o})
+	}
+
+	return res
+}
+
+func (s service) savePoll(post_id uint64, poll *pb.NewPoll) error {
+
+	var close_time any
+	if poll.CloseTime != nil {
+		close_time = poll.CloseTime.AsTime()
+	}
+
+	err := s.cses.Query("INSERT INTO polls (post_id, question, options, multiple, close_time) VALUES (?, ?, ?, ?, ?)", post_id, poll.Question, poll.Options, poll.Multiple, close_time).Exec()
+	if err != nil {
+		return ErrInternal(err)
+	}
+
+	return nil
+}
+
+// deletePoll deletes the poll of the post with its votes. Counter tables
+// can not be mixed with regular ones in a batch, so they are deleted
+// separately.
+func (s service) deletePoll(post_id uint64) error {
+
+	batch := s.cses.NewBatch(gocql.LoggedBatch)
+	batch.Query("DELETE FROM polls WHERE post_id = ?", post_id)
+	batch.Query("DELETE FROM poll_votes WHERE post_id = ?", post_id)
+
+	err := s.cses.ExecuteBatch(batch)
+	if err != nil {
+		return ErrInternal(err)
+	}
+
+	err = s.cses.Query("DELETE FROM poll_tallies WHERE post_id = ?", post_id).Exec()
+	if err != nil {
+		return ErrInternal(err)
+	}
+
+	err = s.cses.Query("DELETE FROM poll_voters WHERE post_id = ?", post_id).Exec()
+	if err != nil {
+		return ErrInternal(err)
+	}
+
+	return nil
+}
+
+// getPoll loads the poll of the post with the tallies and the vote of the user.
+func (s service) getPoll(user_id int64, post_id uint64) (*pb.Poll, error) {
+
+	res := &pb.Poll{}
+
+	var options []string
+	var close_time time.Time
+	err := s.cses.Query("SELECT question, options, multiple, close_time FROM polls WHERE post_id = ?", post_id).Scan(&res.Question, &options, &res.Multiple, &close_time)
+	if err != nil {
+		if err == gocql.ErrNotFound {
+			return nil, ErrPollNotFound
+		}
+		return nil, ErrInternal(err)
+	}
+
+	if !close_time.IsZero() {
+		res.CloseTime = timestamppb.New(close_time.Local())
+		res.Closed = close_time.Before(time.Now())
+	}
+
+	res.Options = make([]*pb.PollOption, 0, len(options))
+	for i, o := range options {
+		res.Options = append(res.Options, &pb.PollOption{Id: int32(i), Text: o})
+	}
+
+	var option_id int32
+	var votes int64
+	iter := s.cses.Query("SELECT option_id, votes FROM poll_tallies WHERE post_id = ?", post_id).Iter()
+	for iter.Scan(&option_id, &votes) {
+		if option_id >= 0 && int(option_id) < len(res.Options) {
+			res.Options[option_id].Votes = votes
+		}
+	}
+	err = iter.Close()
+	if err != nil {
+		return nil, ErrInternal(err)
+	}
+
+	err = s.cses.Query("SELECT voters FROM poll_voters WHERE post_id = ?", post_id).Scan(&res.Voters)
+	if err != nil && err != gocql.ErrNotFound {
+		return nil, ErrInternal(err)
+	}
+
+	var voted []int32
+	err = s.cses.Query("SELECT option_ids FROM poll_votes WHERE post_id = ? AND user_id = ?", post_id, user_id).Scan(&voted)
+	if err != nil && err != gocql.ErrNotFound {
+		return nil, ErrInternal(err)
+	}
+	for _, id := range voted {
+		if id >= 0 && int(id) < len(res.Options) {
+			res.Options[id].Voted = true
+		}
+	}
+
+	return res, nil
+}
+
+// countVote adds delta to the tallies of the options and to the voters.
+func (s service) countVote(post_id uint64, option_ids []int32, delta int64) error {
+
+	batch := s.cses.NewBatch(gocql.CounterBatch)
+	for _, id := range option_ids {
+		batch.Query("UPDATE poll_tallies SET votes = votes + ? WHERE post_id = ? AND option_id = ?", delta, post_id, id)
+	}
+	batch.Query("UPDATE poll_voters SET voters = voters + ? WHERE post_id = ?", delta, post_id)
+
+	err := s.cses.ExecuteBatch(batch)
+	if err != nil {
+		return ErrInternal(err)
+	}
+
+	return nil
+}
+
+func (s service) VotePoll(ctx context.Context, req *pb.VotePollRequest) (*pb.VotePollResponse, error) {
+
+	user_id, err := userId(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.checkPostAccess(ctx, user_id, req.PostId)
+	if err != nil {
+		return nil, err
+	}
+
+	poll, err := s.getPoll(user_id, req.PostId)
+	if err != nil {
+		return nil, err
+	}
+	if poll.Closed {
+		return nil, ErrPollClosed
+	}
+
+	if len(req.OptionIds) == 0 || (!poll.Multiple && len(req.OptionIds) != 1) {
+		return nil, ErrInvalidPollVote
+	}
+	seen := make(map[int32]bool)
+	for _, id := range req.OptionIds {
+		if id < 0 || int(id) >= len(poll.Options) || seen[id] {
+			return nil, ErrInvalidPollVote
+		}
+		seen[id] = true
+	}
+
+	// the primary key of poll_votes allows a single vote per user.
+	applied, err := s.cses.Query("INSERT INTO poll_votes (post_id, user_id, option_ids) VALUES (?, ?, ?) IF NOT EXISTS", req.PostId, user_id, req.OptionIds).MapScanCAS(make(map[string]interface{}))
+	if err != nil {
+		return nil, ErrInternal(err)
+	}
+	if !applied {
+		return nil, ErrAlreadyVoted
+	}
+
+	err = s.countVote(req.PostId, req.OptionIds, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	poll, err = s.getPoll(user_id, req.PostId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.VotePollResponse{Poll: poll}, nil
+}
+
+func (s service) RetractVote(ctx context.Context, req *pb.RetractVoteRequest) (*pb.RetractVoteResponse, error) {
+
+	user_id, err := userId(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.checkPostAccess(ctx, user_id, req.PostId)
+	if err != nil {
+		return nil, err
+	}
+
+	poll, err := s.getPoll(user_id, req.PostId)
+	if err != nil {
+		return nil, err
+	}
+	if poll.Closed {
+		return nil, ErrPollClosed
+	}
+
+	var option_ids []int32
+	err = s.cses.Query("SELECT option_ids FROM poll_votes WHERE post_id = ? AND user_id = ?", req.PostId, user_id).Scan(&option_ids)
+	if err != nil {
+		if err == gocql.ErrNotFound {
+			return nil, ErrPollVoteNotFound
+		}
+		return nil, ErrInternal(err)
+	}
+
+	// the condition makes sure that concurrent retracts decrement the tallies once.
+	applied, err := s.cses.Query("DELETE FROM poll_votes WHERE post_id = ? AND user_id = ? IF option_ids = ?", req.PostId, user_id, option_ids).MapScanCAS(make(map[string]interface{}))
+	if err != nil {
+		return nil, ErrInternal(err)
+	}
+	if !applied {
+		return nil, ErrPollVoteNotFound
+	}
+
+	err = s.countVote(req.PostId, option_ids, -1)
+	if err != nil {
+		return nil, err
+	}
+
+	poll, err = s.getPoll(user_id, req.PostId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.RetractVoteResponse{Poll: poll}, nil
+}
@@ -0,0 +1,91 @@
+package service
+
+import (
+	"context"
+	"regexp"
+	"strings"
+
+	"github.com/NexusIT-Dev/nexusmicro_publications/pb"
+	"github.com/NexusIT-Dev/nexusmicro_publications/unfurl"
+	"github.com/go-kit/log/level"
+)
+
+const (
+	maxPreviews    = 3
+	urlTrailingSet = ".,;:!?)]}'\""
+)
+
+var urlRegexp = regexp.MustCompile(`https?://[^\s<>"]+`)
+
+// linkPreview is the cql representation of pb.LinkPreview.
+type linkPreview struct {
+	Url         string `cql:"url"`
+	Title       string `cql:"title"`
+	Description string `cql:"description"`
+	Image       string `cql:"image"`
+}
+
+func fromLinkPreviews(previews []linkPreview) []*pb.LinkPreview {
+	res := make([]*pb.LinkPreview, 0, len(previews))
+	for _, v := range previews {
+		res = append(res, &pb.LinkPreview{Url: v.Url, Title: v.Title, Description: v.Description, Image: v.Image})
+	}
+	return res
+}
+
+// extractUrls returns up to maxPreviews distinct urls of the links and
+// the bare urls in the message, in order of appearance.
+func extractUrls(message string, entities []*pb.TextEntity) []string {
+
+	res := make([]string, 0)
+	seen := make(map[string]bool)
+
+	add := func(u string) {
+		if len(res) < maxPreviews && !seen[u] && validLink(u) {
+			seen[u] = true
+			res = append(res, u)
+		}
+	}
+
+	for _, e := range entities {
+		if e.Type == pb.TextEntityType_link {
+			add(e.Url)
+		}
+	}
+
+	for _, u := range urlRegexp.FindAllString(message, -1) {
+		add(strings.TrimRight(u, urlTrailingSet))
+	}
+
+	return res
+}
+
+// unfurlPost builds the previews of the urls and stores them in the post.
+// It runs in the background after the post is written, the previews are
+// dropped if the message has been changed in the meantime. The fetches
+// are bounded by the timeout of the fetcher of the unfurler.
+func (s service) unfurlPost(post_id uint64, message string, urls []string) {
+
+	ctx := context.Background()
+
+	previews := make([]linkPreview, 0, len(urls))
+	for _, u := range urls {
+		p, err := s.unfurler.Unfurl(ctx, u)
+		if err != nil {
+			if err != unfurl.ErrNoPreview {
+				level.Info(s.logger).Log("msg", "failed to unfurl url", "url", u, "err", err)
+			}
+			continue
+		}
+		previews = append(previews, linkPreview{Url: u, Title: p.Title, Description: p.Description, Image: p.Image})
+	}
+
+	if len(previews) == 0 {
+		return
+	}
+
+	_, err := s.cses.Query("UPDATE posts SET previews = ? WHERE bucket = ? AND id = ? IF message = ?", previews, s.bucket(post_id), post_id, message).WithContext(ctx).MapScanCAS(make(map[string]interface{}))
+	if err != nil {
+		level.Error(s.logger).Log("msg", "failed to save previews", "post_id", post_id, "err", err)
+	}
+}
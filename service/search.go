@@ -0,0 +1,204 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/NexusIT-Dev/nexusmicro_publications/pb"
+	"github.com/NexusIT-Dev/nexusmicro_publications/search"
+	"github.com/godruoyi/go-snowflake"
+)
+
+// idFromTime returns the smallest snowflake id generated at t or later.
+func idFromTime(t time.Time) uint64 {
+
+	start := (&snowflake.SID{}).GenerateTime()
+	if !t.After(start) {
+		return 0
+	}
+
+	return uint64(t.Sub(start).Milliseconds()) << (snowflake.MachineIDLength + snowflake.SequenceLength)
+}
+
+// maxSearchRounds limits the index searches of a SearchPosts request.
+const maxSearchRounds = 5
+
+func postDocument(post *pb.Post) *search.Document {
+	return &search.Document{Id: post.Id, PostId: post.Id, OwnerId: post.OwnerId, Text: post.Message, Tags: uniqueTags(post.Message)}
+}
+
+func commentDocument(comment *pb.Comment) *search.Document {
+	return &search.Document{Id: comment.Id, PostId: comment.PostId, OwnerId: comment.OwnerId, Text: comment.Message, Tags: uniqueTags(comment.Message)}
+}
+
+func (s store) indexDocument(ctx context.Context, doc *search.Document) error {
+	err := s.index.Index(ctx, doc)
+	if err != nil {
+		return ErrInternal(err)
+	}
+	return nil
+}
+
+func (s store) deleteDocument(ctx context.Context, doc *search.Document) error {
+	err := s.index.Delete(ctx, doc)
+	if err != nil {
+		return ErrInternal(err)
+	}
+	return nil
+}
+
+// shownBefore marks in seen the posts that have a document matching the
+// query in [cursor; max_id), such posts were shown by the earlier pages.
+// The lookup is limited by maxSearchRounds like the search itself.
+func shownBefore(ctx context.Context, index search.Index, query search.Query, cursor uint64, max_id uint64, post_ids map[uint64]bool, seen map[uint64]bool) error {
+
+	query.MinId = cursor
+	query.MaxId = max_id
+	query.PostIds = post_ids
+	query.Limit = 100
+
+	for round := 0; len(post_ids) > 0 && round < maxSearchRounds; round++ {
+
+		result, err := index.Search(ctx, &query)
+		if err != nil {
+			return err
+		}
+
+		for _, hit := range result.Hits {
+			seen[hit.PostId] = true
+			delete(post_ids, hit.PostId)
+		}
+
+		if result.Next == 0 {
+			break
+		}
+		query.MaxId = result.Next
+	}
+
+	return nil
+}
+
+func (s service) SearchPosts(ctx context.Context, req *pb.SearchPostsRequest) (*pb.SearchPostsResponse, error) {
+
+	user_id, err := userId(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Limit < 0 || req.Limit > 100 {
+		return nil, ErrLimitError
+	}
+
+	query := &search.Query{Text: req.Query, OwnerId: req.OwnerId, MaxId: req.LastId, PostsOnly: !req.IncludeComments}
+
+	if req.Tag != "" {
+		tag, ok := normalizeTag(req.Tag)
+		if !ok {
+			return nil, ErrInvalidTag
+		}
+		query.Tag = tag
+	}
+
+	if req.From != nil {
+		query.MinId = idFromTime(req.From.AsTime())
+	}
+	var max_id uint64
+	if req.To != nil {
+		max_id = idFromTime(req.To.AsTime())
+		if max_id == 0 {
+			return &pb.SearchPostsResponse{Posts: []*pb.Post{}}, nil
+		}
+		if query.MaxId == 0 || max_id < query.MaxId {
+			query.MaxId = max_id
+		}
+	}
+
+	var comments *pb.GetCommentsListRequest
+	if req.Extended && req.CommentsLimit > 0 {
+		comments = &pb.GetCommentsListRequest{Limit: req.CommentsLimit, Extended: req.CommentsExtended, SortDir: req.CommentsSortDir, Fields: req.CommentsFields}
+	}
+
+	res := &pb.SearchPostsResponse{}
+	res.Posts = make([]*pb.Post, 0, req.Limit)
+
+	v := s.newViewer(user_id)
+	seen := make(map[uint64]bool)
+
+	// a search that reads many hits the user can not see stops after
+	// maxSearchRounds with a cursor, so one request can not scan the whole
+	// index.
+	for round := 0; len(res.Posts) < int(req.Limit) && round < maxSearchRounds; round++ {
+
+		query.Limit = int(req.Limit) - len(res.Posts)
+
+		result, err := s.index.Search(ctx, query)
+		if err != nil {
+			if err == search.ErrEmptyQuery {
+				return nil, ErrEmptyQuery
+			}
+			return nil, ErrInternal(err)
+		}
+
+		// a post matches with its own document and with those of its
+		// comments and is shown at the newest of them, so on the next
+		// pages the posts matching newer than the cursor are skipped.
+		if req.LastId != 0 && !query.PostsOnly {
+			post_ids := make(map[uint64]bool)
+			for _, hit := range result.Hits {
+				if !seen[hit.PostId] {
+					post_ids[hit.PostId] = true
+				}
+			}
+
+			err = shownBefore(ctx, s.index, *query, req.LastId, max_id, post_ids, seen)
+			if err != nil {
+				return nil, ErrInternal(err)
+			}
+		}
+
+		for _, hit := range result.Hits {
+			if seen[hit.PostId] {
+				continue
+			}
+
+			post, row, err := s.getPost(hit.PostId)
+			if err != nil {
+				if err == ErrPostNotFound {
+					continue
+				}
+				return nil, err
+			}
+
+			ok, err := v.canView(ctx, post)
+			if err != nil {
+				return nil, err
+			}
+			if !ok {
+				continue
+			}
+
+			err = s.fillPost(ctx, user_id, post, row, comments)
+			if err != nil {
+				return nil, err
+			}
+
+			seen[hit.PostId] = true
+			res.Posts = append(res.Posts, post)
+		}
+
+		res.LastId = result.Next
+		if result.Next == 0 {
+			break
+		}
+		query.MaxId = result.Next
+	}
+
+	if req.Extended {
+		err = s.fillOwners(ctx, res.Posts, req.Fields)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return res, nil
+}
@@ -0,0 +1,64 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/NexusIT-Dev/nexusmicro_publications/search"
+)
+
+func TestShownBeforeSkipsPostMatchingTwice(t *testing.T) {
+
+	ctx := context.Background()
+	index := search.NewMemoryIndex()
+
+	// post 10 matches with its text and with its comment 30, post 20 only
+	// with its text.
+	for _, doc := range []*search.Document{
+		{Id: 10, PostId: 10, Text: "golang"},
+		{Id: 20, PostId: 20, Text: "golang"},
+		{Id: 30, PostId: 10, Text: "golang"},
+	} {
+		if err := index.Index(ctx, doc); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	query := search.Query{Text: "golang", Limit: 1}
+
+	first, err := index.Search(ctx, &query)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(first.Hits) != 1 || first.Hits[0].PostId != 10 {
+		t.Fatalf("first page %v, want post 10", first.Hits)
+	}
+
+	query.MaxId = first.Next
+	query.Limit = 10
+	second, err := index.Search(ctx, &query)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	seen := make(map[uint64]bool)
+	post_ids := make(map[uint64]bool)
+	for _, hit := range second.Hits {
+		post_ids[hit.PostId] = true
+	}
+
+	err = shownBefore(ctx, index, query, first.Next, 0, post_ids, seen)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var shown []uint64
+	for _, hit := range second.Hits {
+		if !seen[hit.PostId] {
+			shown = append(shown, hit.PostId)
+		}
+	}
+	if len(shown) != 1 || shown[0] != 20 {
+		t.Errorf("second page shows %v, want [20]", shown)
+	}
+}
@@ -0,0 +1,176 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/NexusIT-Dev/nexusmicro_publications/pb"
+	"github.com/gocql/gocql"
+	"github.com/godruoyi/go-snowflake"
+)
+
+const (
+	// seenTTL is how long the seen posts and the last visit are kept.
+	seenTTL = time.Hour * 24 * 30
+
+	maxMarkSeen      = 100
+	maxNewPostsCount = 100
+	// newPostsWindow bounds the count if the last visit is unknown.
+	newPostsWindow = time.Hour * 24 * 7
+)
+
+func (s service) MarkSeen(ctx context.Context, req *pb.MarkSeenRequest) (*pb.MarkSeenResponse, error) {
+
+	user_id, err := userId(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(req.PostIds) > maxMarkSeen {
+		return nil, ErrTooManyPosts
+	}
+	if len(req.PostIds) == 0 {
+		return &pb.MarkSeenResponse{}, nil
+	}
+
+	ttl := int64(seenTTL.Seconds())
+	now := snowflake.ID()
+
+	var last_seen_id uint64
+	batch := s.cses.NewBatch(gocql.UnloggedBatch)
+	for _, id := range req.PostIds {
+		batch.Query("INSERT INTO seen_posts (user_id, post_id) VALUES (?, ?) USING TTL ?", user_id, id, ttl)
+		if id > last_seen_id && id <= now {
+			last_seen_id = id
+		}
+	}
+
+	err = s.cses.ExecuteBatch(batch)
+	if err != nil {
+		return nil, ErrInternal(err)
+	}
+
+	var visit uint64
+	err = s.cses.Query("SELECT last_seen_id FROM feed_visits WHERE user_id = ?", user_id).Scan(&visit)
+	if err != nil && err != gocql.ErrNotFound {
+		return nil, ErrInternal(err)
+	}
+
+	if last_seen_id > visit {
+		err = s.cses.Query("INSERT INTO feed_visits (user_id, last_seen_id) VALUES (?, ?) USING TTL ?", user_id, last_seen_id, ttl).Exec()
+		if err != nil {
+			return nil, ErrInternal(err)
+		}
+	}
+
+	err = s.addViews(ctx, user_id, req.PostIds)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.MarkSeenResponse{}, nil
+}
+
+func (s service) GetNewPostsCount(ctx context.Context, req *pb.GetNewPostsCountRequest) (*pb.GetNewPostsCountResponse, error) {
+
+	user_id, err := userId(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var last_seen_id uint64
+	err = s.cses.Query("SELECT last_seen_id FROM feed_visits WHERE user_id = ?", user_id).Scan(&last_seen_id)
+	if err != nil && err != gocql.ErrNotFound {
+		return nil, ErrInternal(err)
+	}
+
+	if from := idFromTime(time.Now().Add(-newPostsWindow)); last_seen_id < from {
+		last_seen_id = from
+	}
+
+	res := &pb.GetNewPostsCountResponse{}
+
+	v := s.newViewer(user_id)
+
+	first := s.bucket(last_seen_id)
+	bucket := s.bucket(snowflake.ID())
+	last_id := uint64(0)
+
+	for res.Count < maxNewPostsCount {
+
+		params := make([]any, 0)
+		params = append(params, bucket, last_seen_id)
+
+		condition := ""
+
+		if last_id > 0 {
+			condition += "AND id < ? "
+			params = append(params, last_id)
+		}
+
+		limit := maxNewPostsCount - res.Count
+		params = append(params, limit)
+
+		iter := s.cses.Query("SELECT owner_id, id, visibility, allowed_ids, audience_id FROM posts WHERE bucket = ? AND id > ? "+condition+"ORDER BY id DESC LIMIT ?", params...).Iter()
+
+		rows := int64(0)
+		tmppost := &pb.Post{}
+		for iter.Scan(&tmppost.OwnerId, &tmppost.Id, &tmppost.Visibility, &tmppost.AllowedIds, &tmppost.AudienceId) {
+			rows++
+			last_id = tmppost.Id
+
+			ok, err := v.canView(ctx, tmppost)
+			if err != nil {
+				iter.Close()
+				return nil, err
+			}
+
+			if ok && tmppost.OwnerId != user_id {
+				res.Count++
+			}
+
+			tmppost = &pb.Post{}
+		}
+
+		err = iter.Close()
+		if err != nil {
+			return nil, ErrInternal(err)
+		}
+
+		// the bucket is exhausted, continue with the previous one.
+		if rows < limit {
+			if bucket <= first {
+				break
+			}
+			bucket--
+		}
+	}
+
+	return res, nil
+}
+
+// seenPosts returns the posts of ids that the user has marked as seen.
+func (s service) seenPosts(user_id int64, ids []uint64) (map[uint64]bool, error) {
+
+	res := make(map[uint64]bool)
+
+	for start := 0; start < len(ids); start += maxMarkSeen {
+		end := start + maxMarkSeen
+		if end > len(ids) {
+			end = len(ids)
+		}
+
+		var id uint64
+		iter := s.cses.Query("SELECT post_id FROM seen_posts WHERE user_id = ? AND post_id IN ?", user_id, ids[start:end]).Iter()
+		for iter.Scan(&id) {
+			res[id] = true
+		}
+
+		err := iter.Close()
+		if err != nil {
+			return nil, ErrInternal(err)
+		}
+	}
+
+	return res, nil
+}
@@ -2,30 +2,30 @@ package service
 
 import (
 	"context"
-	"fmt"
-	"strconv"
-	"strings"
 	"time"
 
 	"github.com/NexusIT-Dev/nexusmicro_publications/pb"
+	"github.com/NexusIT-Dev/nexusmicro_publications/pubsub"
+	"github.com/NexusIT-Dev/nexusmicro_publications/ranking"
+	"github.com/NexusIT-Dev/nexusmicro_publications/search"
+	"github.com/NexusIT-Dev/nexusmicro_publications/unfurl"
 	"github.com/go-kit/log"
 	"github.com/gocql/gocql"
 	"github.com/godruoyi/go-snowflake"
-	"github.com/golang-jwt/jwt/v5"
-	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type service struct {
-	bucketDuration time.Duration
-	cses           *gocql.Session
-	signingKey     []byte
-	storagecli     pb.StorageClient
-	userscli       pb.UsersClient
-	linkedacccli   pb.LinkedaccClient
+	store
+	signingKey   []byte
+	storagecli   pb.StorageClient
+	userscli     pb.UsersClient
+	linkedacccli pb.LinkedaccClient
+	unfurler     *unfurl.Unfurler
+	ranker       *ranking.Ranker
+	federation   Federation
 }
 
 func NewService(cses *gocql.Session,
@@ -34,156 +34,134 @@ func NewService(cses *gocql.Session,
 	storagecli pb.StorageClient,
 	userscli pb.UsersClient,
 	linkedacccli pb.LinkedaccClient,
+	unfurler *unfurl.Unfurler,
+	index search.Index,
+	ranker *ranking.Ranker,
+	broker pubsub.Broker,
+	federation Federation,
+	logger log.Logger,
 ) pb.PostsServer {
 	return &service{
-		cses:           cses,
-		signingKey:     signingKey,
-		bucketDuration: bucketDuration,
-		storagecli:     storagecli,
-		userscli:       userscli,
-		linkedacccli:   linkedacccli,
+		store: store{
+			cses:           cses,
+			bucketDuration: bucketDuration,
+			index:          index,
+			broker:         broker,
+			logger:         logger,
+		},
+		signingKey:   signingKey,
+		storagecli:   storagecli,
+		userscli:     userscli,
+		linkedacccli: linkedacccli,
+		unfurler:     unfurler,
+		ranker:       ranker,
+		federation:   federation,
 	}
 }
 
-func GetUnaryInterceptor(signingKey []byte, logger log.Logger) grpc.UnaryServerInterceptor {
-	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
-
-		token := ""
-
-		md, ok := metadata.FromIncomingContext(ctx)
-		if !ok {
-			return nil, ErrInternal(fmt.Errorf("failed to get metadata"))
-		}
-		headertoken := md.Get("authorization")
-		if headertoken != nil && len(headertoken) == 1 {
-			stringsheader := strings.Split(headertoken[0], " ")
-
-			if stringsheader[0] != "Bearer" {
-				return nil, ErrInvalidAccessToken
-			}
-			if len(stringsheader) != 2 {
-				return nil, ErrInvalidAccessToken
-			}
-			token = stringsheader[1]
-		} else {
-			return nil, ErrInvalidAccessToken
-		}
-
-		jwttoken, err := jwt.ParseWithClaims(token, &jwt.RegisteredClaims{}, func(token *jwt.Token) (interface{}, error) {
-			if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-				return nil, fmt.Errorf("Unexpected signing method: %v", token.Header["alg"])
-			}
-			return signingKey, nil
-		})
-
-		if err != nil {
-			return nil, ErrInvalidAccessToken
-		}
-
-		if claims, ok := jwttoken.Claims.(*jwt.RegisteredClaims); ok && jwttoken.Valid {
-			ctx = context.WithValue(ctx, claims.Subject, claims.ID)
-			ctx = metadata.NewOutgoingContext(ctx, md)
-		} else {
-			return nil, ErrInvalidAccessToken
-		}
+func (s service) GetPostById(ctx context.Context, req *pb.GetPostByIdRequest) (*pb.GetPostByIdResponse, error) {
 
-		return handler(ctx, req)
+	user_id, err := viewerId(ctx)
+	if err != nil {
+		return nil, err
 	}
-}
 
-func (s service) GetPostById(ctx context.Context, req *pb.GetPostByIdRequest) (*pb.GetPostByIdResponse, error) {
+	res := &pb.GetPostByIdResponse{}
 
-	user_id, err := strconv.ParseInt(ctx.Value("user").(string), 10, 64)
+	var row *postRow
+	res.Post, row, err = s.getPost(req.Id)
 	if err != nil {
-		return nil, ErrInternal(err)
+		return nil, err
 	}
 
-	res := &pb.GetPostByIdResponse{Post: &pb.Post{}}
-
-	bucket := snowflake.ParseID(req.Id).Timestamp / uint64(s.bucketDuration.Milliseconds())
+	ok, err := s.newViewer(user_id).canView(ctx, res.Post)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrPostNotFound
+	}
 
-	att := []*pb.AttachmentId{}
-	err = s.cses.Query("SELECT id, message, owner_id, attachments FROM posts WHERE bucket = ? AND id = ?", bucket, req.Id).Scan(&res.Post.Id, &res.Post.Message, &res.Post.OwnerId, &att)
+	var comments *pb.GetCommentsListRequest
+	if req.Extended && req.CommentsLimit > 0 {
+		comments = &pb.GetCommentsListRequest{Limit: req.CommentsLimit, Extended: req.CommentsExtended, SortDir: req.CommentsSortDir, Fields: req.CommentsFields}
+	}
 
+	err = s.fillPost(ctx, user_id, res.Post, row, comments)
 	if err != nil {
-		if err == gocql.ErrNotFound {
-			return nil, ErrPostNotFound
-		}
-		return nil, ErrInternal(err)
+		return nil, err
 	}
 
-	attach, err := s.storagecli.GetAttachments(ctx, &pb.GetAttachmentsRequest{Ids: att})
+	err = s.addViews(ctx, user_id, []uint64{res.Post.Id})
 	if err != nil {
-		if status.Code(err) == codes.Unavailable {
-			return nil, ErrServiceStorageUnvaliable
-		}
-		fmt.Println(err)
 		return nil, err
 	}
-	res.Post.Attachments = attach.Attachments
 
-	sid := snowflake.ParseID(res.Post.Id)
-	res.Post.Time = timestamppb.New(sid.GenerateTime().Local())
+	return res, nil
+}
+
+func (s service) NewPost(ctx context.Context, req *pb.NewPostRequest) (*pb.NewPostResponse, error) {
 
-	res.Post.Likes = &pb.LikesInfo{}
-	err = s.cses.Query("SELECT Count(*) FROM likes WHERE post_id = ?", res.Post.Id).Scan(&res.Post.Likes.Count)
+	user_id, err := userId(ctx)
 	if err != nil {
-		return nil, ErrInternal(err)
+		return nil, err
 	}
 
-	var cntlikes int64
-	res.Post.Likes.Liked = new(bool)
-	err = s.cses.Query("SELECT Count(*) FROM likes WHERE post_id = ? AND owner_id = ?", res.Post.Id, user_id).Scan(&cntlikes)
+	var entities []*pb.TextEntity
+	req.Message, entities, err = parseMarkup(req.Message)
 	if err != nil {
-		return nil, ErrInternal(err)
-	}
-	if cntlikes > 0 {
-		*res.Post.Likes.Liked = true
+		return nil, err
 	}
 
-	res.Post.Comments = &pb.CommentsInfo{}
-	err = s.cses.Query("SELECT Count(*) FROM comments WHERE post_id = ?", res.Post.Id).Scan(&res.Post.Comments.Count)
-	if err != nil {
-		return nil, ErrInternal(err)
+	if len(req.AttachmentsIds) == 0 && req.Message == "" && req.Poll == nil {
+		return nil, ErrEmptyContent
 	}
 
-	if req.Extended && req.CommentsLimit > 0 {
+	if req.Poll != nil && !validPoll(req.Poll) {
+		return nil, ErrInvalidPoll
+	}
 
-		commentsres, err := s.GetCommentsList(ctx, &pb.GetCommentsListRequest{PostId: res.Post.Id, Limit: req.CommentsLimit, Extended: req.CommentsExtended, SortDir: req.CommentsSortDir, Fields: req.CommentsFields})
+	if !validVisibility(req.Visibility, req.AllowedIds, req.AudienceId) {
+		return nil, ErrInvalidVisibility
+	}
+	if req.Visibility == pb.Visibility_audience {
+		err = s.checkAudienceOwner(user_id, req.AudienceId)
 		if err != nil {
 			return nil, err
 		}
-
-		res.Post.Comments.Items = commentsres.Comments
 	}
 
-	return res, nil
-}
-
-func (s service) NewPost(ctx context.Context, req *pb.NewPostRequest) (*pb.NewPostResponse, error) {
-
-	user_id, err := strconv.ParseInt(ctx.Value("user").(string), 10, 64)
+	mentions, err := s.resolveMentions(ctx, req.Message)
 	if err != nil {
-		return nil, ErrInternal(err)
+		return nil, err
 	}
-
-	if len(req.AttachmentsIds) == 0 && req.Message == "" {
-		return nil, ErrEmptyContent
+	if req.Visibility != pb.Visibility_public && len(req.LinkedaccIds) != 0 {
+		return nil, ErrLinkedaccNotPublic
 	}
 
 	id := snowflake.ID()
-	bucket := snowflake.ParseID(id).Timestamp / uint64(s.bucketDuration.Milliseconds())
+	bucket := s.bucket(id)
 	sid := snowflake.ParseID(id)
 
 	res := &pb.NewPostResponse{Post: &pb.Post{
-		Id:       id,
-		Time:     timestamppb.New(sid.GenerateTime()),
-		OwnerId:  user_id,
-		Message:  req.Message,
-		Likes:    &pb.LikesInfo{Count: new(int64)},
-		Comments: &pb.CommentsInfo{Count: new(int64)},
+		Id:         id,
+		Time:       timestamppb.New(sid.GenerateTime()),
+		OwnerId:    user_id,
+		Message:    req.Message,
+		Likes:      &pb.LikesInfo{Count: new(int64)},
+		Comments:   &pb.CommentsInfo{Count: new(int64)},
+		Visibility: req.Visibility,
+		AllowedIds: req.AllowedIds,
+		AudienceId: req.AudienceId,
+		Tags:       parseTags(req.Message),
+		Mentions:   mentions,
+		Entities:   entities,
 	}}
 
+	if req.Poll != nil {
+		res.Post.Poll = newPoll(req.Poll)
+	}
+
 	if len(req.AttachmentsIds) != 0 {
 		att, err := s.storagecli.GetAttachments(ctx, &pb.GetAttachmentsRequest{Ids: req.AttachmentsIds})
 		if err != nil {
@@ -197,11 +175,34 @@ func (s service) NewPost(ctx context.Context, req *pb.NewPostRequest) (*pb.NewPo
 		res.Post.Attachments = att.Attachments
 	}
 
-	err = s.cses.Query("INSERT INTO posts (bucket, id, message, attachments, owner_id) VALUES (?, ?, ?, ?, ?)", bucket, id, req.Message, req.AttachmentsIds, user_id).Exec()
+	// the poll is saved first, so a post with has_poll always has one.
+	if req.Poll != nil {
+		err = s.savePoll(id, req.Poll)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = s.cses.Query("INSERT INTO posts (bucket, id, message, attachments, owner_id, visibility, allowed_ids, audience_id, mentions, entities, has_poll) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)", bucket, id, req.Message, req.AttachmentsIds, user_id, req.Visibility, req.AllowedIds, req.AudienceId, toMentions(mentions), toTextEntities(entities), req.Poll != nil).Exec()
 	if err != nil {
 		return nil, ErrInternal(err)
 	}
 
+	err = s.saveTags(id, user_id, "", req.Message)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.saveMentions(id, id, user_id, nil, mentions)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.indexDocument(ctx, postDocument(res.Post))
+	if err != nil {
+		return nil, err
+	}
+
 	_, err = s.linkedacccli.NewExternalPost(ctx, &pb.NewExternalPostRequest{
 		PostId: id,
 		Ids:    req.LinkedaccIds,
@@ -213,14 +214,24 @@ func (s service) NewPost(ctx context.Context, req *pb.NewPostRequest) (*pb.NewPo
 		return nil, err
 	}
 
+	if urls := extractUrls(req.Message, entities); len(urls) != 0 {
+		go s.unfurlPost(id, req.Message, urls)
+	}
+
+	s.publish(ctx, feedTopic, pubsub.Event{Type: pubsub.PostCreated, PostId: id, OwnerId: user_id})
+
+	if s.federation != nil {
+		s.federation.PostCreated(res.Post)
+	}
+
 	return res, nil
 }
 
 func (s service) GetPostsList(ctx context.Context, req *pb.GetPostsListRequest) (*pb.GetPostsListResponse, error) {
 
-	user_id, err := strconv.ParseInt(ctx.Value("user").(string), 10, 64)
+	user_id, err := userId(ctx)
 	if err != nil {
-		return nil, ErrInternal(err)
+		return nil, err
 	}
 
 	if req.Limit < 0 || req.Limit > 100 {
@@ -230,80 +241,55 @@ func (s service) GetPostsList(ctx context.Context, req *pb.GetPostsListRequest)
 	res := &pb.GetPostsListResponse{}
 	res.Posts = make([]*pb.Post, 0, req.Limit)
 
-	bucket := snowflake.ParseID(snowflake.ID()).Timestamp / uint64(s.bucketDuration.Milliseconds())
-
-	params := make([]any, 0)
-	params = append(params, bucket)
-
-	condition := ""
-
-	if req.LastId > 0 {
-		condition += "AND id < ?"
-		params = append(params, req.LastId)
+	var comments *pb.GetCommentsListRequest
+	if req.Extended && req.CommentsLimit > 0 {
+		comments = &pb.GetCommentsListRequest{Limit: req.CommentsLimit, Extended: req.CommentsExtended, SortDir: req.CommentsSortDir, Fields: req.CommentsFields}
 	}
 
-	params = append(params, req.Limit)
+	v := s.newViewer(user_id)
 
-	for i := 0; i < int(req.Limit); {
+	bucket := s.bucket(snowflake.ID())
+	last_id := req.LastId
 
-		params[len(params)-1] = req.Limit - int64(i)
+	for len(res.Posts) < int(req.Limit) {
 
-		iter := s.cses.Query("SELECT id, message, owner_id, attachments FROM posts WHERE bucket = ? "+condition+" ORDER BY id DESC LIMIT ?", params...).Iter()
+		params := make([]any, 0)
+		params = append(params, bucket)
 
-		att := []*pb.AttachmentId{}
-		tmppost := &pb.Post{}
-		for iter.Scan(&tmppost.Id, &tmppost.Message, &tmppost.OwnerId, &att) {
-			attach, err := s.storagecli.GetAttachments(ctx, &pb.GetAttachmentsRequest{Ids: att})
-			if err != nil {
-				if status.Code(err) == codes.Unavailable {
-					return nil, ErrServiceStorageUnvaliable
-				}
-				fmt.Println(err)
-				return nil, err
-			}
-			tmppost.Attachments = attach.Attachments
+		condition := ""
 
-			sid := snowflake.ParseID(tmppost.Id)
-			tmppost.Time = timestamppb.New(sid.GenerateTime().Local())
+		if last_id > 0 {
+			condition += "AND id < ?"
+			params = append(params, last_id)
+		}
 
-			tmppost.Likes = &pb.LikesInfo{}
-			err = s.cses.Query("SELECT Count(*) FROM likes WHERE post_id = ?", tmppost.Id).Scan(&tmppost.Likes.Count)
-			if err != nil {
-				return nil, ErrInternal(err)
-			}
+		limit := req.Limit - int64(len(res.Posts))
+		params = append(params, limit)
 
-			var cntlikes int64
-			tmppost.Likes.Liked = new(bool)
-			err = s.cses.Query("SELECT Count(*) FROM likes WHERE post_id = ? AND owner_id = ?", tmppost.Id, user_id).Scan(&cntlikes)
-			if err != nil {
-				return nil, ErrInternal(err)
-			}
-			if cntlikes > 0 {
-				*tmppost.Likes.Liked = true
-			}
+		iter := s.cses.Query("SELECT "+postColumns+" FROM posts WHERE bucket = ? "+condition+" ORDER BY id DESC LIMIT ?", params...).Iter()
 
-			tmppost.Comments = &pb.CommentsInfo{}
-			err = s.cses.Query("SELECT Count(*) FROM comments WHERE post_id = ?", tmppost.Id).Scan(&tmppost.Comments.Count)
+		rows := 0
+		posts := make([]*pb.Post, 0, limit)
+		postrows := make([]*postRow, 0, limit)
+		row := &postRow{}
+		tmppost := &pb.Post{}
+		for iter.Scan(scanPost(tmppost, row)...) {
+			rows++
+			last_id = tmppost.Id
+
+			ok, err := v.canView(ctx, tmppost)
 			if err != nil {
-				return nil, ErrInternal(err)
+				iter.Close()
+				return nil, err
 			}
 
-			if req.Extended && req.CommentsLimit > 0 {
-
-				commentsres, err := s.GetCommentsList(ctx, &pb.GetCommentsListRequest{PostId: tmppost.Id, Limit: req.CommentsLimit, Extended: req.CommentsExtended, SortDir: req.CommentsSortDir, Fields: req.CommentsFields})
-				if err != nil {
-					return nil, err
-				}
-
-				tmppost.Comments.Items = commentsres.Comments
+			if ok {
+				posts = append(posts, tmppost)
+				postrows = append(postrows, row)
 			}
 
-			res.Posts = append(res.Posts, tmppost)
-
-			att = []*pb.AttachmentId{}
+			row = &postRow{}
 			tmppost = &pb.Post{}
-
-			i++
 		}
 
 		err := iter.Close()
@@ -311,32 +297,46 @@ func (s service) GetPostsList(ctx context.Context, req *pb.GetPostsListRequest)
 			return nil, ErrInternal(err)
 		}
 
-		params[0] = params[0].(uint64) - 1
+		// the seen posts of the page are read at once.
+		var seen map[uint64]bool
+		if req.HideSeen && len(posts) != 0 {
+			ids := make([]uint64, 0, len(posts))
+			for _, post := range posts {
+				ids = append(ids, post.Id)
+			}
 
-		if params[0].(uint64) == 0 {
-			break
+			seen, err = s.seenPosts(user_id, ids)
+			if err != nil {
+				return nil, err
+			}
 		}
-	}
 
-	if req.Extended && len(res.Posts) > 0 {
+		for i, post := range posts {
+			if seen[post.Id] {
+				continue
+			}
+
+			err = s.fillPost(ctx, user_id, post, postrows[i], comments)
+			if err != nil {
+				return nil, err
+			}
 
-		ids := make([]int64, 0, len(res.Posts))
-		for i := range res.Posts {
-			ids = append(ids, res.Posts[i].OwnerId)
+			res.Posts = append(res.Posts, post)
 		}
 
-		usersres, err := s.userscli.GetUsersByIds(ctx, &pb.GetUsersByIdsRequest{Ids: ids, Fields: req.Fields})
-		if err != nil {
-			if status.Code(err) == codes.Unavailable {
-				return nil, ErrServiceUsersUnvaliable
+		// the bucket is exhausted, continue with the previous one.
+		if int64(rows) < limit {
+			if bucket == 0 {
+				break
 			}
-			return nil, err
+			bucket--
 		}
+	}
 
-		if len(usersres.Users) == len(res.Posts) {
-			for i := range res.Posts {
-				res.Posts[i].Owner = usersres.Users[i]
-			}
+	if req.Extended {
+		err = s.fillOwners(ctx, res.Posts, req.Fields)
+		if err != nil {
+			return nil, err
 		}
 	}
 
@@ -345,177 +345,219 @@ func (s service) GetPostsList(ctx context.Context, req *pb.GetPostsListRequest)
 
 func (s service) GetPostsUser(ctx context.Context, req *pb.GetPostsUserRequest) (*pb.GetPostsUserResponse, error) {
 
-	user_id, err := strconv.ParseInt(ctx.Value("user").(string), 10, 64)
+	user_id, err := viewerId(ctx)
 	if err != nil {
-		return nil, ErrInternal(err)
+		return nil, err
 	}
 
 	if req.UserId == 0 {
+		if user_id == 0 {
+			return nil, ErrEmptyUserId
+		}
 		req.UserId = user_id
 	}
 
+	if req.Limit < 0 || req.Limit > 100 {
+		return nil, ErrLimitError
+	}
+
 	res := &pb.GetPostsUserResponse{}
 	res.Posts = make([]*pb.Post, 0, req.Limit)
 
-	params := make([]any, 0)
-	params = append(params, req.UserId)
+	var comments *pb.GetCommentsListRequest
+	if req.Extended && req.CommentsLimit > 0 {
+		comments = &pb.GetCommentsListRequest{Limit: req.CommentsLimit, Extended: req.CommentsExtended, SortDir: req.CommentsSortDir, Fields: req.CommentsFields}
+	}
 
-	condition := ""
+	v := s.newViewer(user_id)
 
-	if req.LastId > 0 {
-		condition += "AND id < ?"
-		params = append(params, req.LastId)
-	}
+	last_id := req.LastId
 
-	params = append(params, req.Limit)
+	for len(res.Posts) < int(req.Limit) {
 
-	iter := s.cses.Query("SELECT id, message, owner_id, attachments FROM posts_by_owner_id WHERE owner_id = ? "+condition+" LIMIT ?", params...).Iter()
+		params := make([]any, 0)
+		params = append(params, req.UserId)
 
-	att := []*pb.AttachmentId{}
-	tmppost := &pb.Post{}
-	for iter.Scan(&tmppost.Id, &tmppost.Message, &tmppost.OwnerId, &att) {
-		attach, err := s.storagecli.GetAttachments(ctx, &pb.GetAttachmentsRequest{Ids: att})
-		if err != nil {
-			if status.Code(err) == codes.Unavailable {
-				return nil, ErrServiceStorageUnvaliable
-			}
-			return nil, err
+		condition := ""
+
+		if last_id > 0 {
+			condition += "AND id < ?"
+			params = append(params, last_id)
 		}
-		tmppost.Attachments = attach.Attachments
 
-		sid := snowflake.ParseID(tmppost.Id)
-		tmppost.Time = timestamppb.New(sid.GenerateTime().Local())
+		limit := req.Limit - int64(len(res.Posts))
+		params = append(params, limit)
 
-		tmppost.Likes = &pb.LikesInfo{}
-		err = s.cses.Query("SELECT Count(*) FROM likes WHERE post_id = ?", tmppost.Id).Scan(&tmppost.Likes.Count)
-		if err != nil {
-			return nil, err
+		iter := s.cses.Query("SELECT "+postColumns+" FROM posts_by_owner_id WHERE owner_id = ? "+condition+" LIMIT ?", params...).Iter()
+
+		rows := 0
+		row := &postRow{}
+		tmppost := &pb.Post{}
+		for iter.Scan(scanPost(tmppost, row)...) {
+			rows++
+			last_id = tmppost.Id
+
+			ok, err := v.canView(ctx, tmppost)
+			if err != nil {
+				iter.Close()
+				return nil, err
+			}
+
+			if ok {
+				err = s.fillPost(ctx, user_id, tmppost, row, comments)
+				if err != nil {
+					iter.Close()
+					return nil, err
+				}
+
+				res.Posts = append(res.Posts, tmppost)
+			}
+
+			row = &postRow{}
+			tmppost = &pb.Post{}
 		}
 
-		var cntlikes int64
-		tmppost.Likes.Liked = new(bool)
-		err = s.cses.Query("SELECT Count(*) FROM likes WHERE post_id = ? AND owner_id = ?", tmppost.Id, user_id).Scan(&cntlikes)
+		err = iter.Close()
 		if err != nil {
 			return nil, ErrInternal(err)
 		}
-		if cntlikes > 0 {
-			*tmppost.Likes.Liked = true
+
+		if int64(rows) < limit {
+			break
 		}
+	}
 
-		tmppost.Comments = &pb.CommentsInfo{}
-		err = s.cses.Query("SELECT Count(*) FROM comments WHERE post_id = ?", tmppost.Id).Scan(&tmppost.Comments.Count)
+	if req.Extended {
+		err = s.fillOwners(ctx, res.Posts, req.Fields)
 		if err != nil {
 			return nil, err
 		}
+	}
 
-		if req.Extended && req.CommentsLimit > 0 {
-
-			commentsres, err := s.GetCommentsList(ctx, &pb.GetCommentsListRequest{PostId: tmppost.Id, Limit: req.CommentsLimit, Extended: req.CommentsExtended, SortDir: req.CommentsSortDir, Fields: req.CommentsFields})
-			if err != nil {
-				return nil, err
-			}
-
-			tmppost.Comments.Items = commentsres.Comments
-		}
+	return res, nil
+}
 
-		res.Posts = append(res.Posts, tmppost)
+func (s service) AddLike(ctx context.Context, req *pb.AddLikeRequest) (*pb.AddLikeResponse, error) {
 
-		att = []*pb.AttachmentId{}
-		tmppost = &pb.Post{}
+	user_id, err := userId(ctx)
+	if err != nil {
+		return nil, err
 	}
 
-	err = iter.Close()
+	err = s.checkPostAccess(ctx, user_id, req.PostId)
 	if err != nil {
 		return nil, err
 	}
 
-	if req.Extended && len(res.Posts) > 0 {
+	var cntlikes int
+	err = s.cses.Query("SELECT Count(*) FROM likes WHERE post_id = ? AND owner_id = ?", req.PostId, user_id).Scan(&cntlikes)
+	if err != nil {
+		return nil, ErrInternal(err)
+	}
+
+	err = s.cses.Query("INSERT INTO likes (post_id, owner_id) VALUES(?, ?)", req.PostId, user_id).Exec()
+	if err != nil {
+		return nil, ErrInternal(err)
+	}
 
-		ids := make([]int64, 0, len(res.Posts))
-		for i := range res.Posts {
-			ids = append(ids, res.Posts[i].OwnerId)
+	if cntlikes == 0 {
+		err = s.addAffinity(user_id, req.PostId, 1)
+		if err != nil {
+			return nil, err
 		}
 
-		usersres, err := s.userscli.GetUsersByIds(ctx, &pb.GetUsersByIdsRequest{Ids: ids, Fields: req.Fields})
+		err = s.countStat(req.PostId, statLikes, 1)
 		if err != nil {
-			if status.Code(err) == codes.Unavailable {
-				return nil, ErrServiceUsersUnvaliable
-			}
 			return nil, err
 		}
 
-		if len(usersres.Users) == len(res.Posts) {
-			for i := range res.Posts {
-				res.Posts[i].Owner = usersres.Users[i]
+		s.publishLikes(ctx, req.PostId)
+
+		if s.federation != nil {
+			post, _, err := s.getPost(req.PostId)
+			if err != nil {
+				return nil, err
 			}
+			s.federation.PostLiked(user_id, post)
 		}
 	}
 
-	return res, nil
+	return &pb.AddLikeResponse{}, nil
 }
 
-func (s service) AddLike(ctx context.Context, req *pb.AddLikeRequest) (*pb.AddLikeResponse, error) {
+func (s service) DeleteLike(ctx context.Context, req *pb.DeleteLikeRequest) (*pb.DeleteLikeResponse, error) {
 
-	user_id, err := strconv.ParseInt(ctx.Value("user").(string), 10, 64)
+	user_id, err := userId(ctx)
 	if err != nil {
-		return nil, ErrInternal(err)
+		return nil, err
 	}
 
-	var cntposts int
-	err = s.cses.Query("SELECT Count(*) FROM posts WHERE bucket = ? AND id = ?", snowflake.ParseID(req.PostId).Timestamp/uint64(s.bucketDuration.Milliseconds()), req.PostId).Scan(&cntposts)
+	var cntlikes int
+	err = s.cses.Query("SELECT Count(*) FROM likes WHERE post_id = ? AND owner_id = ?", req.PostId, user_id).Scan(&cntlikes)
 	if err != nil {
 		return nil, ErrInternal(err)
 	}
-	if cntposts != 1 {
-		return nil, ErrPostNotFound
+	if cntlikes == 0 {
+		return &pb.DeleteLikeResponse{}, nil
 	}
 
-	err = s.cses.Query("INSERT INTO likes (post_id, owner_id) VALUES(?, ?)", req.PostId, user_id).Exec()
+	err = s.cses.Query("DELETE FROM likes WHERE post_id = ? AND owner_id = ?", req.PostId, user_id).Exec()
 	if err != nil {
 		return nil, ErrInternal(err)
 	}
 
-	return &pb.AddLikeResponse{}, nil
-}
-
-func (s service) DeleteLike(ctx context.Context, req *pb.DeleteLikeRequest) (*pb.DeleteLikeResponse, error) {
-
-	user_id, err := strconv.ParseInt(ctx.Value("user").(string), 10, 64)
+	err = s.addAffinity(user_id, req.PostId, -1)
 	if err != nil {
-		return nil, ErrInternal(err)
+		return nil, err
 	}
 
-	err = s.cses.Query("DELETE FROM likes WHERE post_id = ? AND owner_id = ?", req.PostId, user_id).Exec()
+	err = s.countStat(req.PostId, statLikes, -1)
 	if err != nil {
-		return nil, ErrInternal(err)
+		return nil, err
 	}
 
+	s.publishLikes(ctx, req.PostId)
+
 	return &pb.DeleteLikeResponse{}, nil
 }
 
 func (s service) WriteComment(ctx context.Context, req *pb.WriteCommentRequest) (*pb.WriteCommentResponse, error) {
 
-	user_id, err := strconv.ParseInt(ctx.Value("user").(string), 10, 64)
+	user_id, err := userId(ctx)
 	if err != nil {
-		return nil, ErrInternal(err)
+		return nil, err
 	}
 
-	var cntposts int
-	err = s.cses.Query("SELECT Count(*) FROM posts WHERE bucket = ? AND id = ?", snowflake.ParseID(req.PostId).Timestamp/uint64(s.bucketDuration.Milliseconds()), req.PostId).Scan(&cntposts)
+	err = s.checkPostAccess(ctx, user_id, req.PostId)
 	if err != nil {
-		return nil, ErrInternal(err)
+		return nil, err
 	}
-	if cntposts != 1 {
-		return nil, ErrPostNotFound
+
+	var entities []*pb.TextEntity
+	req.Messaage, entities, err = parseMarkup(req.Messaage)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(req.AttachmentsIds) == 0 && req.Messaage == "" {
+		return nil, ErrEmptyContent
+	}
+
+	mentions, err := s.resolveMentions(ctx, req.Messaage)
+	if err != nil {
+		return nil, err
 	}
 
 	id := snowflake.ID()
 	sid := snowflake.ParseID(id)
 	res := &pb.WriteCommentResponse{Comment: &pb.Comment{
-		Id:      id,
-		OwnerId: user_id,
-		Message: req.Messaage,
-		Time:    timestamppb.New(sid.GenerateTime().Local()),
+		Id:       id,
+		PostId:   req.PostId,
+		OwnerId:  user_id,
+		Message:  req.Messaage,
+		Time:     timestamppb.New(sid.GenerateTime().Local()),
+		Mentions: mentions,
+		Entities: entities,
 	}}
 
 	if len(req.AttachmentsIds) != 0 {
@@ -531,22 +573,64 @@ func (s service) WriteComment(ctx context.Context, req *pb.WriteCommentRequest)
 		res.Comment.Attachments = att.Attachments
 	}
 
-	err = s.cses.Query("INSERT INTO comments (id, post_id, owner_id, message, attachments) VAlUES (?, ?, ?, ?, ?)", id, req.PostId, user_id, req.Messaage, req.AttachmentsIds).Exec()
+	err = s.cses.Query("INSERT INTO comments (id, post_id, owner_id, message, attachments, mentions, entities) VAlUES (?, ?, ?, ?, ?, ?, ?)", id, req.PostId, user_id, req.Messaage, req.AttachmentsIds, toMentions(mentions), toTextEntities(entities)).Exec()
 	if err != nil {
 		return nil, ErrInternal(err)
 	}
 
+	err = s.saveMentions(id, req.PostId, user_id, nil, mentions)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.indexDocument(ctx, commentDocument(res.Comment))
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.addAffinity(user_id, req.PostId, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.countStat(req.PostId, statComments, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	s.publish(ctx, postTopic(req.PostId), pubsub.Event{Type: pubsub.CommentCreated, PostId: req.PostId, OwnerId: user_id, CommentId: id})
+
 	return res, nil
 }
 
 func (s service) GetCommentsList(ctx context.Context, req *pb.GetCommentsListRequest) (*pb.GetCommentsListResponse, error) {
 
+	user_id, err := viewerId(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.checkPostAccess(ctx, user_id, req.PostId)
+	if err != nil {
+		return nil, err
+	}
+
+	comments, err := s.getComments(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetCommentsListResponse{Comments: comments}, nil
+}
+
+// getComments loads the comments of a post without checking access to it.
+func (s service) getComments(ctx context.Context, req *pb.GetCommentsListRequest) ([]*pb.Comment, error) {
+
 	if req.Limit < 0 || req.Limit > 100 {
 		return nil, ErrLimitError
 	}
 
-	res := &pb.GetCommentsListResponse{}
-	res.Comments = make([]*pb.Comment, 0, req.Limit)
+	res := make([]*pb.Comment, 0, req.Limit)
 
 	params := make([]any, 0)
 	params = append(params, req.PostId)
@@ -567,13 +651,16 @@ func (s service) GetCommentsList(ctx context.Context, req *pb.GetCommentsListReq
 
 	params = append(params, req.Limit)
 
-	iter := s.cses.Query("SELECT id, post_id, owner_id, message, attachments FROM comments WHERE post_id = ? "+condition+" ORDER BY id "+order_dir+" LIMIT ?", params...).Iter()
+	iter := s.cses.Query("SELECT id, post_id, owner_id, message, attachments, mentions, entities, remote_actor FROM comments WHERE post_id = ? "+condition+" ORDER BY id "+order_dir+" LIMIT ?", params...).Iter()
 
 	att := []*pb.AttachmentId{}
+	mentions := []mention{}
+	entities := []textEntity{}
 	tmpcomment := &pb.Comment{}
-	for iter.Scan(&tmpcomment.Id, &tmpcomment.PostId, &tmpcomment.OwnerId, &tmpcomment.Message, &att) {
+	for iter.Scan(&tmpcomment.Id, &tmpcomment.PostId, &tmpcomment.OwnerId, &tmpcomment.Message, &att, &mentions, &entities, &tmpcomment.RemoteActor) {
 		attach, err := s.storagecli.GetAttachments(ctx, &pb.GetAttachmentsRequest{Ids: att})
 		if err != nil {
+			iter.Close()
 			if status.Code(err) == codes.Unavailable {
 				return nil, ErrServiceStorageUnvaliable
 			}
@@ -584,22 +671,35 @@ func (s service) GetCommentsList(ctx context.Context, req *pb.GetCommentsListReq
 		sid := snowflake.ParseID(tmpcomment.Id)
 		tmpcomment.Time = timestamppb.New(sid.GenerateTime().Local())
 
-		res.Comments = append(res.Comments, tmpcomment)
+		tmpcomment.Mentions = fromMentions(mentions)
+		tmpcomment.Entities = fromTextEntities(entities)
+
+		res = append(res, tmpcomment)
 
 		att = []*pb.AttachmentId{}
+		mentions = []mention{}
+		entities = []textEntity{}
 		tmpcomment = &pb.Comment{}
 	}
 
 	err := iter.Close()
 	if err != nil {
-		return nil, err
+		return nil, ErrInternal(err)
 	}
 
-	if req.Extended && len(res.Comments) > 0 {
+	// remote comments have no owner.
+	local := make([]*pb.Comment, 0, len(res))
+	for _, c := range res {
+		if c.RemoteActor == "" {
+			local = append(local, c)
+		}
+	}
+
+	if req.Extended && len(local) > 0 {
 
-		ids := make([]int64, 0, len(res.Comments))
-		for i := range res.Comments {
-			ids = append(ids, res.Comments[i].OwnerId)
+		ids := make([]int64, 0, len(local))
+		for i := range local {
+			ids = append(ids, local[i].OwnerId)
 		}
 
 		usersres, err := s.userscli.GetUsersByIds(ctx, &pb.GetUsersByIdsRequest{Ids: ids, Fields: req.Fields})
@@ -610,9 +710,9 @@ func (s service) GetCommentsList(ctx context.Context, req *pb.GetCommentsListReq
 			return nil, err
 		}
 
-		if len(usersres.Users) == len(res.Comments) {
-			for i := range res.Comments {
-				res.Comments[i].Owner = usersres.Users[i]
+		if len(usersres.Users) == len(local) {
+			for i := range local {
+				local[i].Owner = usersres.Users[i]
 			}
 		}
 
@@ -621,33 +721,281 @@ func (s service) GetCommentsList(ctx context.Context, req *pb.GetCommentsListReq
 	return res, nil
 }
 
+// getComment loads a comment of a post without checking access to the post.
+func (s service) getComment(ctx context.Context, post_id uint64, id uint64) (*pb.Comment, error) {
+
+	comment := &pb.Comment{}
+	att := []*pb.AttachmentId{}
+	mentions := []mention{}
+	entities := []textEntity{}
+
+	err := s.cses.Query("SELECT id, post_id, owner_id, message, attachments, mentions, entities, remote_actor FROM comments WHERE post_id = ? AND id = ?", post_id, id).Scan(&comment.Id, &comment.PostId, &comment.OwnerId, &comment.Message, &att, &mentions, &entities, &comment.RemoteActor)
+	if err != nil {
+		if err == gocql.ErrNotFound {
+			return nil, ErrCommentNotFound
+		}
+		return nil, ErrInternal(err)
+	}
+
+	attach, err := s.storagecli.GetAttachments(ctx, &pb.GetAttachmentsRequest{Ids: att})
+	if err != nil {
+		if status.Code(err) == codes.Unavailable {
+			return nil, ErrServiceStorageUnvaliable
+		}
+		return nil, err
+	}
+	comment.Attachments = attach.Attachments
+
+	sid := snowflake.ParseID(comment.Id)
+	comment.Time = timestamppb.New(sid.GenerateTime().Local())
+
+	comment.Mentions = fromMentions(mentions)
+	comment.Entities = fromTextEntities(entities)
+
+	return comment, nil
+}
+
+// postColumns are the columns of the posts table scanned by scanPost.
+const postColumns = "id, message, owner_id, attachments, visibility, allowed_ids, audience_id, mentions, entities, previews, has_poll"
+
+// postRow holds the columns of the posts table that are not stored
+// in pb.Post as is.
+type postRow struct {
+	att      []*pb.AttachmentId
+	mentions []mention
+	entities []textEntity
+	previews []linkPreview
+	has_poll bool
+}
+
+// scanPost returns the scan destinations for postColumns.
+func scanPost(post *pb.Post, row *postRow) []any {
+	return []any{&post.Id, &post.Message, &post.OwnerId, &row.att, &post.Visibility, &post.AllowedIds, &post.AudienceId, &row.mentions, &row.entities, &row.previews, &row.has_poll}
+}
+
+// getPost loads a post from the posts table without resolving its attachments.
+func (s store) getPost(id uint64) (*pb.Post, *postRow, error) {
+
+	post := &pb.Post{}
+	row := &postRow{}
+
+	err := s.cses.Query("SELECT "+postColumns+" FROM posts WHERE bucket = ? AND id = ?", s.bucket(id), id).Scan(scanPost(post, row)...)
+	if err != nil {
+		if err == gocql.ErrNotFound {
+			return nil, nil, ErrPostNotFound
+		}
+		return nil, nil, ErrInternal(err)
+	}
+
+	return post, row, nil
+}
+
+// fillPost resolves attachments, likes and comments of a post read from
+// the posts table. comments is nil if comments should not be returned.
+func (s service) fillPost(ctx context.Context, user_id int64, post *pb.Post, row *postRow, comments *pb.GetCommentsListRequest) error {
+
+	attach, err := s.storagecli.GetAttachments(ctx, &pb.GetAttachmentsRequest{Ids: row.att})
+	if err != nil {
+		if status.Code(err) == codes.Unavailable {
+			return ErrServiceStorageUnvaliable
+		}
+		return err
+	}
+	post.Attachments = attach.Attachments
+
+	sid := snowflake.ParseID(post.Id)
+	post.Time = timestamppb.New(sid.GenerateTime().Local())
+
+	post.Tags = parseTags(post.Message)
+	post.Mentions = fromMentions(row.mentions)
+	post.Entities = fromTextEntities(row.entities)
+	post.Previews = fromLinkPreviews(row.previews)
+
+	// a missing poll must not fail the lists with the post.
+	if row.has_poll {
+		post.Poll, err = s.getPoll(user_id, post.Id)
+		if err == ErrPollNotFound {
+			post.Poll = nil
+		} else if err != nil {
+			return err
+		}
+	}
+
+	if post.OwnerId != user_id {
+		post.AllowedIds = nil
+		post.AudienceId = 0
+	} else {
+		post.Views, err = s.getViews(post.Id)
+		if err != nil {
+			return err
+		}
+	}
+
+	post.Likes = &pb.LikesInfo{}
+	err = s.cses.Query("SELECT Count(*) FROM likes WHERE post_id = ?", post.Id).Scan(&post.Likes.Count)
+	if err != nil {
+		return ErrInternal(err)
+	}
+
+	// guests get no liked flag.
+	if user_id != 0 {
+		var cntlikes int64
+		post.Likes.Liked = new(bool)
+		err = s.cses.Query("SELECT Count(*) FROM likes WHERE post_id = ? AND owner_id = ?", post.Id, user_id).Scan(&cntlikes)
+		if err != nil {
+			return ErrInternal(err)
+		}
+		if cntlikes > 0 {
+			*post.Likes.Liked = true
+		}
+	}
+
+	post.Comments = &pb.CommentsInfo{}
+	err = s.cses.Query("SELECT Count(*) FROM comments WHERE post_id = ?", post.Id).Scan(&post.Comments.Count)
+	if err != nil {
+		return ErrInternal(err)
+	}
+
+	if comments != nil {
+		post.Comments.Items, err = s.getComments(ctx, &pb.GetCommentsListRequest{
+			PostId:   post.Id,
+			Limit:    comments.Limit,
+			Extended: comments.Extended,
+			SortDir:  comments.SortDir,
+			Fields:   comments.Fields,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// fillOwners sets the owners of the posts.
+func (s service) fillOwners(ctx context.Context, posts []*pb.Post, fields []pb.UserFields) error {
+
+	if len(posts) == 0 {
+		return nil
+	}
+
+	ids := make([]int64, 0, len(posts))
+	for i := range posts {
+		ids = append(ids, posts[i].OwnerId)
+	}
+
+	usersres, err := s.userscli.GetUsersByIds(ctx, &pb.GetUsersByIdsRequest{Ids: ids, Fields: fields})
+	if err != nil {
+		if status.Code(err) == codes.Unavailable {
+			return ErrServiceUsersUnvaliable
+		}
+		return err
+	}
+
+	if len(usersres.Users) == len(posts) {
+		for i := range posts {
+			posts[i].Owner = usersres.Users[i]
+		}
+	}
+
+	return nil
+}
+
+// bucket returns the posts table partition of the snowflake id.
+func (s store) bucket(id uint64) uint64 {
+	return snowflake.ParseID(id).Timestamp / uint64(s.bucketDuration.Milliseconds())
+}
+
 func (s service) UpdatePost(ctx context.Context, req *pb.UpdatePostRequest) (*pb.UpdatePostResponse, error) {
 
-	/*user_id, err := getAuthUser(ctx)
+	user_id, err := userId(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	res := &pb.UpdatePostResponse{Post: &pb.Post{
-		OwnerId: user_id,
-	}}
+	post, row, err := s.getPost(req.PostId)
+	if err != nil {
+		return nil, err
+	}
+	if post.OwnerId != user_id {
+		return nil, ErrPostNotFound
+	}
 
-	if req.Attachments.Update {
-		att, err := s.storagecli.GetAttachments(ctx, &pb.GetAttachmentsRequest{Id: req.Attachments.Value})
+	old := post.Message
+	if req.Message != nil {
+		var entities []*pb.TextEntity
+		post.Message, entities, err = parseMarkup(*req.Message)
 		if err != nil {
-			if status.Code(err) == codes.Unavailable {
-				return nil, ErrServiceStorageUnvaliable
-			} else if status.Code(err) == codes.Internal {
-				return nil, err
+			return nil, err
+		}
+		row.entities = toTextEntities(entities)
+	}
+
+	if req.Attachments != nil {
+		row.att = req.Attachments.Ids
+		if len(row.att) != 0 {
+			_, err := s.storagecli.GetAttachments(ctx, &pb.GetAttachmentsRequest{Ids: row.att})
+			if err != nil {
+				if status.Code(err) == codes.Unavailable {
+					return nil, ErrServiceStorageUnvaliable
+				} else if status.Code(err) == codes.Internal {
+					return nil, err
+				}
+				return nil, ErrInvalidAttachments
 			}
-			return nil, ErrInvalidAttachments
 		}
-		res.Post.Attachments = att.Attachments
 	}
 
-	if req.Message.Update {
+	if len(row.att) == 0 && post.Message == "" && !row.has_poll {
+		return nil, ErrEmptyContent
+	}
+
+	oldmentions := fromMentions(row.mentions)
+	if post.Message != old {
+		mentions, err := s.resolveMentions(ctx, post.Message)
+		if err != nil {
+			return nil, err
+		}
+		row.mentions = toMentions(mentions)
+		row.previews = nil
+	}
+
+	err = s.cses.Query("UPDATE posts SET message = ?, attachments = ?, mentions = ?, entities = ?, previews = ? WHERE bucket = ? AND id = ?", post.Message, row.att, row.mentions, row.entities, row.previews, s.bucket(post.Id), post.Id).Exec()
+	if err != nil {
+		return nil, ErrInternal(err)
+	}
+
+	err = s.saveTags(post.Id, user_id, old, post.Message)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.saveMentions(post.Id, post.Id, user_id, oldmentions, fromMentions(row.mentions))
+	if err != nil {
+		return nil, err
+	}
 
-	}*/
+	if post.Message != old {
+		err = s.deleteDocument(ctx, &search.Document{Id: post.Id, PostId: post.Id, OwnerId: user_id, Text: old, Tags: uniqueTags(old)})
+		if err != nil {
+			return nil, err
+		}
+		err = s.indexDocument(ctx, postDocument(post))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = s.fillPost(ctx, user_id, post, row, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if post.Message != old {
+		if urls := extractUrls(post.Message, post.Entities); len(urls) != 0 {
+			go s.unfurlPost(post.Id, post.Message, urls)
+		}
+	}
 
-	return nil, nil
+	return &pb.UpdatePostResponse{Post: post}, nil
 }
@@ -0,0 +1,325 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/NexusIT-Dev/nexusmicro_publications/hll"
+	"github.com/NexusIT-Dev/nexusmicro_publications/pb"
+	"github.com/gocql/gocql"
+	"github.com/godruoyi/go-snowflake"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	maxHourlyStatsPoints = 24 * 31
+	maxDailyStatsPoints  = 366
+	// maxStatsPartitions is the number of posts read with one IN query.
+	maxStatsPartitions = 100
+)
+
+// post_stats counters.
+const (
+	statViews    = "views"
+	statLikes    = "likes"
+	statComments = "comments"
+)
+
+// addViews counts the impressions of the posts by the user. Views of
+// posts that do not exist or are hidden from the user and views of own
+// posts are skipped. Unique viewers are counted with a HyperLogLog
+// stored in post_viewers, guests are not counted as viewers.
+func (s service) addViews(ctx context.Context, user_id int64, ids []uint64) error {
+
+	v := s.newViewer(user_id)
+	hour := time.Now().UTC().Truncate(time.Hour)
+	index, rank := hll.Register(hll.Hash(uint64(user_id)))
+
+	counters := s.cses.NewBatch(gocql.CounterBatch)
+	viewers := s.cses.NewBatch(gocql.UnloggedBatch)
+
+	for _, id := range ids {
+
+		post := &pb.Post{}
+		err := s.cses.Query("SELECT owner_id, visibility, allowed_ids, audience_id FROM posts WHERE bucket = ? AND id = ?", s.bucket(id), id).Scan(&post.OwnerId, &post.Visibility, &post.AllowedIds, &post.AudienceId)
+		if err != nil {
+			if err == gocql.ErrNotFound {
+				continue
+			}
+			return ErrInternal(err)
+		}
+
+		if post.OwnerId == user_id {
+			continue
+		}
+
+		ok, err := v.canView(ctx, post)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			continue
+		}
+
+		counters.Query("UPDATE post_views SET views = views + 1 WHERE post_id = ?", id)
+		counters.Query("UPDATE post_stats SET views = views + 1 WHERE post_id = ? AND hour = ?", id, hour)
+		if user_id != 0 {
+			viewers.Query("INSERT INTO post_viewers (post_id, register, rank) VALUES (?, ?, ?)", id, index, rank)
+		}
+	}
+
+	if counters.Size() == 0 {
+		return nil
+	}
+
+	err := s.cses.ExecuteBatch(counters)
+	if err != nil {
+		return ErrInternal(err)
+	}
+
+	if viewers.Size() != 0 {
+		err = s.cses.ExecuteBatch(viewers)
+		if err != nil {
+			return ErrInternal(err)
+		}
+	}
+
+	return nil
+}
+
+// countStat adds delta to a counter of the post for the current hour.
+func (s store) countStat(post_id uint64, stat string, delta int64) error {
+
+	hour := time.Now().UTC().Truncate(time.Hour)
+
+	err := s.cses.Query("UPDATE post_stats SET "+stat+" = "+stat+" + ? WHERE post_id = ? AND hour = ?", delta, post_id, hour).Exec()
+	if err != nil {
+		return ErrInternal(err)
+	}
+
+	return nil
+}
+
+// nonNegative clamps a counter read from post_stats. An unlike of a like
+// made before the counters were backfilled makes a sum negative.
+func nonNegative(v int64) int64 {
+	if v < 0 {
+		return 0
+	}
+	return v
+}
+
+// postCounters are the post_stats counters of a post summed over all hours.
+type postCounters struct {
+	views    int64
+	likes    int64
+	comments int64
+}
+
+// postCounts returns the summed post_stats counters of the posts, posts
+// without counters are missing from the result.
+func (s store) postCounts(ids []uint64) (map[uint64]postCounters, error) {
+
+	res := make(map[uint64]postCounters, len(ids))
+
+	for start := 0; start < len(ids); start += maxStatsPartitions {
+		end := start + maxStatsPartitions
+		if end > len(ids) {
+			end = len(ids)
+		}
+
+		var id uint64
+		var c postCounters
+		iter := s.cses.Query("SELECT post_id, SUM(views), SUM(likes), SUM(comments) FROM post_stats WHERE post_id IN ? GROUP BY post_id", ids[start:end]).Iter()
+		for iter.Scan(&id, &c.views, &c.likes, &c.comments) {
+			res[id] = postCounters{views: nonNegative(c.views), likes: nonNegative(c.likes), comments: nonNegative(c.comments)}
+		}
+
+		err := iter.Close()
+		if err != nil {
+			return nil, ErrInternal(err)
+		}
+	}
+
+	return res, nil
+}
+
+// engagementCounts returns the summed post_stats counters of the posts.
+// Until StatsBackfill is done the counters miss the older likes and
+// comments, so these are counted from their rows instead.
+func (s store) engagementCounts(ctx context.Context, ids []uint64) (map[uint64]postCounters, error) {
+
+	res, err := s.postCounts(ids)
+	if err != nil {
+		return nil, err
+	}
+
+	backfilled, err := s.statsBackfilled(ctx)
+	if err != nil {
+		return nil, ErrInternal(err)
+	}
+	if backfilled {
+		return res, nil
+	}
+
+	for _, id := range ids {
+		c := res[id]
+
+		err = s.cses.Query("SELECT Count(*) FROM likes WHERE post_id = ?", id).WithContext(ctx).Scan(&c.likes)
+		if err != nil {
+			return nil, ErrInternal(err)
+		}
+
+		err = s.cses.Query("SELECT Count(*) FROM comments WHERE post_id = ?", id).WithContext(ctx).Scan(&c.comments)
+		if err != nil {
+			return nil, ErrInternal(err)
+		}
+
+		res[id] = c
+	}
+
+	return res, nil
+}
+
+// getViews returns the impressions and the estimated unique viewers of the post.
+func (s service) getViews(post_id uint64) (*pb.ViewsInfo, error) {
+
+	res := &pb.ViewsInfo{}
+
+	err := s.cses.Query("SELECT views FROM post_views WHERE post_id = ?", post_id).Scan(&res.Impressions)
+	if err != nil {
+		if err == gocql.ErrNotFound {
+			return res, nil
+		}
+		return nil, ErrInternal(err)
+	}
+
+	var sketch hll.Sketch
+	var index int
+	var rank int
+
+	iter := s.cses.Query("SELECT register, MAX(rank) FROM post_viewers WHERE post_id = ? GROUP BY register", post_id).Iter()
+	for iter.Scan(&index, &rank) {
+		if index >= 0 && index < hll.Registers {
+			sketch.Add(index, uint8(rank))
+		}
+	}
+
+	err = iter.Close()
+	if err != nil {
+		return nil, ErrInternal(err)
+	}
+
+	res.UniqueViewers = int64(sketch.Estimate())
+
+	return res, nil
+}
+
+// deleteStats deletes the views and the statistics of the post.
+func (s service) deleteStats(post_id uint64) error {
+
+	for _, table := range []string{"post_views", "post_viewers", "post_stats"} {
+		err := s.cses.Query("DELETE FROM "+table+" WHERE post_id = ?", post_id).Exec()
+		if err != nil {
+			return ErrInternal(err)
+		}
+	}
+
+	return nil
+}
+
+// truncateStatsTime returns the start of the hour or the day of t in UTC.
+func truncateStatsTime(t time.Time, granularity pb.StatsGranularity) time.Time {
+	t = t.UTC()
+	if granularity == pb.StatsGranularity_day {
+		return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	}
+	return t.Truncate(time.Hour)
+}
+
+func (s service) GetPostStats(ctx context.Context, req *pb.GetPostStatsRequest) (*pb.GetPostStatsResponse, error) {
+
+	user_id, err := userId(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var owner_id int64
+	err = s.cses.Query("SELECT owner_id FROM posts WHERE bucket = ? AND id = ?", s.bucket(req.PostId), req.PostId).Scan(&owner_id)
+	if err != nil {
+		if err == gocql.ErrNotFound {
+			return nil, ErrPostNotFound
+		}
+		return nil, ErrInternal(err)
+	}
+	if owner_id != user_id {
+		return nil, ErrPostNotFound
+	}
+
+	step := time.Hour
+	max_points := maxHourlyStatsPoints
+	switch req.Granularity {
+	case pb.StatsGranularity_hour:
+	case pb.StatsGranularity_day:
+		step = time.Hour * 24
+		max_points = maxDailyStatsPoints
+	default:
+		return nil, ErrInvalidStatsRange
+	}
+
+	sid := snowflake.ParseID(req.PostId)
+	from := sid.GenerateTime()
+	if req.From != nil {
+		from = req.From.AsTime()
+	}
+	to := time.Now()
+	if req.To != nil {
+		to = req.To.AsTime()
+	}
+
+	from = truncateStatsTime(from, req.Granularity)
+	if !to.After(from) || to.Sub(from) > step*time.Duration(max_points) {
+		return nil, ErrInvalidStatsRange
+	}
+
+	res := &pb.GetPostStatsResponse{}
+
+	points := make(map[time.Time]*pb.StatsPoint)
+	for t := from; t.Before(to); t = t.Add(step) {
+		point := &pb.StatsPoint{Time: timestamppb.New(t)}
+		points[t] = point
+		res.Points = append(res.Points, point)
+	}
+
+	var hour time.Time
+	var views, likes, comments int64
+
+	iter := s.cses.Query("SELECT hour, views, likes, comments FROM post_stats WHERE post_id = ? AND hour >= ? AND hour < ?", req.PostId, from, to).Iter()
+	for iter.Scan(&hour, &views, &likes, &comments) {
+		point, ok := points[truncateStatsTime(hour, req.Granularity)]
+		if !ok {
+			continue
+		}
+		point.Views += views
+		point.Likes += likes
+		point.Comments += comments
+	}
+
+	err = iter.Close()
+	if err != nil {
+		return nil, ErrInternal(err)
+	}
+
+	// a point holds the likes made minus the likes removed in it.
+	for _, point := range res.Points {
+		point.Likes = nonNegative(point.Likes)
+		point.Comments = nonNegative(point.Comments)
+	}
+
+	res.Views, err = s.getViews(req.PostId)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
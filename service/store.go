@@ -0,0 +1,20 @@
+package service
+
+import (
+	"time"
+
+	"github.com/NexusIT-Dev/nexusmicro_publications/pubsub"
+	"github.com/NexusIT-Dev/nexusmicro_publications/search"
+	"github.com/go-kit/log"
+	"github.com/gocql/gocql"
+)
+
+// store is the storage of posts shared by the service and the jobs that
+// run without the clients of other services, like Trending and Replies.
+type store struct {
+	cses           *gocql.Session
+	bucketDuration time.Duration
+	index          search.Index
+	broker         pubsub.Broker
+	logger         log.Logger
+}
@@ -0,0 +1,328 @@
+package service
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/NexusIT-Dev/nexusmicro_publications/pb"
+	"github.com/gocql/gocql"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const maxTagLength = 64
+
+func isTagRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_'
+}
+
+// normalizeTag strips the leading # and lowercases the tag. It returns
+// false if the tag is empty, too long, purely numeric or contains
+// characters other than letters, digits and underscores.
+func normalizeTag(tag string) (string, bool) {
+
+	tag = strings.ToLower(strings.TrimPrefix(tag, "#"))
+
+	length := 0
+	digits := 0
+	for _, r := range tag {
+		if !isTagRune(r) {
+			return "", false
+		}
+		if unicode.IsDigit(r) {
+			digits++
+		}
+		length++
+	}
+
+	if length == 0 || length > maxTagLength || digits == length {
+		return "", false
+	}
+
+	return tag, true
+}
+
+// parseTags finds the hashtags in the message. A hashtag starts with #
+// at the beginning of the message or after a character that can not be
+// a part of a word or url, so "a#b" and "site.com/#anchor" are skipped.
+func parseTags(message string) []*pb.Hashtag {
+
+	res := make([]*pb.Hashtag, 0)
+
+	runes := []rune(message)
+	for i := 0; i < len(runes); i++ {
+		if runes[i] != '#' {
+			continue
+		}
+		if i > 0 && (isTagRune(runes[i-1]) || strings.ContainsRune("/&#", runes[i-1])) {
+			continue
+		}
+
+		j := i + 1
+		for j < len(runes) && isTagRune(runes[j]) {
+			j++
+		}
+
+		if tag, ok := normalizeTag(string(runes[i+1 : j])); ok {
+			res = append(res, &pb.Hashtag{Tag: tag, Offset: int32(i), Length: int32(j - i)})
+		}
+
+		i = j - 1
+	}
+
+	return res
+}
+
+// uniqueTags returns the distinct tags of the message.
+func uniqueTags(message string) []string {
+
+	res := make([]string, 0)
+	seen := make(map[string]bool)
+
+	for _, t := range parseTags(message) {
+		if !seen[t.Tag] {
+			seen[t.Tag] = true
+			res = append(res, t.Tag)
+		}
+	}
+
+	return res
+}
+
+// saveTags updates posts_by_tag after the message of a post has changed
+// from old to message.
+func (s service) saveTags(post_id uint64, owner_id int64, old string, message string) error {
+
+	oldtags := make(map[string]bool)
+	for _, t := range uniqueTags(old) {
+		oldtags[t] = true
+	}
+
+	newtags := make(map[string]bool)
+	for _, t := range uniqueTags(message) {
+		newtags[t] = true
+	}
+
+	batch := s.cses.NewBatch(gocql.LoggedBatch)
+	for t := range oldtags {
+		if !newtags[t] {
+			batch.Query("DELETE FROM posts_by_tag WHERE tag = ? AND id = ?", t, post_id)
+		}
+	}
+	for t := range newtags {
+		if !oldtags[t] {
+			batch.Query("INSERT INTO posts_by_tag (tag, id, owner_id) VALUES (?, ?, ?)", t, post_id, owner_id)
+		}
+	}
+
+	if batch.Size() == 0 {
+		return nil
+	}
+
+	err := s.cses.ExecuteBatch(batch)
+	if err != nil {
+		return ErrInternal(err)
+	}
+
+	return nil
+}
+
+// tagPostIds returns up to limit ids of posts with any of the tags that are
+// older than last_id, newest first.
+func (s service) tagPostIds(tags []string, last_id uint64, limit int64) ([]uint64, error) {
+
+	res := make([]uint64, 0)
+	seen := make(map[uint64]bool)
+
+	for _, tag := range tags {
+
+		params := make([]any, 0)
+		params = append(params, tag)
+
+		condition := ""
+
+		if last_id > 0 {
+			condition += "AND id < ?"
+			params = append(params, last_id)
+		}
+
+		params = append(params, limit)
+
+		var id uint64
+		iter := s.cses.Query("SELECT id FROM posts_by_tag WHERE tag = ? "+condition+" LIMIT ?", params...).Iter()
+		for iter.Scan(&id) {
+			if !seen[id] {
+				seen[id] = true
+				res = append(res, id)
+			}
+		}
+
+		err := iter.Close()
+		if err != nil {
+			return nil, ErrInternal(err)
+		}
+	}
+
+	sort.Slice(res, func(i, j int) bool { return res[i] > res[j] })
+
+	if int64(len(res)) > limit {
+		res = res[:limit]
+	}
+
+	return res, nil
+}
+
+// getTaggedPosts pages through posts_by_tag until limit visible posts are
+// collected or the tags are exhausted. If hide_seen is set, the posts the
+// user has marked as seen are skipped.
+func (s service) getTaggedPosts(ctx context.Context, user_id int64, tags []string, last_id uint64, limit int64, hide_seen bool, comments *pb.GetCommentsListRequest) ([]*pb.Post, error) {
+
+	res := make([]*pb.Post, 0, limit)
+
+	v := s.newViewer(user_id)
+
+	for int64(len(res)) < limit {
+
+		requested := limit - int64(len(res))
+
+		ids, err := s.tagPostIds(tags, last_id, requested)
+		if err != nil {
+			return nil, err
+		}
+
+		var seen map[uint64]bool
+		if hide_seen && len(ids) != 0 {
+			seen, err = s.seenPosts(user_id, ids)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		for _, id := range ids {
+			last_id = id
+
+			if seen[id] {
+				continue
+			}
+
+			post, row, err := s.getPost(id)
+			if err != nil {
+				if err == ErrPostNotFound {
+					continue
+				}
+				return nil, err
+			}
+
+			ok, err := v.canView(ctx, post)
+			if err != nil {
+				return nil, err
+			}
+			if !ok {
+				continue
+			}
+
+			err = s.fillPost(ctx, user_id, post, row, comments)
+			if err != nil {
+				return nil, err
+			}
+
+			res = append(res, post)
+		}
+
+		if int64(len(ids)) < requested {
+			break
+		}
+	}
+
+	return res, nil
+}
+
+func (s service) GetPostsByTag(ctx context.Context, req *pb.GetPostsByTagRequest) (*pb.GetPostsByTagResponse, error) {
+
+	user_id, err := userId(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Limit < 0 || req.Limit > 100 {
+		return nil, ErrLimitError
+	}
+
+	tag, ok := normalizeTag(req.Tag)
+	if !ok {
+		return nil, ErrInvalidTag
+	}
+
+	var comments *pb.GetCommentsListRequest
+	if req.Extended && req.CommentsLimit > 0 {
+		comments = &pb.GetCommentsListRequest{Limit: req.CommentsLimit, Extended: req.CommentsExtended, SortDir: req.CommentsSortDir, Fields: req.CommentsFields}
+	}
+
+	res := &pb.GetPostsByTagResponse{}
+	res.Posts, err = s.getTaggedPosts(ctx, user_id, []string{tag}, req.LastId, req.Limit, false, comments)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Extended {
+		err = s.fillOwners(ctx, res.Posts, req.Fields)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return res, nil
+}
+
+func (s service) GetTagsFeed(ctx context.Context, req *pb.GetTagsFeedRequest) (*pb.GetTagsFeedResponse, error) {
+
+	user_id, err := userId(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Limit < 0 || req.Limit > 100 {
+		return nil, ErrLimitError
+	}
+
+	userres, err := s.userscli.GetAuthUser(ctx, &pb.GetAuthUserRequest{Fields: []pb.UserFields{pb.UserFields_tags}})
+	if err != nil {
+		if status.Code(err) == codes.Unavailable {
+			return nil, ErrServiceUsersUnvaliable
+		}
+		return nil, err
+	}
+
+	tags := make([]string, 0)
+	for _, t := range userres.GetUser().GetTags() {
+		if tag, ok := normalizeTag(t); ok {
+			tags = append(tags, tag)
+		}
+	}
+
+	res := &pb.GetTagsFeedResponse{Posts: []*pb.Post{}}
+	if len(tags) == 0 {
+		return res, nil
+	}
+
+	var comments *pb.GetCommentsListRequest
+	if req.Extended && req.CommentsLimit > 0 {
+		comments = &pb.GetCommentsListRequest{Limit: req.CommentsLimit, Extended: req.CommentsExtended, SortDir: req.CommentsSortDir, Fields: req.CommentsFields}
+	}
+
+	res.Posts, err = s.getTaggedPosts(ctx, user_id, tags, req.LastId, req.Limit, req.HideSeen, comments)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Extended {
+		err = s.fillOwners(ctx, res.Posts, req.Fields)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return res, nil
+}
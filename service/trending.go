@@ -0,0 +1,276 @@
+package service
+
+import (
+	"context"
+	"math"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/NexusIT-Dev/nexusmicro_publications/pb"
+	"github.com/go-kit/log"
+	"github.com/go-kit/log/level"
+	"github.com/gocql/gocql"
+	"github.com/godruoyi/go-snowflake"
+)
+
+// TrendingConfig configures the ranking of trending posts. The service
+// has no reposts yet, so the score is built from likes and comments.
+type TrendingConfig struct {
+	// Window is the maximum age of a ranked post.
+	Window time.Duration
+	// Interval is how often the ranking is recomputed.
+	Interval time.Duration
+	// HalfLife is the age at which the score of a post halves.
+	HalfLife      time.Duration
+	LikeWeight    float64
+	CommentWeight float64
+	// Size is the number of posts kept in the ranking.
+	Size int
+}
+
+const trendingLease = "trending"
+
+// Trending periodically recomputes the ranking of trending posts into
+// the trending_posts table. Every run writes a new version of the
+// ranking and then switches trending_state to it, so readers never see
+// a half written ranking. Old versions expire after a few intervals,
+// which also lets clients finish paging through them.
+//
+// Every replica runs Trending, but only the holder of the trending lease
+// recomputes the ranking. The holder renews the lease on every run, it
+// expires after two intervals.
+type Trending struct {
+	s     store
+	cfg   TrendingConfig
+	owner string
+}
+
+func NewTrending(cses *gocql.Session, bucketDuration time.Duration, cfg TrendingConfig, logger log.Logger) *Trending {
+	return &Trending{
+		s: store{
+			cses:           cses,
+			bucketDuration: bucketDuration,
+			logger:         logger,
+		},
+		cfg:   cfg,
+		owner: strconv.FormatUint(snowflake.ID(), 10),
+	}
+}
+
+// Run recomputes the ranking every interval until the context is done.
+func (t *Trending) Run(ctx context.Context) {
+
+	ticker := time.NewTicker(t.cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		ok, err := t.s.lease(ctx, trendingLease, t.owner, 2*t.cfg.Interval)
+		if err != nil {
+			level.Error(t.s.logger).Log("msg", "failed to take trending lease", "err", err)
+		}
+
+		if ok {
+			err = t.recompute(ctx)
+			if err != nil {
+				level.Error(t.s.logger).Log("msg", "failed to recompute trending posts", "err", err)
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+type trendingPost struct {
+	id    uint64
+	score float64
+}
+
+// trendingScore is the engagement of the post decayed exponentially by its age.
+func trendingScore(cfg TrendingConfig, likes int64, comments int64, age time.Duration) float64 {
+	engagement := cfg.LikeWeight*float64(likes) + cfg.CommentWeight*float64(comments)
+	return engagement * math.Exp2(-age.Seconds()/cfg.HalfLife.Seconds())
+}
+
+func (t *Trending) recompute(ctx context.Context) error {
+
+	now := time.Now()
+	from := idFromTime(now.Add(-t.cfg.Window))
+
+	first := t.s.bucket(from)
+	bucket := t.s.bucket(snowflake.ID())
+
+	// only public posts are ranked, the ranking is the same for everyone.
+	ids := make([]uint64, 0)
+	for {
+		var id uint64
+		var visibility pb.Visibility
+		iter := t.s.cses.Query("SELECT id, visibility FROM posts WHERE bucket = ? AND id >= ?", bucket, from).WithContext(ctx).Iter()
+		for iter.Scan(&id, &visibility) {
+			if visibility == pb.Visibility_public {
+				ids = append(ids, id)
+			}
+		}
+
+		err := iter.Close()
+		if err != nil {
+			return err
+		}
+
+		if bucket <= first {
+			break
+		}
+		bucket--
+	}
+
+	counts, err := t.s.engagementCounts(ctx, ids)
+	if err != nil {
+		return err
+	}
+
+	posts := make([]trendingPost, 0, len(ids))
+	for _, id := range ids {
+
+		c := counts[id]
+
+		sid := snowflake.ParseID(id)
+		score := trendingScore(t.cfg, c.likes, c.comments, now.Sub(sid.GenerateTime()))
+		if score > 0 {
+			posts = append(posts, trendingPost{id: id, score: score})
+		}
+	}
+
+	sort.Slice(posts, func(i, j int) bool {
+		if posts[i].score != posts[j].score {
+			return posts[i].score > posts[j].score
+		}
+		return posts[i].id > posts[j].id
+	})
+
+	if len(posts) > t.cfg.Size {
+		posts = posts[:t.cfg.Size]
+	}
+
+	version := snowflake.ID()
+	ttl := int64((3 * t.cfg.Interval).Seconds())
+
+	// the rows of a version share a partition, so the batches are cheap.
+	for start := 0; start < len(posts); start += 100 {
+		batch := t.s.cses.NewBatch(gocql.UnloggedBatch).WithContext(ctx)
+		for rank := start; rank < len(posts) && rank < start+100; rank++ {
+			batch.Query("INSERT INTO trending_posts (version, rank, post_id, score) VALUES (?, ?, ?, ?) USING TTL ?", version, rank, posts[rank].id, posts[rank].score, ttl)
+		}
+
+		err := t.s.cses.ExecuteBatch(batch)
+		if err != nil {
+			return err
+		}
+	}
+
+	return t.s.cses.Query("INSERT INTO trending_state (id, version) VALUES (0, ?)", version).WithContext(ctx).Exec()
+}
+
+func (s service) GetTrendingPosts(ctx context.Context, req *pb.GetTrendingPostsRequest) (*pb.GetTrendingPostsResponse, error) {
+
+	user_id, err := userId(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Limit < 0 || req.Limit > 100 {
+		return nil, ErrLimitError
+	}
+
+	if req.Offset < 0 {
+		return nil, ErrInvalidOffset
+	}
+
+	res := &pb.GetTrendingPostsResponse{}
+	res.Posts = make([]*pb.Post, 0, req.Limit)
+
+	version := req.Version
+	if version == 0 {
+		err = s.cses.Query("SELECT version FROM trending_state WHERE id = 0").Scan(&version)
+		if err != nil {
+			if err == gocql.ErrNotFound {
+				return res, nil
+			}
+			return nil, ErrInternal(err)
+		}
+	}
+	res.Version = version
+
+	var comments *pb.GetCommentsListRequest
+	if req.Extended && req.CommentsLimit > 0 {
+		comments = &pb.GetCommentsListRequest{Limit: req.CommentsLimit, Extended: req.CommentsExtended, SortDir: req.CommentsSortDir, Fields: req.CommentsFields}
+	}
+
+	v := s.newViewer(user_id)
+
+	offset := req.Offset
+
+	for len(res.Posts) < int(req.Limit) {
+
+		limit := req.Limit - int64(len(res.Posts))
+
+		ids := make([]uint64, 0, limit)
+
+		var id uint64
+		iter := s.cses.Query("SELECT post_id FROM trending_posts WHERE version = ? AND rank >= ? LIMIT ?", version, offset, limit).Iter()
+		for iter.Scan(&id) {
+			ids = append(ids, id)
+		}
+
+		err = iter.Close()
+		if err != nil {
+			return nil, ErrInternal(err)
+		}
+
+		for _, id := range ids {
+			offset++
+
+			post, row, err := s.getPost(id)
+			if err != nil {
+				if err == ErrPostNotFound {
+					continue
+				}
+				return nil, err
+			}
+
+			ok, err := v.canView(ctx, post)
+			if err != nil {
+				return nil, err
+			}
+			if !ok {
+				continue
+			}
+
+			err = s.fillPost(ctx, user_id, post, row, comments)
+			if err != nil {
+				return nil, err
+			}
+
+			res.Posts = append(res.Posts, post)
+		}
+
+		if int64(len(ids)) < limit {
+			offset = 0
+			break
+		}
+	}
+
+	res.NextOffset = offset
+
+	if req.Extended {
+		err = s.fillOwners(ctx, res.Posts, req.Fields)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return res, nil
+}
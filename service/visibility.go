@@ -0,0 +1,139 @@
+package service
+
+import (
+	"context"
+
+	"github.com/NexusIT-Dev/nexusmicro_publications/pb"
+	"github.com/gocql/gocql"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// viewer decides which posts the current user is allowed to see.
+// Subscriptions are cached, so a list of posts costs at most one
+// users service call per owner, and audience memberships are loaded
+// with a single query on the first post addressed to an audience.
+type viewer struct {
+	s          service
+	user_id    int64
+	subscribed map[int64]bool
+	audiences  map[uint64]bool
+}
+
+func (s service) newViewer(user_id int64) *viewer {
+	return &viewer{
+		s:          s,
+		user_id:    user_id,
+		subscribed: make(map[int64]bool),
+	}
+}
+
+func (v *viewer) canView(ctx context.Context, post *pb.Post) (bool, error) {
+
+	// guests only see public posts.
+	if v.user_id == 0 {
+		return post.Visibility == pb.Visibility_public, nil
+	}
+
+	if post.OwnerId == v.user_id {
+		return true, nil
+	}
+
+	switch post.Visibility {
+	case pb.Visibility_public:
+		return true, nil
+	case pb.Visibility_subscribers:
+		return v.isSubscribed(ctx, post.OwnerId)
+	case pb.Visibility_custom:
+		for _, id := range post.AllowedIds {
+			if id == v.user_id {
+				return true, nil
+			}
+		}
+		return false, nil
+	case pb.Visibility_audience:
+		return v.inAudience(post.AudienceId)
+	default:
+		return false, nil
+	}
+}
+
+func (v *viewer) isSubscribed(ctx context.Context, owner_id int64) (bool, error) {
+
+	if subscribed, ok := v.subscribed[owner_id]; ok {
+		return subscribed, nil
+	}
+
+	userres, err := v.s.userscli.GetUserById(ctx, &pb.GetUserByIdRequest{Id: owner_id, Fields: []pb.UserFields{pb.UserFields_subscribed}})
+	if err != nil {
+		if status.Code(err) == codes.Unavailable {
+			return false, ErrServiceUsersUnvaliable
+		}
+		return false, err
+	}
+
+	subscribed := userres.User != nil && userres.User.GetSubscribed()
+	v.subscribed[owner_id] = subscribed
+
+	return subscribed, nil
+}
+
+func (v *viewer) inAudience(audience_id uint64) (bool, error) {
+
+	if v.audiences == nil {
+		audiences := make(map[uint64]bool)
+
+		var id uint64
+		iter := v.s.cses.Query("SELECT audience_id FROM audiences_by_member WHERE user_id = ?", v.user_id).Iter()
+		for iter.Scan(&id) {
+			audiences[id] = true
+		}
+
+		err := iter.Close()
+		if err != nil {
+			return false, ErrInternal(err)
+		}
+
+		v.audiences = audiences
+	}
+
+	return v.audiences[audience_id], nil
+}
+
+// checkPostAccess returns ErrPostNotFound if the post does not exist
+// or is hidden from the user.
+func (s service) checkPostAccess(ctx context.Context, user_id int64, post_id uint64) error {
+
+	post := &pb.Post{}
+
+	err := s.cses.Query("SELECT owner_id, visibility, allowed_ids, audience_id FROM posts WHERE bucket = ? AND id = ?", s.bucket(post_id), post_id).Scan(&post.OwnerId, &post.Visibility, &post.AllowedIds, &post.AudienceId)
+	if err != nil {
+		if err == gocql.ErrNotFound {
+			return ErrPostNotFound
+		}
+		return ErrInternal(err)
+	}
+
+	ok, err := s.newViewer(user_id).canView(ctx, post)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrPostNotFound
+	}
+
+	return nil
+}
+
+func validVisibility(visibility pb.Visibility, allowed []int64, audience_id uint64) bool {
+	switch visibility {
+	case pb.Visibility_public, pb.Visibility_subscribers, pb.Visibility_only_me:
+		return len(allowed) == 0 && audience_id == 0
+	case pb.Visibility_custom:
+		return len(allowed) != 0 && audience_id == 0
+	case pb.Visibility_audience:
+		return len(allowed) == 0 && audience_id != 0
+	default:
+		return false
+	}
+}
@@ -0,0 +1,287 @@
+// Package syndication renders the wall of a user as RSS 2.0 and Atom
+// feeds.
+package syndication
+
+import (
+	"encoding/xml"
+	"mime"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/NexusIT-Dev/nexusmicro_publications/pb"
+)
+
+// titleLength is the maximum length of an item title in runes.
+const titleLength = 100
+
+type Feed struct {
+	Id          string
+	Title       string
+	Description string
+	// Link is the page of the wall, Self is the url of the feed.
+	Link    string
+	Self    string
+	Author  string
+	Updated time.Time
+	Items   []Item
+}
+
+type Item struct {
+	Id        string
+	Title     string
+	Link      string
+	Content   string
+	Published time.Time
+	// Enclosures are the attachments of the post.
+	Enclosures []Enclosure
+}
+
+type Enclosure struct {
+	Url    string
+	Type   string
+	Length int64
+}
+
+// PostsFeed returns the feed of the posts of the user. webURL is the
+// address of the web app the links point to.
+func PostsFeed(user_id int64, posts []*pb.Post, webURL string, self string) *Feed {
+
+	webURL = strings.TrimSuffix(webURL, "/")
+
+	f := &Feed{
+		Id:    webURL + "/users/" + strconv.FormatInt(user_id, 10),
+		Title: "id" + strconv.FormatInt(user_id, 10),
+		Link:  webURL + "/users/" + strconv.FormatInt(user_id, 10),
+		Self:  self,
+		Items: make([]Item, 0, len(posts)),
+	}
+
+	for _, p := range posts {
+		if p.Owner != nil {
+			f.Title = strings.TrimSpace(p.Owner.Name + " " + p.Owner.Lastname)
+			f.Description = p.Owner.GetDescription()
+		}
+
+		item := Item{
+			Id:         webURL + "/posts/" + strconv.FormatUint(p.Id, 10),
+			Title:      itemTitle(p),
+			Link:       webURL + "/posts/" + strconv.FormatUint(p.Id, 10),
+			Content:    p.Message,
+			Published:  p.Time.AsTime(),
+			Enclosures: make([]Enclosure, 0, len(p.Attachments)),
+		}
+
+		for _, a := range p.Attachments {
+			if a.Url == "" {
+				continue
+			}
+			item.Enclosures = append(item.Enclosures, Enclosure{
+				Url:    a.Url,
+				Type:   attachmentType(a),
+				Length: a.GetInfo().GetFileSize(),
+			})
+		}
+
+		if item.Published.After(f.Updated) {
+			f.Updated = item.Published
+		}
+
+		f.Items = append(f.Items, item)
+	}
+
+	f.Author = f.Title
+	if f.Description == "" {
+		f.Description = "Публикации " + f.Title
+	}
+
+	return f
+}
+
+// itemTitle returns the first line of the message.
+func itemTitle(p *pb.Post) string {
+
+	title, _, _ := strings.Cut(strings.TrimSpace(p.Message), "\n")
+	if runes := []rune(title); len(runes) > titleLength {
+		title = strings.TrimSpace(string(runes[:titleLength-1])) + "…"
+	}
+	if title == "" {
+		title = "Пост от " + p.Time.AsTime().Format("02.01.2006 15:04")
+	}
+
+	return title
+}
+
+// attachmentType returns the mime type of the attachment by the
+// extension of its file.
+func attachmentType(a *pb.Attachment) string {
+
+	name := a.GetInfo().GetFileName()
+	if name == "" {
+		name = a.Url
+	}
+
+	if t := mime.TypeByExtension(strings.ToLower(path.Ext(name))); t != "" {
+		return t
+	}
+
+	return "application/octet-stream"
+}
+
+type rss struct {
+	XMLName xml.Name   `xml:"rss"`
+	Version string     `xml:"version,attr"`
+	Atom    string     `xml:"xmlns:atom,attr"`
+	Channel rssChannel `xml:"channel"`
+}
+
+type rssChannel struct {
+	Title         string    `xml:"title"`
+	Link          string    `xml:"link"`
+	Description   string    `xml:"description"`
+	Self          rssLink   `xml:"atom:link"`
+	LastBuildDate string    `xml:"lastBuildDate,omitempty"`
+	Items         []rssItem `xml:"item"`
+}
+
+type rssLink struct {
+	Href string `xml:"href,attr"`
+	Rel  string `xml:"rel,attr"`
+	Type string `xml:"type,attr"`
+}
+
+type rssItem struct {
+	Title       string         `xml:"title"`
+	Link        string         `xml:"link"`
+	Description string         `xml:"description"`
+	Guid        rssGuid        `xml:"guid"`
+	PubDate     string         `xml:"pubDate"`
+	Enclosures  []rssEnclosure `xml:"enclosure"`
+}
+
+type rssGuid struct {
+	Value       string `xml:",chardata"`
+	IsPermaLink bool   `xml:"isPermaLink,attr"`
+}
+
+type rssEnclosure struct {
+	Url    string `xml:"url,attr"`
+	Length int64  `xml:"length,attr"`
+	Type   string `xml:"type,attr"`
+}
+
+// RSS renders the feed as RSS 2.0.
+func RSS(f *Feed) ([]byte, error) {
+
+	doc := rss{
+		Version: "2.0",
+		Atom:    "http://www.w3.org/2005/Atom",
+		Channel: rssChannel{
+			Title:       f.Title,
+			Link:        f.Link,
+			Description: f.Description,
+			Self:        rssLink{Href: f.Self, Rel: "self", Type: "application/rss+xml"},
+			Items:       make([]rssItem, 0, len(f.Items)),
+		},
+	}
+	if !f.Updated.IsZero() {
+		doc.Channel.LastBuildDate = f.Updated.UTC().Format(time.RFC1123Z)
+	}
+
+	for _, item := range f.Items {
+		ri := rssItem{
+			Title:       item.Title,
+			Link:        item.Link,
+			Description: item.Content,
+			Guid:        rssGuid{Value: item.Id, IsPermaLink: item.Id == item.Link},
+			PubDate:     item.Published.UTC().Format(time.RFC1123Z),
+		}
+		for _, e := range item.Enclosures {
+			ri.Enclosures = append(ri.Enclosures, rssEnclosure{Url: e.Url, Length: e.Length, Type: e.Type})
+		}
+		doc.Channel.Items = append(doc.Channel.Items, ri)
+	}
+
+	return marshal(doc)
+}
+
+type atomFeed struct {
+	XMLName xml.Name    `xml:"http://www.w3.org/2005/Atom feed"`
+	Id      string      `xml:"id"`
+	Title   string      `xml:"title"`
+	Updated string      `xml:"updated"`
+	Links   []atomLink  `xml:"link"`
+	Author  atomPerson  `xml:"author"`
+	Entries []atomEntry `xml:"entry"`
+}
+
+type atomLink struct {
+	Rel    string `xml:"rel,attr,omitempty"`
+	Href   string `xml:"href,attr"`
+	Type   string `xml:"type,attr,omitempty"`
+	Length int64  `xml:"length,attr,omitempty"`
+}
+
+type atomPerson struct {
+	Name string `xml:"name"`
+	Uri  string `xml:"uri,omitempty"`
+}
+
+type atomEntry struct {
+	Id        string     `xml:"id"`
+	Title     string     `xml:"title"`
+	Updated   string     `xml:"updated"`
+	Published string     `xml:"published"`
+	Links     []atomLink `xml:"link"`
+	Content   atomText   `xml:"content"`
+}
+
+type atomText struct {
+	Type  string `xml:"type,attr"`
+	Value string `xml:",chardata"`
+}
+
+// Atom renders the feed as Atom 1.0.
+func Atom(f *Feed) ([]byte, error) {
+
+	doc := atomFeed{
+		Id:      f.Id,
+		Title:   f.Title,
+		Updated: f.Updated.UTC().Format(time.RFC3339),
+		Links: []atomLink{
+			{Rel: "alternate", Href: f.Link, Type: "text/html"},
+			{Rel: "self", Href: f.Self, Type: "application/atom+xml"},
+		},
+		Author:  atomPerson{Name: f.Author, Uri: f.Link},
+		Entries: make([]atomEntry, 0, len(f.Items)),
+	}
+
+	for _, item := range f.Items {
+		// posts are not versioned, the time of an edit is unknown.
+		entry := atomEntry{
+			Id:        item.Id,
+			Title:     item.Title,
+			Updated:   item.Published.UTC().Format(time.RFC3339),
+			Published: item.Published.UTC().Format(time.RFC3339),
+			Links:     []atomLink{{Rel: "alternate", Href: item.Link, Type: "text/html"}},
+			Content:   atomText{Type: "text", Value: item.Content},
+		}
+		for _, e := range item.Enclosures {
+			entry.Links = append(entry.Links, atomLink{Rel: "enclosure", Href: e.Url, Type: e.Type, Length: e.Length})
+		}
+		doc.Entries = append(doc.Entries, entry)
+	}
+
+	return marshal(doc)
+}
+
+func marshal(doc any) ([]byte, error) {
+
+	data, err := xml.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]byte(xml.Header), data...), nil
+}
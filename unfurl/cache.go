@@ -0,0 +1,40 @@
+package unfurl
+
+import (
+	"context"
+	"time"
+
+	"github.com/gocql/gocql"
+)
+
+// CQLCache stores previews in the link_previews table for ttl.
+type CQLCache struct {
+	cses *gocql.Session
+	ttl  time.Duration
+}
+
+func NewCQLCache(cses *gocql.Session, ttl time.Duration) *CQLCache {
+	return &CQLCache{
+		cses: cses,
+		ttl:  ttl,
+	}
+}
+
+func (c *CQLCache) Get(ctx context.Context, url string) (*Preview, error) {
+
+	preview := &Preview{Url: url}
+
+	err := c.cses.Query("SELECT title, description, image FROM link_previews WHERE url = ?", url).WithContext(ctx).Scan(&preview.Title, &preview.Description, &preview.Image)
+	if err != nil {
+		if err == gocql.ErrNotFound {
+			return nil, ErrNotCached
+		}
+		return nil, err
+	}
+
+	return preview, nil
+}
+
+func (c *CQLCache) Put(ctx context.Context, preview *Preview) error {
+	return c.cses.Query("INSERT INTO link_previews (url, title, description, image) VALUES (?, ?, ?, ?) USING TTL ?", preview.Url, preview.Title, preview.Description, preview.Image, int(c.ttl.Seconds())).WithContext(ctx).Exec()
+}
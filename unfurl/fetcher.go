@@ -0,0 +1,78 @@
+package unfurl
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"time"
+
+	"github.com/NexusIT-Dev/nexusmicro_publications/netguard"
+)
+
+const (
+	maxBodySize  = 1 << 20
+	maxRedirects = 5
+	userAgent    = "nexusmicro-posts (link preview)"
+)
+
+// ErrForbiddenAddress is returned when the url resolves to a loopback,
+// private or otherwise internal address.
+var ErrForbiddenAddress = netguard.ErrForbiddenAddress
+
+// HTTPFetcher downloads html pages. Unless allowPrivate is set, it refuses
+// to connect to internal addresses, so users can not make the service
+// request hosts inside the cluster.
+type HTTPFetcher struct {
+	client *http.Client
+}
+
+func NewHTTPFetcher(timeout time.Duration, allowPrivate bool) *HTTPFetcher {
+
+	dialer := netguard.Dialer(timeout, allowPrivate)
+
+	return &HTTPFetcher{
+		client: &http.Client{
+			Timeout: timeout,
+			Transport: &http.Transport{
+				DialContext:         dialer.DialContext,
+				TLSHandshakeTimeout: timeout,
+				MaxIdleConns:        10,
+			},
+			CheckRedirect: func(req *http.Request, via []*http.Request) error {
+				if len(via) >= maxRedirects {
+					return fmt.Errorf("unfurl: stopped after %d redirects", maxRedirects)
+				}
+				return nil
+			},
+		},
+	}
+}
+
+func (f *HTTPFetcher) Fetch(ctx context.Context, url string) ([]byte, error) {
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("User-Agent", userAgent)
+	req.Header.Set("Accept", "text/html")
+
+	res, err := f.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unfurl: unexpected status %s", res.Status)
+	}
+
+	mediatype, _, err := mime.ParseMediaType(res.Header.Get("Content-Type"))
+	if err != nil || (mediatype != "text/html" && mediatype != "application/xhtml+xml") {
+		return nil, ErrNoPreview
+	}
+
+	return io.ReadAll(io.LimitReader(res.Body, maxBodySize))
+}
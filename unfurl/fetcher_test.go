@@ -0,0 +1,80 @@
+package unfurl
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func newTestServer(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/page", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		w.Write([]byte(`<html><head><title>Page</title></head></html>`))
+	})
+	mux.HandleFunc("/image", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "image/png")
+		w.Write([]byte{0x89, 'P', 'N', 'G'})
+	})
+	mux.HandleFunc("/redirect", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/page", http.StatusFound)
+	})
+	mux.HandleFunc("/loop", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/loop", http.StatusFound)
+	})
+
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+
+	return srv
+}
+
+func TestHTTPFetcher(t *testing.T) {
+
+	srv := newTestServer(t)
+	f := NewHTTPFetcher(time.Second*5, true)
+
+	for _, tt := range []struct {
+		name string
+		path string
+		want string
+		err  error
+	}{
+		{name: "html", path: "/page", want: `<html><head><title>Page</title></head></html>`},
+		{name: "redirect", path: "/redirect", want: `<html><head><title>Page</title></head></html>`},
+		{name: "not html", path: "/image", err: ErrNoPreview},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			body, err := f.Fetch(context.Background(), srv.URL+tt.path)
+			if err != tt.err {
+				t.Fatalf("got error %v, want %v", err, tt.err)
+			}
+			if string(body) != tt.want {
+				t.Errorf("got body %q, want %q", body, tt.want)
+			}
+		})
+	}
+
+	for _, path := range []string{"/missing", "/loop"} {
+		_, err := f.Fetch(context.Background(), srv.URL+path)
+		if err == nil {
+			t.Errorf("%s: got no error", path)
+		}
+	}
+}
+
+func TestHTTPFetcherForbidsPrivate(t *testing.T) {
+
+	srv := newTestServer(t)
+	f := NewHTTPFetcher(time.Second*5, false)
+
+	_, err := f.Fetch(context.Background(), srv.URL+"/page")
+	if !errors.Is(err, ErrForbiddenAddress) {
+		t.Errorf("got error %v, want %v", err, ErrForbiddenAddress)
+	}
+}
@@ -0,0 +1,103 @@
+package unfurl
+
+import (
+	"bytes"
+	"net/url"
+	"strings"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+const maxDescriptionLength = 300
+
+// OpenGraphParser reads og: meta tags from the head of the page and falls
+// back to twitter: tags, the description meta tag and the title.
+type OpenGraphParser struct{}
+
+func (OpenGraphParser) Parse(pageurl string, body []byte) (*Preview, error) {
+
+	meta := make(map[string]string)
+	title := ""
+
+	z := html.NewTokenizer(bytes.NewReader(body))
+
+loop:
+	for {
+		tt := z.Next()
+		switch tt {
+		case html.ErrorToken:
+			break loop
+		case html.StartTagToken, html.SelfClosingTagToken:
+			t := z.Token()
+			switch t.DataAtom {
+			case atom.Body:
+				break loop
+			case atom.Title:
+				if z.Next() == html.TextToken {
+					title = strings.TrimSpace(string(z.Text()))
+				}
+			case atom.Meta:
+				key, content := "", ""
+				for _, a := range t.Attr {
+					switch a.Key {
+					case "property", "name":
+						key = strings.ToLower(a.Val)
+					case "content":
+						content = strings.TrimSpace(a.Val)
+					}
+				}
+				if _, ok := meta[key]; key != "" && content != "" && !ok {
+					meta[key] = content
+				}
+			}
+		case html.EndTagToken:
+			if z.Token().DataAtom == atom.Head {
+				break loop
+			}
+		}
+	}
+
+	preview := &Preview{
+		Title:       first(meta["og:title"], meta["twitter:title"], title),
+		Description: first(meta["og:description"], meta["twitter:description"], meta["description"]),
+		Image:       first(meta["og:image"], meta["og:image:url"], meta["twitter:image"]),
+	}
+
+	if preview.Title == "" {
+		return nil, ErrNoPreview
+	}
+
+	if r := []rune(preview.Description); len(r) > maxDescriptionLength {
+		preview.Description = string(r[:maxDescriptionLength]) + "…"
+	}
+
+	if preview.Image != "" {
+		preview.Image = resolve(pageurl, preview.Image)
+	}
+
+	return preview, nil
+}
+
+func first(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+// resolve makes ref absolute relative to base. Images that are not http
+// urls are dropped.
+func resolve(base string, ref string) string {
+	b, err := url.Parse(base)
+	if err != nil {
+		return ""
+	}
+	r, err := b.Parse(ref)
+	if err != nil || (r.Scheme != "http" && r.Scheme != "https") {
+		return ""
+	}
+	return r.String()
+}
@@ -0,0 +1,77 @@
+package unfurl
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestOpenGraphParser(t *testing.T) {
+
+	long := strings.Repeat("я", maxDescriptionLength+10)
+
+	for _, tt := range []struct {
+		name string
+		body string
+		want *Preview
+		err  error
+	}{
+		{
+			name: "open graph",
+			body: `<html><head>
+				<title>Title</title>
+				<meta property="og:title" content="OG title">
+				<meta property="og:description" content=" OG description ">
+				<meta property="og:image" content="/img/cover.png">
+				<meta name="twitter:title" content="Twitter title">
+			</head><body></body></html>`,
+			want: &Preview{Title: "OG title", Description: "OG description", Image: "https://example.com/img/cover.png"},
+		},
+		{
+			name: "fallbacks",
+			body: `<html><head>
+				<title> Title </title>
+				<meta name="description" content="Description">
+				<meta name="twitter:image" content="https://cdn.example.com/a.jpg">
+			</head></html>`,
+			want: &Preview{Title: "Title", Description: "Description", Image: "https://cdn.example.com/a.jpg"},
+		},
+		{
+			name: "first meta wins",
+			body: `<head><meta property="og:title" content="First"><meta property="og:title" content="Second"></head>`,
+			want: &Preview{Title: "First"},
+		},
+		{
+			name: "meta in body ignored",
+			body: `<html><head></head><body><meta property="og:title" content="Body"></body></html>`,
+			err:  ErrNoPreview,
+		},
+		{
+			name: "image not http",
+			body: `<head><title>Title</title><meta property="og:image" content="javascript:alert(1)"></head>`,
+			want: &Preview{Title: "Title"},
+		},
+		{
+			name: "long description",
+			body: `<head><title>Title</title><meta name="description" content="` + long + `"></head>`,
+			want: &Preview{Title: "Title", Description: string([]rune(long)[:maxDescriptionLength]) + "…"},
+		},
+		{
+			name: "no title",
+			body: `<html><head><meta name="description" content="Description"></head></html>`,
+			err:  ErrNoPreview,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := OpenGraphParser{}.Parse("https://example.com/posts/1", []byte(tt.body))
+			if err != tt.err {
+				t.Fatalf("got error %v, want %v", err, tt.err)
+			}
+			if tt.want == nil {
+				return
+			}
+			if *got != *tt.want {
+				t.Errorf("got %+v, want %+v", *got, *tt.want)
+			}
+		})
+	}
+}
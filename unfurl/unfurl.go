@@ -0,0 +1,83 @@
+package unfurl
+
+import (
+	"context"
+	"errors"
+)
+
+var (
+	// ErrNoPreview is returned if the page has nothing to show in a preview.
+	ErrNoPreview = errors.New("unfurl: no preview")
+
+	// ErrNotCached is returned by Cache.Get on a cache miss.
+	ErrNotCached = errors.New("unfurl: not cached")
+)
+
+// Preview is a link preview card.
+type Preview struct {
+	Url         string
+	Title       string
+	Description string
+	Image       string
+}
+
+// Fetcher downloads the page at url.
+type Fetcher interface {
+	Fetch(ctx context.Context, url string) ([]byte, error)
+}
+
+// Parser builds a preview from the page body. pageurl is used to resolve
+// relative links.
+type Parser interface {
+	Parse(pageurl string, body []byte) (*Preview, error)
+}
+
+// Cache stores previews by url.
+type Cache interface {
+	Get(ctx context.Context, url string) (*Preview, error)
+	Put(ctx context.Context, preview *Preview) error
+}
+
+type Unfurler struct {
+	fetcher Fetcher
+	parser  Parser
+	cache   Cache
+}
+
+// New returns an Unfurler. cache may be nil.
+func New(fetcher Fetcher, parser Parser, cache Cache) *Unfurler {
+	return &Unfurler{
+		fetcher: fetcher,
+		parser:  parser,
+		cache:   cache,
+	}
+}
+
+// Unfurl returns the preview of the url, from the cache if possible. The
+// cache is best effort, the page is fetched if the cache fails.
+func (u *Unfurler) Unfurl(ctx context.Context, url string) (*Preview, error) {
+
+	if u.cache != nil {
+		preview, err := u.cache.Get(ctx, url)
+		if err == nil {
+			return preview, nil
+		}
+	}
+
+	body, err := u.fetcher.Fetch(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+
+	preview, err := u.parser.Parse(url, body)
+	if err != nil {
+		return nil, err
+	}
+	preview.Url = url
+
+	if u.cache != nil {
+		u.cache.Put(ctx, preview)
+	}
+
+	return preview, nil
+}
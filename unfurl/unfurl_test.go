@@ -0,0 +1,104 @@
+package unfurl
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+// memoryCache is a Cache that may be made to fail.
+type memoryCache struct {
+	mu       sync.Mutex
+	previews map[string]*Preview
+	err      error
+}
+
+func (c *memoryCache) Get(ctx context.Context, url string) (*Preview, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.err != nil {
+		return nil, c.err
+	}
+	p, ok := c.previews[url]
+	if !ok {
+		return nil, ErrNotCached
+	}
+	return p, nil
+}
+
+func (c *memoryCache) Put(ctx context.Context, preview *Preview) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.err != nil {
+		return c.err
+	}
+	c.previews[preview.Url] = preview
+	return nil
+}
+
+// countingFetcher counts the fetches of the wrapped fetcher.
+type countingFetcher struct {
+	Fetcher
+	mu      sync.Mutex
+	fetches int
+}
+
+func (f *countingFetcher) Fetch(ctx context.Context, url string) ([]byte, error) {
+	f.mu.Lock()
+	f.fetches++
+	f.mu.Unlock()
+	return f.Fetcher.Fetch(ctx, url)
+}
+
+func TestUnfurler(t *testing.T) {
+
+	srv := newTestServer(t)
+	fetcher := &countingFetcher{Fetcher: NewHTTPFetcher(time.Second*5, true)}
+	cache := &memoryCache{previews: make(map[string]*Preview)}
+	u := New(fetcher, OpenGraphParser{}, cache)
+
+	url := srv.URL + "/page"
+	want := Preview{Url: url, Title: "Page"}
+
+	// a miss is fetched and cached, a hit is not fetched again.
+	for i := 0; i < 2; i++ {
+		got, err := u.Unfurl(context.Background(), url)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if *got != want {
+			t.Errorf("got %+v, want %+v", *got, want)
+		}
+	}
+	if fetcher.fetches != 1 {
+		t.Errorf("got %d fetches, want 1", fetcher.fetches)
+	}
+	if cached := cache.previews[url]; cached == nil || *cached != want {
+		t.Errorf("cached %+v, want %+v", cached, want)
+	}
+
+	// a failing cache falls back to fetching.
+	cache.err = errors.New("cache is down")
+	got, err := u.Unfurl(context.Background(), url)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *got != want {
+		t.Errorf("got %+v, want %+v", *got, want)
+	}
+	if fetcher.fetches != 2 {
+		t.Errorf("got %d fetches, want 2", fetcher.fetches)
+	}
+
+	// pages without a preview are not cached.
+	cache.err = nil
+	_, err = u.Unfurl(context.Background(), srv.URL+"/image")
+	if err != ErrNoPreview {
+		t.Errorf("got error %v, want %v", err, ErrNoPreview)
+	}
+	if _, ok := cache.previews[srv.URL+"/image"]; ok {
+		t.Error("page without a preview is cached")
+	}
+}